@@ -109,11 +109,11 @@ func main() {
 	}()
 
 	// Start consuming messages
-	go func() {
-		if consumer != nil {
-			consumer.ConsumeMessages()
+	if consumer != nil {
+		if err := consumer.ConsumeMessages(); err != nil {
+			logger.Error("failed to consume messages", slog.Any("error", err))
 		}
-	}()
+	}
 
 	logger.Info("activity service running", slog.String("port", cfg.GRPCServerAddress))
 	if err := grpcServer.Serve(lis); err != nil {
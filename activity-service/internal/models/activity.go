@@ -3,6 +3,7 @@ package models
 import "time"
 
 type Activity struct {
+	MessageID   string // ID of the message the activity was received in, empty if logged directly
 	InvoiceID   int64
 	UserID      int64
 	Action      string
@@ -3,11 +3,14 @@ package repository
 import (
 	"context"
 	"database/sql"
-	"log"
+	"errors"
 
 	"github.com/emzola/numer/activity-service/internal/models"
 )
 
+// ErrDuplicateActivity is returned when an activity from the same message has already been logged.
+var ErrDuplicateActivity = errors.New("activity already logged")
+
 type ActivityRepository struct {
 	db *sql.DB
 }
@@ -16,13 +19,25 @@ func NewActivityRepository(db *sql.DB) *ActivityRepository {
 	return &ActivityRepository{db: db}
 }
 
-func (r *ActivityRepository) LogActivity(ctx context.Context, activity *models.Activity) {
-	query := `INSERT INTO activities (invoice_id, user_id, action, description, timestamp) 
-              VALUES ($1, $2, $3, $4, $5)`
-	_, err := r.db.ExecContext(ctx, query, activity.InvoiceID, activity.UserID, activity.Action, activity.Description, activity.Timestamp)
+// LogActivity stores an activity. It returns ErrDuplicateActivity if an activity with the same message ID was
+// stored before.
+func (r *ActivityRepository) LogActivity(ctx context.Context, activity *models.Activity) error {
+	query := `INSERT INTO activities (message_id, invoice_id, user_id, action, description, timestamp) 
+              VALUES (NULLIF($1, ''), $2, $3, $4, $5, $6)
+              ON CONFLICT (message_id) DO NOTHING`
+	result, err := r.db.ExecContext(ctx, query, activity.MessageID, activity.InvoiceID, activity.UserID, activity.Action, activity.Description, activity.Timestamp)
 	if err != nil {
-		log.Printf("failed to insert activity into database: %s", err)
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrDuplicateActivity
 	}
+	return nil
 }
 
 func (r *ActivityRepository) GetUserActivities(ctx context.Context, userID int64, limit int) ([]*models.Activity, error) {
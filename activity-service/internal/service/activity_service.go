@@ -8,7 +8,7 @@ import (
 )
 
 type activityRepository interface {
-	LogActivity(ctx context.Context, activity *models.Activity)
+	LogActivity(ctx context.Context, activity *models.Activity) error
 	GetUserActivities(ctx context.Context, userID int64, limit int) ([]*models.Activity, error)
 	GetInvoiceActivities(ctx context.Context, invoiceID int64) ([]*models.Activity, error)
 }
@@ -21,7 +21,7 @@ func NewActivityService(repo activityRepository) *ActivityService {
 	return &ActivityService{repo: repo}
 }
 
-func (s *ActivityService) LogActivity(ctx context.Context, invoiceID, userID int64, action, description string) {
+func (s *ActivityService) LogActivity(ctx context.Context, invoiceID, userID int64, action, description string) error {
 	activity := &models.Activity{
 		InvoiceID:   invoiceID,
 		UserID:      userID,
@@ -29,7 +29,7 @@ func (s *ActivityService) LogActivity(ctx context.Context, invoiceID, userID int
 		Description: description,
 		Timestamp:   time.Now(),
 	}
-	s.repo.LogActivity(ctx, activity)
+	return s.repo.LogActivity(ctx, activity)
 }
 
 func (s *ActivityService) GetUserActivities(ctx context.Context, userID int64, limit int) ([]*models.Activity, error) {
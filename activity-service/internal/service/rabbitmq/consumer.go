@@ -3,13 +3,29 @@ package rabbitmq
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/emzola/numer/activity-service/internal/models"
 	"github.com/emzola/numer/activity-service/internal/repository"
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
+const (
+	prefetchCount = 10          // Messages delivered ahead of being acknowledged
+	retryDelay    = time.Second // Wait before handing back a message that could not be stored
+)
+
+// activityMessage is an activity event published by the invoice service.
+type activityMessage struct {
+	InvoiceID   int64  `json:"invoice_id"`
+	UserID      int64  `json:"user_id"`
+	Action      string `json:"action"`
+	Description string `json:"description"`
+}
+
 type Consumer struct {
 	conn    *amqp.Connection
 	channel *amqp.Channel
@@ -37,49 +53,84 @@ func NewConsumer(url, queueName string, repo *repository.ActivityRepository) (*C
 	}, nil
 }
 
-// Start consuming messages from the queue
-func (c *Consumer) ConsumeMessages() {
+// Start consuming messages from the queue. A message is only acknowledged once its activity is stored, and one
+// delivered again with the same message ID is dropped.
+func (c *Consumer) ConsumeMessages() error {
+	err := c.channel.Qos(prefetchCount, 0, false)
+	if err != nil {
+		return fmt.Errorf("failed to set prefetch count: %w", err)
+	}
+
 	msgs, err := c.channel.Consume(
 		c.queue, // queue
 		"",      // consumer
-		true,    // auto-ack
+		false,   // auto-ack
 		false,   // exclusive
 		false,   // no-local
 		false,   // no-wait
 		nil,     // args
 	)
 	if err != nil {
-		log.Fatalf("failed to register consumer: %v", err)
+		return fmt.Errorf("failed to register consumer: %w", err)
 	}
 
 	go func() {
 		for msg := range msgs {
-			log.Printf("received a message: %s", msg.Body)
-
-			// Deserialize the JSON to a Go map
-			var message map[string]interface{}
-			err := json.Unmarshal(msg.Body, &message)
-			if err != nil {
-				log.Fatalf("failed to deserialize JSON: %s", err)
-			}
-
-			// Extract data from the message map
-			invoiceID := int64(message["invoice_id"].(float64)) // Convert float64 to int64
-			userID := int64(message["user_id"].(float64))       // Convert float64 to int64
-			action := message["action"].(string)
-			description := message["description"].(string)
-
-			activity := &models.Activity{
-				InvoiceID:   invoiceID,
-				UserID:      userID,
-				Action:      action,
-				Description: description,
-			}
-
-			// Store activity log in the database
-			c.repo.LogActivity(context.TODO(), activity)
+			c.handleMessage(msg)
 		}
 	}()
+	return nil
+}
+
+// handleMessage stores the activity in a message and acknowledges it.
+func (c *Consumer) handleMessage(msg amqp.Delivery) {
+	// Deserialize the JSON to an activity message
+	var message activityMessage
+	err := json.Unmarshal(msg.Body, &message)
+	if err != nil || message.UserID == 0 || message.Action == "" {
+		// It would fail the same way every time, so reject it rather than requeue it. The queue's dead letter
+		// exchange, if it has one, keeps it for inspection.
+		log.Printf("rejecting invalid message %s: %s", msg.MessageId, msg.Body)
+		c.nack(msg, false)
+		return
+	}
+
+	activity := &models.Activity{
+		MessageID:   msg.MessageId,
+		InvoiceID:   message.InvoiceID,
+		UserID:      message.UserID,
+		Action:      message.Action,
+		Description: message.Description,
+		Timestamp:   msg.Timestamp,
+	}
+	if activity.Timestamp.IsZero() {
+		activity.Timestamp = time.Now()
+	}
+
+	// Store activity log in the database
+	err = c.repo.LogActivity(context.Background(), activity)
+	switch {
+	case errors.Is(err, repository.ErrDuplicateActivity):
+		log.Printf("dropping duplicate message %s", msg.MessageId)
+	case err != nil:
+		log.Printf("failed to store activity from message %s: %s", msg.MessageId, err)
+		time.Sleep(retryDelay)
+		c.nack(msg, true)
+		return
+	}
+
+	err = msg.Ack(false)
+	if err != nil {
+		log.Printf("failed to acknowledge message %s: %s", msg.MessageId, err)
+	}
+}
+
+// nack rejects a message, handing it back to the queue if requeue is set.
+func (c *Consumer) nack(msg amqp.Delivery, requeue bool) {
+	err := msg.Nack(false, requeue)
+	if err != nil {
+		log.Printf("failed to reject message %s: %s", msg.MessageId, err)
+	}
 }
 
 func (c *Consumer) Close() {
@@ -0,0 +1,6 @@
+-- +goose Up
+-- The ID of the message an activity came from, so a message delivered twice is only logged once
+ALTER TABLE activities ADD COLUMN message_id VARCHAR(255) UNIQUE;
+
+-- +goose Down
+ALTER TABLE activities DROP COLUMN IF EXISTS message_id;
@@ -6,6 +6,7 @@ require (
 	github.com/emzola/numer/activity-service v0.0.0-20240913051324-94f175801702
 	github.com/emzola/numer/invoice-service v0.0.0-20240913051324-94f175801702
 	github.com/emzola/numer/stats-service v0.0.0-20240913074304-e33b61dd60b7
+	github.com/emzola/numer/user-service v0.0.0-20240913074304-e33b61dd60b7
 	github.com/golang-jwt/jwt/v5 v5.2.1
 	github.com/hashicorp/consul/api v1.29.4
 	github.com/julienschmidt/httprouter v1.2.0
@@ -15,7 +16,6 @@ require (
 
 require (
 	github.com/armon/go-metrics v0.4.1 // indirect
-	github.com/emzola/numer/user-service v0.0.0-20240913074304-e33b61dd60b7 // indirect
 	github.com/fatih/color v1.16.0 // indirect
 	github.com/hashicorp/errwrap v1.1.0 // indirect
 	github.com/hashicorp/go-cleanhttp v0.5.2 // indirect
@@ -35,3 +35,9 @@ require (
 	golang.org/x/text v0.18.0 // indirect
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20240827150818-7e3bb234dfed // indirect
 )
+
+replace (
+	github.com/emzola/numer/invoice-service => ../invoice-service
+	github.com/emzola/numer/stats-service => ../stats-service
+	github.com/emzola/numer/user-service => ../user-service
+)
@@ -0,0 +1,255 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+	"google.golang.org/grpc"
+)
+
+const (
+	// maxAttachmentUploadBytes bounds an upload request, leaving room for the multipart framing around the
+	// largest attachment the invoice service accepts.
+	maxAttachmentUploadBytes = 6 << 20
+	// maxAttachmentResponseBytes bounds a downloaded attachment message from the invoice service.
+	maxAttachmentResponseBytes = 16 << 20
+)
+
+func (h *Handler) UploadAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	// Read the file from the multipart form
+	filename, contentType, content, err := h.readMultipartFile(w, r, "file")
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.UploadAttachment(ctx, &invoicepb.UploadAttachmentRequest{
+		InvoiceId:   invoiceId,
+		UserId:      user.Id,
+		Filename:    filename,
+		ContentType: contentType,
+		Content:     content,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusCreated, envelope{"attachment": convertAttachment(grpcRes.Attachment)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetAttachmentsHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ListAttachments(ctx, &invoicepb.ListAttachmentsRequest{InvoiceId: invoiceId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	attachments := make([]AttachmentHTTP, len(grpcRes.Attachments))
+	for i, attachment := range grpcRes.Attachments {
+		attachments[i] = convertAttachment(attachment)
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"attachments": attachments}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) DownloadAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract attachment ID param
+	attachmentId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.GetAttachment(ctx, &invoicepb.AttachmentRequest{AttachmentId: attachmentId, UserId: user.Id},
+		grpc.MaxCallRecvMsgSize(maxAttachmentResponseBytes))
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+	attachment := grpcRes.Attachment
+
+	// Write the file rather than a JSON envelope, always as a download so it isn't rendered by the browser
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+	w.Header().Set("Content-Length", strconv.Itoa(len(attachment.Content)))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(attachment.Content)
+	if err != nil {
+		h.logError(r, err)
+	}
+}
+
+func (h *Handler) DeleteAttachmentHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract attachment ID param
+	attachmentId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.DeleteAttachment(ctx, &invoicepb.AttachmentRequest{AttachmentId: attachmentId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"attachment": convertAttachment(grpcRes.Attachment)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// readMultipartFile reads the file in the named field of a multipart form body. The content type is taken from
+// the part, or guessed from the file name when the client didn't send a specific one.
+func (h *Handler) readMultipartFile(w http.ResponseWriter, r *http.Request, field string) (string, string, []byte, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxAttachmentUploadBytes)
+	mr, err := r.MultipartReader()
+	if err != nil {
+		return "", "", nil, errors.New("body must be a multipart form")
+	}
+
+	for {
+		part, err := mr.NextPart()
+		var maxBytesError *http.MaxBytesError
+		switch {
+		case errors.Is(err, io.EOF):
+			return "", "", nil, fmt.Errorf("form must contain a %q file", field)
+		case errors.As(err, &maxBytesError):
+			return "", "", nil, fmt.Errorf("body must not be larger than %d bytes", maxAttachmentUploadBytes)
+		case err != nil:
+			return "", "", nil, fmt.Errorf("body contains a badly-formed multipart form: %w", err)
+		}
+		if part.FormName() != field || part.FileName() == "" {
+			continue
+		}
+
+		content, err := io.ReadAll(part)
+		if err != nil {
+			if errors.As(err, &maxBytesError) {
+				return "", "", nil, fmt.Errorf("body must not be larger than %d bytes", maxAttachmentUploadBytes)
+			}
+			return "", "", nil, err
+		}
+
+		contentType := part.Header.Get("Content-Type")
+		if contentType == "" || contentType == "application/octet-stream" {
+			contentType = mime.TypeByExtension(filepath.Ext(part.FileName()))
+		}
+		return part.FileName(), contentType, content, nil
+	}
+}
+
+// Convert a gRPC Attachment to an HTTP Attachment
+func convertAttachment(attachment *invoicepb.Attachment) AttachmentHTTP {
+	return AttachmentHTTP{
+		AttachmentID: attachment.Id,
+		InvoiceID:    attachment.InvoiceId,
+		Filename:     attachment.Filename,
+		ContentType:  attachment.ContentType,
+		Size:         attachment.Size,
+		Checksum:     attachment.Checksum,
+		CreatedAt:    attachment.CreatedAt.AsTime(),
+	}
+}
+
+// Struct to represent an invoice attachment in the HTTP response
+type AttachmentHTTP struct {
+	AttachmentID int64     `json:"attachment_id"`
+	InvoiceID    int64     `json:"invoice_id"`
+	Filename     string    `json:"filename"`
+	ContentType  string    `json:"content_type"`
+	Size         int64     `json:"size"`
+	Checksum     string    `json:"checksum"`
+	CreatedAt    time.Time `json:"created_at"`
+}
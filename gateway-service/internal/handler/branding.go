@@ -0,0 +1,369 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *Handler) CreateBrandingProfileHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq BrandingProfileHTTPReq
+	err := h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	// Convert the HTTP request into the gRPC BrandingProfileRequest
+	grpcReq := &invoicepb.BrandingProfileRequest{
+		UserId:              user.Id,
+		Name:                httpReq.Name,
+		AccentColor:         httpReq.AccentColor,
+		Footer:              httpReq.Footer,
+		PaymentInstructions: httpReq.PaymentInstructions,
+		DefaultNote:         httpReq.DefaultNote,
+		IsDefault:           httpReq.IsDefault,
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.CreateBrandingProfile(ctx, grpcReq)
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusCreated, envelope{"branding_profile": convertBrandingProfile(grpcRes.Profile)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetBrandingProfilesHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ListBrandingProfiles(ctx, &invoicepb.ListBrandingProfilesRequest{UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	profiles := make([]BrandingProfileHTTP, len(grpcRes.Profiles))
+	for i, profile := range grpcRes.Profiles {
+		profiles[i] = convertBrandingProfile(profile)
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"branding_profiles": profiles}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetBrandingProfileHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract branding profile ID param
+	profileId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.GetBrandingProfile(ctx, &invoicepb.GetBrandingProfileRequest{ProfileId: profileId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"branding_profile": convertBrandingProfile(grpcRes.Profile)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) UpdateBrandingProfileHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract branding profile ID param
+	profileId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq BrandingProfileHTTPReq
+	err = h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	// Convert the HTTP request into the gRPC BrandingProfileRequest
+	grpcReq := &invoicepb.BrandingProfileRequest{
+		ProfileId:           profileId,
+		UserId:              user.Id,
+		Name:                httpReq.Name,
+		AccentColor:         httpReq.AccentColor,
+		Footer:              httpReq.Footer,
+		PaymentInstructions: httpReq.PaymentInstructions,
+		DefaultNote:         httpReq.DefaultNote,
+		IsDefault:           httpReq.IsDefault,
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.UpdateBrandingProfile(ctx, grpcReq)
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"branding_profile": convertBrandingProfile(grpcRes.Profile)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) DeleteBrandingProfileHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract branding profile ID param
+	profileId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.DeleteBrandingProfile(ctx, &invoicepb.GetBrandingProfileRequest{ProfileId: profileId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"branding_profile": convertBrandingProfile(grpcRes.Profile)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) UploadBrandingLogoHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract branding profile ID param
+	profileId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	// Read the image from the multipart form
+	_, contentType, logo, err := h.readMultipartFile(w, r, "file")
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	h.setBrandingLogo(w, r, &invoicepb.SetBrandingProfileLogoRequest{
+		ProfileId:   profileId,
+		UserId:      user.Id,
+		ContentType: contentType,
+		Logo:        logo,
+	})
+}
+
+func (h *Handler) DeleteBrandingLogoHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract branding profile ID param
+	profileId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	// An empty logo removes it
+	h.setBrandingLogo(w, r, &invoicepb.SetBrandingProfileLogoRequest{ProfileId: profileId, UserId: user.Id})
+}
+
+func (h *Handler) setBrandingLogo(w http.ResponseWriter, r *http.Request, grpcReq *invoicepb.SetBrandingProfileLogoRequest) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.SetBrandingProfileLogo(ctx, grpcReq)
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"branding_profile": convertBrandingProfile(grpcRes.Profile)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetBrandingLogoHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract branding profile ID param
+	profileId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.GetBrandingProfile(ctx, &invoicepb.GetBrandingProfileRequest{ProfileId: profileId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+	profile := grpcRes.Profile
+	if len(profile.Logo) == 0 {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	// Write the image rather than a JSON envelope
+	w.Header().Set("Content-Type", profile.LogoContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(profile.Logo)))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(profile.Logo)
+	if err != nil {
+		h.logError(r, err)
+	}
+}
+
+// Convert a gRPC BrandingProfile to an HTTP BrandingProfile
+func convertBrandingProfile(profile *invoicepb.BrandingProfile) BrandingProfileHTTP {
+	return BrandingProfileHTTP{
+		BrandingProfileID:   profile.Id,
+		UserID:              profile.UserId,
+		Name:                profile.Name,
+		AccentColor:         profile.AccentColor,
+		Footer:              profile.Footer,
+		PaymentInstructions: profile.PaymentInstructions,
+		DefaultNote:         profile.DefaultNote,
+		IsDefault:           profile.IsDefault,
+		LogoContentType:     profile.LogoContentType,
+		CreatedAt:           profile.CreatedAt.AsTime(),
+		UpdatedAt:           profile.UpdatedAt.AsTime(),
+	}
+}
+
+// Struct to capture the HTTP request JSON data
+type BrandingProfileHTTPReq struct {
+	Name                string `json:"name"`
+	AccentColor         string `json:"accent_color"`
+	Footer              string `json:"footer"`
+	PaymentInstructions string `json:"payment_instructions"`
+	DefaultNote         string `json:"default_note"`
+	IsDefault           bool   `json:"is_default"`
+}
+
+// Struct to represent a BrandingProfile in the HTTP response
+type BrandingProfileHTTP struct {
+	BrandingProfileID   int64     `json:"branding_profile_id"`
+	UserID              int64     `json:"user_id"`
+	Name                string    `json:"name"`
+	AccentColor         string    `json:"accent_color"`
+	Footer              string    `json:"footer"`
+	PaymentInstructions string    `json:"payment_instructions"`
+	DefaultNote         string    `json:"default_note"`
+	IsDefault           bool      `json:"is_default"`
+	LogoContentType     string    `json:"logo_content_type"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
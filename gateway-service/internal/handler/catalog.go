@@ -0,0 +1,250 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *Handler) CreateCatalogItemHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq CatalogItemHTTPReq
+	err := h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	// Convert the HTTP request into the gRPC CatalogItemRequest
+	grpcReq := &invoicepb.CatalogItemRequest{
+		UserId:      user.Id,
+		Sku:         httpReq.SKU,
+		Description: httpReq.Description,
+		UnitPrice:   httpReq.UnitPrice,
+		Unit:        httpReq.Unit,
+		TaxRateId:   httpReq.TaxRateID,
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.CreateCatalogItem(ctx, grpcReq)
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusCreated, envelope{"catalog_item": convertCatalogItem(grpcRes.CatalogItem)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetCatalogItemsHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ListCatalogItems(ctx, &invoicepb.ListCatalogItemsRequest{UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	items := make([]CatalogItemHTTP, len(grpcRes.CatalogItems))
+	for i, item := range grpcRes.CatalogItems {
+		items[i] = convertCatalogItem(item)
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"catalog_items": items}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetCatalogItemHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract catalog item ID param
+	catalogItemId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.GetCatalogItem(ctx, &invoicepb.GetCatalogItemRequest{CatalogItemId: catalogItemId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"catalog_item": convertCatalogItem(grpcRes.CatalogItem)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) UpdateCatalogItemHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract catalog item ID param
+	catalogItemId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq CatalogItemHTTPReq
+	err = h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	// Convert the HTTP request into the gRPC CatalogItemRequest
+	grpcReq := &invoicepb.CatalogItemRequest{
+		CatalogItemId: catalogItemId,
+		UserId:        user.Id,
+		Sku:           httpReq.SKU,
+		Description:   httpReq.Description,
+		UnitPrice:     httpReq.UnitPrice,
+		Unit:          httpReq.Unit,
+		TaxRateId:     httpReq.TaxRateID,
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.UpdateCatalogItem(ctx, grpcReq)
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"catalog_item": convertCatalogItem(grpcRes.CatalogItem)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) DeleteCatalogItemHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract catalog item ID param
+	catalogItemId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.DeleteCatalogItem(ctx, &invoicepb.GetCatalogItemRequest{CatalogItemId: catalogItemId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"catalog_item": convertCatalogItem(grpcRes.CatalogItem)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// Convert a gRPC CatalogItem to an HTTP CatalogItem
+func convertCatalogItem(item *invoicepb.CatalogItem) CatalogItemHTTP {
+	return CatalogItemHTTP{
+		CatalogItemID: item.Id,
+		UserID:        item.UserId,
+		SKU:           item.Sku,
+		Description:   item.Description,
+		UnitPrice:     item.UnitPrice,
+		Unit:          item.Unit,
+		TaxRateID:     item.TaxRateId,
+		CreatedAt:     item.CreatedAt.AsTime(),
+		UpdatedAt:     item.UpdatedAt.AsTime(),
+	}
+}
+
+// Struct to capture the HTTP request JSON data
+type CatalogItemHTTPReq struct {
+	SKU         string `json:"sku"`
+	Description string `json:"description"`
+	UnitPrice   int64  `json:"price"`
+	Unit        string `json:"unit"`
+	TaxRateID   int64  `json:"tax_rate_id"`
+}
+
+// Struct to represent a CatalogItem in the HTTP response
+type CatalogItemHTTP struct {
+	CatalogItemID int64     `json:"catalog_item_id"`
+	UserID        int64     `json:"user_id"`
+	SKU           string    `json:"sku"`
+	Description   string    `json:"description"`
+	UnitPrice     int64     `json:"price"`
+	Unit          string    `json:"unit"`
+	TaxRateID     int64     `json:"tax_rate_id,omitempty"`
+	CreatedAt     time.Time `json:"created_at"`
+	UpdatedAt     time.Time `json:"updated_at"`
+}
@@ -0,0 +1,383 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) CreateCreditNoteHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq CreateCreditNoteHTTPReq
+	err = h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	// Convert the HTTP request into the gRPC CreateCreditNoteRequest
+	grpcReq := &invoicepb.CreateCreditNoteRequest{
+		UserId:    user.Id,
+		InvoiceId: invoiceId,
+		Reason:    httpReq.Reason,
+	}
+	if !httpReq.IssueDate.IsZero() {
+		grpcReq.IssueDate = timestamppb.New(httpReq.IssueDate)
+	}
+	for _, item := range httpReq.Items {
+		grpcReq.Items = append(grpcReq.Items, &invoicepb.CreditNoteItem{
+			InvoiceItemId: item.InvoiceItemID,
+			Description:   item.Description,
+			Quantity:      item.Quantity,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.CreateCreditNote(ctx, grpcReq)
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusCreated, envelope{"credit_note": convertCreditNote(grpcRes.CreditNote), "invoice": convertInvoice(grpcRes.Invoice)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetCreditNotesHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ListCreditNotes(ctx, &invoicepb.ListCreditNotesRequest{UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"credit_notes": convertCreditNotes(grpcRes.CreditNotes)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetInvoiceCreditNotesHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ListCreditNotes(ctx, &invoicepb.ListCreditNotesRequest{UserId: user.Id, InvoiceId: invoiceId})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"credit_notes": convertCreditNotes(grpcRes.CreditNotes)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetCreditNoteHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract credit note ID param
+	creditNoteId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.GetCreditNote(ctx, &invoicepb.GetCreditNoteRequest{CreditNoteId: creditNoteId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"credit_note": convertCreditNote(grpcRes.CreditNote)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) ApplyCreditNoteHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract credit note ID param
+	creditNoteId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq ApplyCreditNoteHTTPReq
+	err = h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ApplyCreditNote(ctx, &invoicepb.ApplyCreditNoteRequest{
+		CreditNoteId: creditNoteId,
+		UserId:       user.Id,
+		InvoiceId:    httpReq.InvoiceID,
+		Amount:       httpReq.Amount,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"credit_note": convertCreditNote(grpcRes.CreditNote), "invoice": convertInvoice(grpcRes.Invoice)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) RefundCreditNoteHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract credit note ID param
+	creditNoteId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq RefundCreditNoteHTTPReq
+	err = h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.RefundCreditNote(ctx, &invoicepb.RefundCreditNoteRequest{
+		CreditNoteId: creditNoteId,
+		UserId:       user.Id,
+		Amount:       httpReq.Amount,
+		Method:       httpReq.Method,
+		Reference:    httpReq.Reference,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"credit_note": convertCreditNote(grpcRes.CreditNote)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// Convert gRPC CreditNotes to HTTP CreditNotes
+func convertCreditNotes(creditNotes []*invoicepb.CreditNote) []CreditNoteHTTP {
+	httpCreditNotes := make([]CreditNoteHTTP, len(creditNotes))
+	for i, creditNote := range creditNotes {
+		httpCreditNotes[i] = convertCreditNote(creditNote)
+	}
+	return httpCreditNotes
+}
+
+// Convert a gRPC CreditNote to an HTTP CreditNote
+func convertCreditNote(creditNote *invoicepb.CreditNote) CreditNoteHTTP {
+	items := make([]CreditNoteItemHTTP, len(creditNote.Items))
+	for i, item := range creditNote.Items {
+		items[i] = CreditNoteItemHTTP{
+			InvoiceItemID:  item.InvoiceItemId,
+			Description:    item.Description,
+			Quantity:       item.Quantity,
+			UnitPrice:      item.UnitPrice,
+			DiscountAmount: item.DiscountAmount,
+		}
+	}
+
+	allocations := make([]CreditNoteAllocationHTTP, len(creditNote.Allocations))
+	for i, allocation := range creditNote.Allocations {
+		allocations[i] = CreditNoteAllocationHTTP{
+			Kind:      allocation.Kind,
+			InvoiceID: allocation.InvoiceId,
+			Amount:    allocation.Amount,
+			Method:    allocation.Method,
+			Reference: allocation.Reference,
+			CreatedAt: allocation.CreatedAt.AsTime(),
+		}
+	}
+
+	return CreditNoteHTTP{
+		CreditNoteID:       creditNote.Id,
+		InvoiceID:          creditNote.InvoiceId,
+		CustomerID:         creditNote.CustomerId,
+		CreditNoteNumber:   creditNote.CreditNoteNumber,
+		IssueDate:          creditNote.IssueDate.AsTime(),
+		Currency:           creditNote.Currency,
+		Reason:             creditNote.Reason,
+		Items:              items,
+		DiscountPercentage: creditNote.DiscountPercentage,
+		Subtotal:           creditNote.Subtotal,
+		DiscountAmount:     creditNote.DiscountAmount,
+		Taxes:              convertInvoiceTaxes(creditNote.Taxes),
+		TaxTotal:           creditNote.TaxTotal,
+		Total:              creditNote.Total,
+		AmountApplied:      creditNote.AmountApplied,
+		AmountRefunded:     creditNote.AmountRefunded,
+		RemainingCredit:    creditNote.RemainingCredit,
+		Allocations:        allocations,
+	}
+}
+
+// Struct to capture the HTTP request JSON data
+type CreateCreditNoteHTTPReq struct {
+	Reason    string               `json:"reason"`
+	IssueDate time.Time            `json:"issue_date"`
+	Items     []CreditNoteItemHTTP `json:"items"`
+}
+
+// Struct to capture the HTTP request JSON data
+type ApplyCreditNoteHTTPReq struct {
+	InvoiceID int64 `json:"invoice_id"`
+	Amount    int64 `json:"amount"`
+}
+
+// Struct to capture the HTTP request JSON data
+type RefundCreditNoteHTTPReq struct {
+	Amount    int64  `json:"amount"`
+	Method    string `json:"method"`
+	Reference string `json:"reference"`
+}
+
+// Struct for credit note items
+type CreditNoteItemHTTP struct {
+	InvoiceItemID  int64  `json:"invoice_item_id"`
+	Description    string `json:"description"`
+	Quantity       int32  `json:"quantity"`
+	UnitPrice      int64  `json:"price,omitempty"`
+	DiscountAmount int64  `json:"discount_amount,omitempty"`
+}
+
+// Struct to represent credit applied or refunded from a credit note in the HTTP response
+type CreditNoteAllocationHTTP struct {
+	Kind      string    `json:"kind"`
+	InvoiceID int64     `json:"invoice_id,omitempty"`
+	Amount    int64     `json:"amount"`
+	Method    string    `json:"method,omitempty"`
+	Reference string    `json:"reference,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Struct to represent a CreditNote in the HTTP response
+type CreditNoteHTTP struct {
+	CreditNoteID       int64                      `json:"credit_note_id"`
+	InvoiceID          int64                      `json:"invoice_id"`
+	CustomerID         int64                      `json:"customer_id"`
+	CreditNoteNumber   string                     `json:"credit_note_number"`
+	IssueDate          time.Time                  `json:"issue_date"`
+	Currency           string                     `json:"currency"`
+	Reason             string                     `json:"reason"`
+	Items              []CreditNoteItemHTTP       `json:"items"`
+	DiscountPercentage int64                      `json:"discount_percentage"`
+	Subtotal           int64                      `json:"subtotal"`
+	DiscountAmount     int64                      `json:"discount_amount"`
+	Taxes              []InvoiceTaxHTTP           `json:"taxes"`
+	TaxTotal           int64                      `json:"tax_total"`
+	Total              int64                      `json:"total"`
+	AmountApplied      int64                      `json:"amount_applied"`
+	AmountRefunded     int64                      `json:"amount_refunded"`
+	RemainingCredit    int64                      `json:"remaining_credit"`
+	Allocations        []CreditNoteAllocationHTTP `json:"allocations"`
+}
@@ -0,0 +1,265 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxImportBytes is the largest exchange rate file accepted for import.
+const maxImportBytes = 1_048_576
+
+func (h *Handler) GetCurrencySettingsHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.GetCurrencySettings(ctx, &invoicepb.GetCurrencySettingsRequest{UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"currency_settings": CurrencySettingsHTTP{BaseCurrency: grpcRes.BaseCurrency}}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) UpdateCurrencySettingsHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq CurrencySettingsHTTP
+	err := h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.UpdateCurrencySettings(ctx, &invoicepb.UpdateCurrencySettingsRequest{
+		UserId:       user.Id,
+		BaseCurrency: httpReq.BaseCurrency,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"currency_settings": CurrencySettingsHTTP{BaseCurrency: grpcRes.BaseCurrency}}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) CreateExchangeRateHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq CreateExchangeRateHTTPReq
+	err := h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	// Convert the HTTP request into the gRPC CreateExchangeRateRequest
+	grpcReq := &invoicepb.CreateExchangeRateRequest{
+		UserId:   user.Id,
+		Currency: httpReq.Currency,
+		Rate:     httpReq.Rate,
+	}
+	if !httpReq.EffectiveDate.IsZero() {
+		grpcReq.EffectiveDate = timestamppb.New(httpReq.EffectiveDate)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.CreateExchangeRate(ctx, grpcReq)
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusCreated, envelope{"exchange_rate": convertExchangeRate(grpcRes.ExchangeRate)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetExchangeRatesHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ListExchangeRates(ctx, &invoicepb.ListExchangeRatesRequest{UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	// Map the gRPC ListExchangeRatesResponse to the HTTP response
+	rates := make([]ExchangeRateHTTP, len(grpcRes.ExchangeRates))
+	for i, rate := range grpcRes.ExchangeRates {
+		rates[i] = convertExchangeRate(rate)
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"exchange_rates": rates}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// ImportExchangeRatesHandler imports exchange rates from a CSV request body with a
+// currency,rate,effective_date header.
+func (h *Handler) ImportExchangeRatesHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Read the CSV file from the request body
+	data, err := h.readImportBody(w, r, maxImportBytes)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ImportExchangeRates(ctx, &invoicepb.ImportExchangeRatesRequest{UserId: user.Id, Data: data})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusCreated, envelope{"imported": grpcRes.Imported}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) DeleteExchangeRateHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract exchange rate ID param
+	exchangeRateId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.DeleteExchangeRate(ctx, &invoicepb.DeleteExchangeRateRequest{ExchangeRateId: exchangeRateId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"message": grpcRes.Message}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// Convert a gRPC ExchangeRate to an HTTP ExchangeRate
+func convertExchangeRate(rate *invoicepb.ExchangeRate) ExchangeRateHTTP {
+	return ExchangeRateHTTP{
+		ExchangeRateID: rate.Id,
+		BaseCurrency:   rate.BaseCurrency,
+		Currency:       rate.Currency,
+		Rate:           rate.Rate,
+		EffectiveDate:  rate.EffectiveDate.AsTime().Format(time.DateOnly),
+	}
+}
+
+// Struct to capture the HTTP request and response JSON data
+type CurrencySettingsHTTP struct {
+	BaseCurrency string `json:"base_currency"`
+}
+
+// Struct to capture the HTTP request JSON data
+type CreateExchangeRateHTTPReq struct {
+	Currency      string    `json:"currency"`
+	Rate          string    `json:"rate"`
+	EffectiveDate time.Time `json:"effective_date"`
+}
+
+// Struct to represent an ExchangeRate in the HTTP response
+type ExchangeRateHTTP struct {
+	ExchangeRateID int64  `json:"exchange_rate_id"`
+	BaseCurrency   string `json:"base_currency"`
+	Currency       string `json:"currency"`
+	Rate           string `json:"rate"`
+	EffectiveDate  string `json:"effective_date"`
+}
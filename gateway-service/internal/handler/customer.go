@@ -23,10 +23,13 @@ func (h *Handler) CreateCustomerHandler(w http.ResponseWriter, r *http.Request)
 
 	// Convert the HTTP request into the gRPC CreateUserRequest
 	grpcReq := &userpb.CreateCustomerRequest{
-		UserId:  user.Id,
-		Name:    httpReq.Name,
-		Email:   httpReq.Email,
-		Address: httpReq.Address,
+		UserId:   user.Id,
+		Name:     httpReq.Name,
+		Email:    httpReq.Email,
+		Address:  httpReq.Address,
+		Country:  httpReq.Country,
+		TaxId:    httpReq.TaxID,
+		PeppolId: httpReq.PeppolID,
 	}
 
 	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
@@ -43,18 +46,12 @@ func (h *Handler) CreateCustomerHandler(w http.ResponseWriter, r *http.Request)
 	client := userpb.NewUserServiceClient(conn)
 	grpcRes, err := client.CreateCustomer(ctx, grpcReq)
 	if err != nil {
-		h.serverErrorResponse(w, r, err)
+		h.grpcErrorResponse(w, r, err)
 		return
 	}
 
 	// Map the gRPC CustomerResponse back to the HTTP response
-	cusResp := CustomerHTTPResp{
-		ID:      grpcRes.Customer.Id,
-		UserId:  grpcRes.Customer.UserId,
-		Name:    grpcRes.Customer.Name,
-		Email:   grpcRes.Customer.Email,
-		Address: grpcRes.Customer.Address,
-	}
+	cusResp := convertCustomer(grpcRes.Customer)
 
 	err = h.encodeJSON(w, http.StatusCreated, envelope{"customer": cusResp}, nil)
 	if err != nil {
@@ -94,13 +91,7 @@ func (h *Handler) GetCustomerHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Map the gRPC CustomerResponse back to the HTTP response
-	cusResp := CustomerHTTPResp{
-		ID:      grpcRes.Customer.Id,
-		UserId:  grpcRes.Customer.UserId,
-		Name:    grpcRes.Customer.Name,
-		Email:   grpcRes.Customer.Email,
-		Address: grpcRes.Customer.Address,
-	}
+	cusResp := convertCustomer(grpcRes.Customer)
 
 	err = h.encodeJSON(w, http.StatusOK, envelope{"customer": cusResp}, nil)
 	if err != nil {
@@ -130,6 +121,9 @@ func (h *Handler) UpdateCustomerHandler(w http.ResponseWriter, r *http.Request)
 		Name:       httpReq.Name,
 		Email:      httpReq.Email,
 		Address:    httpReq.Address,
+		Country:    httpReq.Country,
+		TaxId:      httpReq.TaxID,
+		PeppolId:   httpReq.PeppolID,
 	}
 
 	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
@@ -146,18 +140,12 @@ func (h *Handler) UpdateCustomerHandler(w http.ResponseWriter, r *http.Request)
 	client := userpb.NewUserServiceClient(conn)
 	grpcRes, err := client.UpdateCustomer(ctx, grpcReq)
 	if err != nil {
-		h.serverErrorResponse(w, r, err)
+		h.grpcErrorResponse(w, r, err)
 		return
 	}
 
 	// Map the gRPC CustomerResponse back to the HTTP response
-	cusResp := CustomerHTTPResp{
-		ID:      grpcRes.Customer.Id,
-		UserId:  grpcRes.Customer.UserId,
-		Name:    grpcRes.Customer.Name,
-		Email:   grpcRes.Customer.Email,
-		Address: grpcRes.Customer.Address,
-	}
+	cusResp := convertCustomer(grpcRes.Customer)
 
 	err = h.encodeJSON(w, http.StatusOK, envelope{"customer": cusResp}, nil)
 	if err != nil {
@@ -207,27 +195,50 @@ func (h *Handler) DeleteCustomerHandler(w http.ResponseWriter, r *http.Request)
 	}
 }
 
+// Convert a gRPC Customer to an HTTP Customer
+func convertCustomer(customer *userpb.Customer) CustomerHTTPResp {
+	return CustomerHTTPResp{
+		ID:       customer.Id,
+		UserId:   customer.UserId,
+		Name:     customer.Name,
+		Email:    customer.Email,
+		Address:  customer.Address,
+		Country:  customer.Country,
+		TaxID:    customer.TaxId,
+		PeppolID: customer.PeppolId,
+	}
+}
+
 // Struct to capture the HTTP request JSON data
 type CreateCustomerHTTPReq struct {
-	Name    string `json:"name"`
-	Email   string `json:"email"`
-	Address string `json:"address"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Address  string `json:"address"`
+	Country  string `json:"country"`
+	TaxID    string `json:"tax_id"`
+	PeppolID string `json:"peppol_id"`
 }
 
 // Struct to capture the HTTP response
 type CustomerHTTPResp struct {
-	ID      int64  `json:"customer_id"`
-	UserId  int64  `json:"user_id"`
-	Name    string `json:"name"`
-	Email   string `json:"email"`
-	Address string `json:"address"`
+	ID       int64  `json:"customer_id"`
+	UserId   int64  `json:"user_id"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Address  string `json:"address"`
+	Country  string `json:"country"`
+	TaxID    string `json:"tax_id"`
+	PeppolID string `json:"peppol_id"`
 }
 
 // Struct to capture the HTTP request JSON data
 type UpdateCustomerHTTPReq struct {
-	Name    string `json:"name"`
-	Email   string `json:"email"`
-	Address string `json:"address"`
+	Name     string `json:"name"`
+	Email    string `json:"email"`
+	Address  string `json:"address"`
+	Country  string `json:"country"`
+	TaxID    string `json:"tax_id"`
+	PeppolID string `json:"peppol_id"`
 }
 
 // Struct to capture the HTTP response
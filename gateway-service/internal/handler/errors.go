@@ -4,6 +4,9 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (h *Handler) logError(r *http.Request, err error) {
@@ -64,3 +67,34 @@ func (h *Handler) authenticationRequiredResponse(w http.ResponseWriter, r *http.
 	message := "you must be authenticated to access this resource"
 	h.errorResponse(w, r, http.StatusUnauthorized, message)
 }
+
+func (h *Handler) conflictResponse(w http.ResponseWriter, r *http.Request, message string) {
+	h.errorResponse(w, r, http.StatusConflict, message)
+}
+
+func (h *Handler) preconditionFailedResponse(w http.ResponseWriter, r *http.Request) {
+	message := "the resource has been changed since it was read, fetch it again and retry"
+	h.errorResponse(w, r, http.StatusPreconditionFailed, message)
+}
+
+// grpcErrorResponse translates an error returned by a backend service into the matching HTTP response.
+func (h *Handler) grpcErrorResponse(w http.ResponseWriter, r *http.Request, err error) {
+	st, ok := status.FromError(err)
+	if !ok {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+
+	switch st.Code() {
+	case codes.NotFound:
+		h.notFoundResponse(w, r)
+	case codes.InvalidArgument:
+		h.errorResponse(w, r, http.StatusBadRequest, st.Message())
+	case codes.FailedPrecondition, codes.AlreadyExists, codes.Aborted:
+		h.conflictResponse(w, r, st.Message())
+	case codes.PermissionDenied:
+		h.notPermittedResponse(w, r)
+	default:
+		h.serverErrorResponse(w, r, err)
+	}
+}
@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"context"
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+)
+
+const (
+	// exportPageSize is how many invoices an export fetches from the invoice service at a time.
+	exportPageSize = 100
+	// exportWriteTimeout bounds how long an export can take to write, in place of the server's write timeout.
+	exportWriteTimeout = 5 * time.Minute
+)
+
+// invoiceExportHeader names the columns of an invoice export. Amounts are in cents, as in the JSON API.
+var invoiceExportHeader = []string{
+	"invoice_number", "status", "customer_id", "issue_date", "due_date", "currency", "subtotal", "discount_amount",
+	"charge_total", "tax_total", "total", "amount_paid", "amount_credited", "late_fee_total", "balance_due", "note",
+}
+
+// ExportInvoicesHandler streams the invoices matching the same filters as GetInvoicesHandler as a CSV file, a
+// page at a time, so exports of any size use little memory.
+func (h *Handler) ExportInvoicesHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Read url query params
+	grpcReq, err := h.readInvoiceFilters(r.URL.Query(), user.Id)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+	grpcReq.PageSize = exportPageSize
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(r.Context(), "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	listInvoices := func() (*invoicepb.ListInvoicesResponse, error) {
+		ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+		defer cancel()
+		return client.ListInvoices(ctx, grpcReq)
+	}
+
+	// Fetch the first page before writing anything, so a bad filter still gets an error response
+	grpcRes, err := listInvoices()
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	_ = rc.SetWriteDeadline(time.Now().Add(exportWriteTimeout))
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="invoices.csv"`)
+	w.WriteHeader(http.StatusOK)
+
+	cw := csv.NewWriter(w)
+	_ = cw.Write(invoiceExportHeader)
+	for {
+		for _, inv := range grpcRes.Invoices {
+			_ = cw.Write(invoiceExportRecord(inv))
+		}
+		cw.Flush()
+		if err := cw.Error(); err != nil {
+			// The client has gone away
+			h.logError(r, err)
+			return
+		}
+		_ = rc.Flush()
+
+		if grpcRes.NextPageToken == "" {
+			return
+		}
+		grpcReq.PageToken = grpcRes.NextPageToken
+		grpcRes, err = listInvoices()
+		if err != nil {
+			// The status has been sent, so all that can be done is to cut the file short
+			h.logError(r, err)
+			return
+		}
+	}
+}
+
+// Convert a gRPC Invoice to a row of an invoice export
+func invoiceExportRecord(inv *invoicepb.Invoice) []string {
+	amount := func(cents int64) string { return strconv.FormatInt(cents, 10) }
+	return []string{
+		inv.InvoiceNumber,
+		inv.Status,
+		strconv.FormatInt(inv.CustomerId, 10),
+		inv.IssueDate.AsTime().Format(time.DateOnly),
+		inv.DueDate.AsTime().Format(time.DateOnly),
+		inv.Currency,
+		amount(inv.Subtotal),
+		amount(inv.DiscountAmount),
+		amount(inv.ChargeTotal),
+		amount(inv.TaxTotal),
+		amount(inv.Total),
+		amount(inv.AmountPaid),
+		amount(inv.AmountCredited),
+		amount(inv.LateFeeTotal),
+		amount(inv.BalanceDue),
+		inv.Note,
+	}
+}
@@ -9,9 +9,11 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	invoicepb "github.com/emzola/numer/invoice-service/proto"
 	"github.com/julienschmidt/httprouter"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // envelop is a wrapper around JSON responses.
@@ -29,6 +31,32 @@ func (h *Handler) readIDParam(r *http.Request) (int64, error) {
 	return id, nil
 }
 
+// invoiceETag returns the entity tag of an invoice at the given version
+func invoiceETag(version int64) string {
+	return strconv.Quote(strconv.FormatInt(version, 10))
+}
+
+// readIfMatch reads the invoice version required by the If-Match header. It reports false if there is no
+// header or it matches any version, and returns a zero version if the header can match no version at all.
+func (h *Handler) readIfMatch(r *http.Request) (int64, bool) {
+	value := strings.TrimSpace(r.Header.Get("If-Match"))
+	if value == "" || value == "*" {
+		return 0, false
+	}
+
+	// Weak tags never match, since If-Match uses the strong comparison
+	tag, err := strconv.Unquote(value)
+	if err != nil || !strings.HasPrefix(value, `"`) {
+		return 0, true
+	}
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil || version < 1 {
+		return 0, true
+	}
+
+	return version, true
+}
+
 // encodeJSON serializes data to JSON and writes the appropriate HTTP status code and headers if necessary.
 func (h *Handler) encodeJSON(w http.ResponseWriter, status int, data envelope, headers http.Header) error {
 	js, err := json.MarshalIndent(data, "", "\t")
@@ -95,38 +123,143 @@ func (h *Handler) decodeJSON(w http.ResponseWriter, r *http.Request, dst any) er
 func convertInvoices(invoices []*invoicepb.Invoice) []InvoiceHTTP {
 	httpInvoices := make([]InvoiceHTTP, len(invoices))
 	for i, inv := range invoices {
-		httpInvoices[i] = InvoiceHTTP{
-			InvoiceID:          inv.Id,
-			UserID:             inv.UserId,
-			CustomerID:         inv.CustomerId,
-			IssueDate:          inv.IssueDate.AsTime(),
-			DueDate:            inv.DueDate.AsTime(),
-			Currency:           inv.Currency,
-			Items:              convertInvoiceItems(inv.Items),
-			DiscountPercentage: inv.DiscountPercentage,
-			AccountName:        inv.AccountName,
-			AccountNumber:      inv.AccountNumber,
-			BankName:           inv.BankName,
-			RoutingNumber:      inv.RoutingNumber,
-			Note:               inv.Note,
-		}
+		httpInvoices[i] = convertInvoice(inv)
 	}
 	return httpInvoices
 }
 
+// Convert a gRPC Invoice to an HTTP Invoice
+func convertInvoice(inv *invoicepb.Invoice) InvoiceHTTP {
+	httpInvoice := InvoiceHTTP{
+		InvoiceID:          inv.Id,
+		UserID:             inv.UserId,
+		CustomerID:         inv.CustomerId,
+		InvoiceNumber:      inv.InvoiceNumber,
+		Status:             inv.Status,
+		IssueDate:          inv.IssueDate.AsTime(),
+		DueDate:            inv.DueDate.AsTime(),
+		Currency:           inv.Currency,
+		Items:              convertInvoiceItems(inv.Items),
+		DiscountPercentage: inv.DiscountPercentage,
+		Subtotal:           inv.Subtotal,
+		DiscountAmount:     inv.DiscountAmount,
+		FixedDiscount:      inv.FixedDiscount,
+		Charges:            convertInvoiceCharges(inv.Charges),
+		ChargeTotal:        inv.ChargeTotal,
+		Taxes:              convertInvoiceTaxes(inv.Taxes),
+		TaxTotal:           inv.TaxTotal,
+		Total:              inv.Total,
+		AmountPaid:         inv.AmountPaid,
+		AmountCredited:     inv.AmountCredited,
+		CreditNoteTotal:    inv.CreditNoteTotal,
+		LateFees:           convertLateFees(inv.LateFees),
+		LateFeeTotal:       inv.LateFeeTotal,
+		BalanceDue:         inv.BalanceDue,
+		BaseCurrency:       inv.BaseCurrency,
+		ExchangeRate:       inv.ExchangeRate,
+		RecurringInvoiceID: inv.RecurringInvoiceId,
+		QuoteID:            inv.QuoteId,
+		BrandingProfileID:  inv.BrandingProfileId,
+		AccountName:        inv.AccountName,
+		AccountNumber:      inv.AccountNumber,
+		BankName:           inv.BankName,
+		RoutingNumber:      inv.RoutingNumber,
+		Note:               inv.Note,
+		Version:            inv.Version,
+	}
+	if inv.ArchivedAt != nil {
+		archivedAt := inv.ArchivedAt.AsTime()
+		httpInvoice.ArchivedAt = &archivedAt
+	}
+	return httpInvoice
+}
+
 // Convert gRPC InvoiceItems to HTTP InvoiceItems
 func convertInvoiceItems(items []*invoicepb.InvoiceItem) []InvoiceItem {
 	httpItems := make([]InvoiceItem, len(items))
 	for i, item := range items {
 		httpItems[i] = InvoiceItem{
-			Description: item.Description,
-			Quantity:    item.Quantity,
-			UnitPrice:   item.UnitPrice,
+			CatalogItemID:      item.CatalogItemId,
+			SKU:                item.Sku,
+			Description:        item.Description,
+			Quantity:           item.Quantity,
+			Unit:               item.Unit,
+			UnitPrice:          item.GetUnitPrice(),
+			DiscountPercentage: item.DiscountPercentage,
+			FixedDiscount:      item.FixedDiscount,
+			DiscountAmount:     item.DiscountAmount,
+			Amount:             item.Amount,
+			TaxRateIDs:         item.TaxRateIds,
 		}
 	}
 	return httpItems
 }
 
+// Convert HTTP invoice items to gRPC InvoiceItems, passing on whether the price and tax rates were given
+func convertHTTPInvoiceItems(items []InvoiceItemHTTPReq) []*invoicepb.InvoiceItem {
+	grpcItems := make([]*invoicepb.InvoiceItem, len(items))
+	for i, item := range items {
+		grpcItems[i] = &invoicepb.InvoiceItem{
+			CatalogItemId:      item.CatalogItemID,
+			Sku:                item.SKU,
+			Description:        item.Description,
+			Quantity:           item.Quantity,
+			Unit:               item.Unit,
+			UnitPrice:          item.UnitPrice,
+			DiscountPercentage: item.DiscountPercentage,
+			FixedDiscount:      item.FixedDiscount,
+		}
+		if item.TaxRateIDs != nil {
+			grpcItems[i].TaxRateIds = *item.TaxRateIDs
+			grpcItems[i].TaxRateIdsSet = true
+		}
+	}
+	return grpcItems
+}
+
+// Convert gRPC InvoiceCharges to HTTP InvoiceCharges
+func convertInvoiceCharges(charges []*invoicepb.InvoiceCharge) []InvoiceChargeHTTP {
+	httpCharges := make([]InvoiceChargeHTTP, len(charges))
+	for i, charge := range charges {
+		httpCharges[i] = InvoiceChargeHTTP{
+			Description: charge.Description,
+			Amount:      charge.Amount,
+			TaxRateIDs:  charge.TaxRateIds,
+		}
+	}
+	return httpCharges
+}
+
+// Convert HTTP InvoiceCharges to gRPC InvoiceCharges
+func convertHTTPInvoiceCharges(charges []InvoiceChargeHTTP) []*invoicepb.InvoiceCharge {
+	grpcCharges := make([]*invoicepb.InvoiceCharge, len(charges))
+	for i, charge := range charges {
+		grpcCharges[i] = &invoicepb.InvoiceCharge{
+			Description: charge.Description,
+			Amount:      charge.Amount,
+			TaxRateIds:  charge.TaxRateIDs,
+		}
+	}
+	return grpcCharges
+}
+
+// Convert a gRPC tax breakdown to an HTTP tax breakdown
+func convertInvoiceTaxes(taxes []*invoicepb.InvoiceTax) []InvoiceTaxHTTP {
+	httpTaxes := make([]InvoiceTaxHTTP, len(taxes))
+	for i, tax := range taxes {
+		httpTaxes[i] = InvoiceTaxHTTP{
+			TaxRateID:     tax.TaxRateId,
+			Name:          tax.Name,
+			Rate:          tax.Rate,
+			Inclusive:     tax.Inclusive,
+			Compound:      tax.Compound,
+			TaxableAmount: tax.TaxableAmount,
+			Amount:        tax.Amount,
+		}
+	}
+	return httpTaxes
+}
+
 // ReadString reads a url query param and returns a string
 func (h *Handler) ReadString(qs url.Values, key string, defaultValue string) string {
 	s := qs.Get(key)
@@ -150,3 +283,59 @@ func (h *Handler) ReadInt(qs url.Values, key string, defaultValue int) int {
 
 	return i
 }
+
+// ReadInt64 reads a url query param as an int64, returning zero if it is absent
+func (h *Handler) ReadInt64(qs url.Values, key string) (int64, error) {
+	s := qs.Get(key)
+	if s == "" {
+		return 0, nil
+	}
+
+	i, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%s must be an integer", key)
+	}
+
+	return i, nil
+}
+
+// ReadBool reads a url query param as a boolean, returning false if it is absent
+func (h *Handler) ReadBool(qs url.Values, key string) (bool, error) {
+	s := qs.Get(key)
+	if s == "" {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, fmt.Errorf("%s must be true or false", key)
+	}
+
+	return b, nil
+}
+
+// ReadDate reads a url query param in YYYY-MM-DD form as a timestamp, returning nil if it is absent
+func (h *Handler) ReadDate(qs url.Values, key string) (*timestamppb.Timestamp, error) {
+	s := qs.Get(key)
+	if s == "" {
+		return nil, nil
+	}
+
+	date, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be a date in YYYY-MM-DD form", key)
+	}
+
+	return timestamppb.New(date), nil
+}
+
+// ReadCSV reads a comma-separated url query param as a list of values
+func (h *Handler) ReadCSV(qs url.Values, key string) []string {
+	var values []string
+	for _, value := range strings.Split(qs.Get(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
@@ -0,0 +1,155 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+	userpb "github.com/emzola/numer/user-service/proto"
+)
+
+// maxBulkImportBytes is the largest customer or invoice file accepted for import, kept under the default gRPC
+// message size of the services that read it.
+const maxBulkImportBytes = 2 << 20
+
+// ImportCustomersHandler imports customers from a CSV request body with a name,email,address header.
+func (h *Handler) ImportCustomersHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Read url query params
+	dryRun, err := h.ReadBool(r.URL.Query(), "dry_run")
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	// Read the CSV file from the request body
+	data, err := h.readImportBody(w, r, maxBulkImportBytes)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to user service
+	conn, err := grpcutil.ServiceConnection(ctx, "user-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := userpb.NewUserServiceClient(conn)
+	grpcRes, err := client.ImportCustomers(ctx, &userpb.ImportCustomersRequest{UserId: user.Id, Data: data, DryRun: dryRun})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	customers := make([]CustomerHTTPResp, len(grpcRes.Customers))
+	for i, customer := range grpcRes.Customers {
+		customers[i] = convertCustomer(customer)
+	}
+
+	importErrors := make([]ImportErrorHTTP, len(grpcRes.Errors))
+	for i, importError := range grpcRes.Errors {
+		importErrors[i] = ImportErrorHTTP{Row: importError.Row, Message: importError.Message}
+	}
+
+	h.importResponse(w, r, dryRun, envelope{"customers": customers}, importErrors)
+}
+
+// ImportInvoicesHandler imports draft invoices from a CSV request body with one line item per row.
+func (h *Handler) ImportInvoicesHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Read url query params
+	dryRun, err := h.ReadBool(r.URL.Query(), "dry_run")
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	// Read the CSV file from the request body
+	data, err := h.readImportBody(w, r, maxBulkImportBytes)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ImportInvoices(ctx, &invoicepb.ImportInvoicesRequest{UserId: user.Id, Data: data, DryRun: dryRun})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	importErrors := make([]ImportErrorHTTP, len(grpcRes.Errors))
+	for i, importError := range grpcRes.Errors {
+		importErrors[i] = ImportErrorHTTP{Row: importError.Row, Message: importError.Message}
+	}
+
+	h.importResponse(w, r, dryRun, envelope{"invoices": convertInvoices(grpcRes.Invoices)}, importErrors)
+}
+
+// importResponse writes the result of an import: the rejected rows if there are any, otherwise what was
+// imported, or would have been on a dry run.
+func (h *Handler) importResponse(w http.ResponseWriter, r *http.Request, dryRun bool, imported envelope, importErrors []ImportErrorHTTP) {
+	var err error
+	switch {
+	case len(importErrors) > 0:
+		err = h.encodeJSON(w, http.StatusUnprocessableEntity, envelope{"dry_run": dryRun, "errors": importErrors}, nil)
+	case dryRun:
+		imported["dry_run"] = true
+		err = h.encodeJSON(w, http.StatusOK, imported, nil)
+	default:
+		imported["dry_run"] = false
+		err = h.encodeJSON(w, http.StatusCreated, imported, nil)
+	}
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// readImportBody reads a file sent as the raw request body, up to maxBytes.
+func (h *Handler) readImportBody(w http.ResponseWriter, r *http.Request, maxBytes int64) ([]byte, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxBytes)
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		var maxBytesError *http.MaxBytesError
+		if errors.As(err, &maxBytesError) {
+			return nil, fmt.Errorf("body must not be larger than %d bytes", maxBytes)
+		}
+		return nil, err
+	}
+	if len(data) == 0 {
+		return nil, errors.New("body must not be empty")
+	}
+	return data, nil
+}
+
+// Struct to represent a rejected row of an import file in the HTTP response
+type ImportErrorHTTP struct {
+	Row     int32  `json:"row"`
+	Message string `json:"message"`
+}
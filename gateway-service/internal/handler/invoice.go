@@ -2,12 +2,17 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/emzola/numer/gateway-service/internal/grpcutil"
 	invoicepb "github.com/emzola/numer/invoice-service/proto"
 	userpb "github.com/emzola/numer/user-service/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -35,21 +40,18 @@ func (h *Handler) CreateInvoiceHandler(w http.ResponseWriter, r *http.Request) {
 		DueDate:            dueDateProto,
 		Currency:           httpReq.Currency,
 		DiscountPercentage: httpReq.DiscountPercentage,
+		FixedDiscount:      httpReq.FixedDiscount,
+		Charges:            convertHTTPInvoiceCharges(httpReq.Charges),
 		AccountName:        httpReq.AccountName,
 		AccountNumber:      httpReq.AccountNumber,
 		BankName:           httpReq.BankName,
 		RoutingNumber:      httpReq.RoutingNumber,
 		Note:               httpReq.Note,
+		BrandingProfileId:  httpReq.BrandingProfileID,
 	}
 
 	// Map Invoice items from HTTP request to gRPC request with []*InvoiceItem
-	for _, item := range httpReq.Items {
-		grpcReq.Items = append(grpcReq.Items, &invoicepb.InvoiceItem{
-			Description: item.Description,
-			Quantity:    item.Quantity,
-			UnitPrice:   item.UnitPrice,
-		})
-	}
+	grpcReq.Items = convertHTTPInvoiceItems(httpReq.Items)
 
 	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
 	defer cancel()
@@ -65,7 +67,7 @@ func (h *Handler) CreateInvoiceHandler(w http.ResponseWriter, r *http.Request) {
 	client := invoicepb.NewInvoiceServiceClient(conn)
 	grpcRes, err := client.CreateInvoice(ctx, grpcReq)
 	if err != nil {
-		h.serverErrorResponse(w, r, err)
+		h.grpcErrorResponse(w, r, err)
 		return
 	}
 
@@ -111,19 +113,49 @@ func (h *Handler) GetInvoiceHandler(w http.ResponseWriter, r *http.Request) {
 		InvoiceID:          grpcRes.Invoice.Id,
 		UserID:             grpcRes.Invoice.UserId,
 		CustomerID:         grpcRes.Invoice.CustomerId,
+		InvoiceNumber:      grpcRes.Invoice.InvoiceNumber,
+		Status:             grpcRes.Invoice.Status,
 		IssueDate:          grpcRes.Invoice.IssueDate.AsTime(),
 		DueDate:            grpcRes.Invoice.DueDate.AsTime(),
 		Currency:           grpcRes.Invoice.Currency,
 		Items:              convertInvoiceItems(grpcRes.Invoice.Items),
 		DiscountPercentage: grpcRes.Invoice.DiscountPercentage,
+		Subtotal:           grpcRes.Invoice.Subtotal,
+		DiscountAmount:     grpcRes.Invoice.DiscountAmount,
+		FixedDiscount:      grpcRes.Invoice.FixedDiscount,
+		Charges:            convertInvoiceCharges(grpcRes.Invoice.Charges),
+		ChargeTotal:        grpcRes.Invoice.ChargeTotal,
+		Taxes:              convertInvoiceTaxes(grpcRes.Invoice.Taxes),
+		TaxTotal:           grpcRes.Invoice.TaxTotal,
+		Total:              grpcRes.Invoice.Total,
+		AmountPaid:         grpcRes.Invoice.AmountPaid,
+		AmountCredited:     grpcRes.Invoice.AmountCredited,
+		CreditNoteTotal:    grpcRes.Invoice.CreditNoteTotal,
+		LateFees:           convertLateFees(grpcRes.Invoice.LateFees),
+		LateFeeTotal:       grpcRes.Invoice.LateFeeTotal,
+		BalanceDue:         grpcRes.Invoice.BalanceDue,
+		BaseCurrency:       grpcRes.Invoice.BaseCurrency,
+		ExchangeRate:       grpcRes.Invoice.ExchangeRate,
+		RecurringInvoiceID: grpcRes.Invoice.RecurringInvoiceId,
+		QuoteID:            grpcRes.Invoice.QuoteId,
+		BrandingProfileID:  grpcRes.Invoice.BrandingProfileId,
 		AccountName:        grpcRes.Invoice.AccountName,
 		AccountNumber:      grpcRes.Invoice.AccountNumber,
 		BankName:           grpcRes.Invoice.BankName,
 		RoutingNumber:      grpcRes.Invoice.RoutingNumber,
 		Note:               grpcRes.Invoice.Note,
+		Version:            grpcRes.Invoice.Version,
+	}
+	if grpcRes.Invoice.ArchivedAt != nil {
+		archivedAt := grpcRes.Invoice.ArchivedAt.AsTime()
+		invoiceResp.ArchivedAt = &archivedAt
 	}
 
-	err = h.encodeJSON(w, http.StatusOK, envelope{"invoice": invoiceResp}, nil)
+	// The ETag lets clients make their next update conditional on this version
+	headers := make(http.Header)
+	headers.Set("ETag", invoiceETag(grpcRes.Invoice.Version))
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"invoice": invoiceResp}, headers)
 	if err != nil {
 		h.serverErrorResponse(w, r, err)
 	}
@@ -137,6 +169,13 @@ func (h *Handler) UpdateInvoiceHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// An If-Match header makes the update conditional on the version the client last read
+	version, conditional := h.readIfMatch(r)
+	if conditional && version == 0 {
+		h.preconditionFailedResponse(w, r)
+		return
+	}
+
 	// Decode the JSON body into the HTTP request struct
 	var httpReq UpdateInvoiceHTTPReq
 	err = h.decodeJSON(w, r, &httpReq)
@@ -145,32 +184,74 @@ func (h *Handler) UpdateInvoiceHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Convert time.Time to protobuf Timestamp
-	issueDateProto := timestamppb.New(httpReq.IssueDate)
-	dueDateProto := timestamppb.New(httpReq.DueDate)
-
-	// Convert the HTTP request into the gRPC UpdateInvoiceRequest
+	// Convert the HTTP request into the gRPC UpdateInvoiceRequest, naming only the fields present in the body
 	grpcReq := &invoicepb.UpdateInvoiceRequest{
-		InvoiceId:          invoiceId,
-		Status:             httpReq.Status,
-		IssueDate:          issueDateProto,
-		DueDate:            dueDateProto,
-		Currency:           httpReq.Currency,
-		DiscountPercentage: httpReq.DiscountPercentage,
-		AccountName:        httpReq.AccountName,
-		AccountNumber:      httpReq.AccountNumber,
-		BankName:           httpReq.BankName,
-		RoutingNumber:      httpReq.RoutingNumber,
-		Note:               httpReq.Note,
+		InvoiceId:  invoiceId,
+		Version:    version,
+		UpdateMask: &fieldmaskpb.FieldMask{},
+	}
+	if httpReq.Status != nil {
+		grpcReq.Status = *httpReq.Status
+		grpcReq.UpdateMask.Paths = append(grpcReq.UpdateMask.Paths, "status")
+	}
+	if httpReq.IssueDate != nil {
+		grpcReq.IssueDate = timestamppb.New(*httpReq.IssueDate)
+		grpcReq.UpdateMask.Paths = append(grpcReq.UpdateMask.Paths, "issue_date")
+	}
+	if httpReq.DueDate != nil {
+		grpcReq.DueDate = timestamppb.New(*httpReq.DueDate)
+		grpcReq.UpdateMask.Paths = append(grpcReq.UpdateMask.Paths, "due_date")
+	}
+	if httpReq.Currency != nil {
+		grpcReq.Currency = *httpReq.Currency
+		grpcReq.UpdateMask.Paths = append(grpcReq.UpdateMask.Paths, "currency")
+	}
+	if httpReq.Items != nil {
+		// Map Invoice items from HTTP request to gRPC request with []*InvoiceItem
+		grpcReq.Items = convertHTTPInvoiceItems(*httpReq.Items)
+		grpcReq.UpdateMask.Paths = append(grpcReq.UpdateMask.Paths, "items")
+	}
+	if httpReq.DiscountPercentage != nil {
+		grpcReq.DiscountPercentage = *httpReq.DiscountPercentage
+		grpcReq.UpdateMask.Paths = append(grpcReq.UpdateMask.Paths, "discount_percentage")
+	}
+	if httpReq.FixedDiscount != nil {
+		grpcReq.FixedDiscount = *httpReq.FixedDiscount
+		grpcReq.UpdateMask.Paths = append(grpcReq.UpdateMask.Paths, "fixed_discount")
+	}
+	if httpReq.Charges != nil {
+		grpcReq.Charges = convertHTTPInvoiceCharges(*httpReq.Charges)
+		grpcReq.UpdateMask.Paths = append(grpcReq.UpdateMask.Paths, "charges")
+	}
+	if httpReq.AccountName != nil {
+		grpcReq.AccountName = *httpReq.AccountName
+		grpcReq.UpdateMask.Paths = append(grpcReq.UpdateMask.Paths, "account_name")
+	}
+	if httpReq.AccountNumber != nil {
+		grpcReq.AccountNumber = *httpReq.AccountNumber
+		grpcReq.UpdateMask.Paths = append(grpcReq.UpdateMask.Paths, "account_number")
+	}
+	if httpReq.BankName != nil {
+		grpcReq.BankName = *httpReq.BankName
+		grpcReq.UpdateMask.Paths = append(grpcReq.UpdateMask.Paths, "bank_name")
+	}
+	if httpReq.RoutingNumber != nil {
+		grpcReq.RoutingNumber = *httpReq.RoutingNumber
+		grpcReq.UpdateMask.Paths = append(grpcReq.UpdateMask.Paths, "routing_number")
+	}
+	if httpReq.Note != nil {
+		grpcReq.Note = *httpReq.Note
+		grpcReq.UpdateMask.Paths = append(grpcReq.UpdateMask.Paths, "note")
+	}
+	if httpReq.BrandingProfileID != nil {
+		grpcReq.BrandingProfileId = *httpReq.BrandingProfileID
+		grpcReq.UpdateMask.Paths = append(grpcReq.UpdateMask.Paths, "branding_profile_id")
 	}
 
-	// Map Invoice items from HTTP request to gRPC request with []*InvoiceItem
-	for _, item := range httpReq.Items {
-		grpcReq.Items = append(grpcReq.Items, &invoicepb.InvoiceItem{
-			Description: item.Description,
-			Quantity:    item.Quantity,
-			UnitPrice:   item.UnitPrice,
-		})
+	// An empty mask would update every field, so there is nothing to do
+	if len(grpcReq.UpdateMask.Paths) == 0 {
+		h.badRequestResponse(w, r, errors.New("body must name at least one field to update"))
+		return
 	}
 
 	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
@@ -187,7 +268,12 @@ func (h *Handler) UpdateInvoiceHandler(w http.ResponseWriter, r *http.Request) {
 	client := invoicepb.NewInvoiceServiceClient(conn)
 	grpcRes, err := client.UpdateInvoice(ctx, grpcReq)
 	if err != nil {
-		h.serverErrorResponse(w, r, err)
+		// A conditional update that lost the race failed its precondition; otherwise it is a plain conflict
+		if conditional && status.Code(err) == codes.Aborted {
+			h.preconditionFailedResponse(w, r)
+			return
+		}
+		h.grpcErrorResponse(w, r, err)
 		return
 	}
 
@@ -195,9 +281,272 @@ func (h *Handler) UpdateInvoiceHandler(w http.ResponseWriter, r *http.Request) {
 	updateInvResp := UpdateInvoiceHTTPResp{
 		InvoiceID: grpcRes.InvoiceId,
 		Message:   grpcRes.Message,
+		Version:   grpcRes.Invoice.Version,
+	}
+
+	headers := make(http.Header)
+	headers.Set("ETag", invoiceETag(grpcRes.Invoice.Version))
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"invoice": updateInvResp}, headers)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) FinalizeInvoiceHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.FinalizeInvoice(ctx, &invoicepb.FinalizeInvoiceRequest{InvoiceId: invoiceId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"invoice": convertInvoice(grpcRes.Invoice)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) VoidInvoiceHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq VoidInvoiceHTTPReq
+	err = h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.VoidInvoice(ctx, &invoicepb.VoidInvoiceRequest{InvoiceId: invoiceId, UserId: user.Id, Reason: httpReq.Reason})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"invoice": convertInvoice(grpcRes.Invoice)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) DeleteInvoiceHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.DeleteInvoice(ctx, &invoicepb.DeleteInvoiceRequest{InvoiceId: invoiceId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	// Map the gRPC DeleteInvoiceResponse back to the HTTP response
+	deleteInvResp := DeleteInvoiceHTTPResp{
+		InvoiceID: grpcRes.InvoiceId,
+		Message:   grpcRes.Message,
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"invoice": deleteInvResp}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) DuplicateInvoiceHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.DuplicateInvoice(ctx, &invoicepb.DuplicateInvoiceRequest{InvoiceId: invoiceId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusCreated, envelope{"invoice": convertInvoice(grpcRes.Invoice)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) ArchiveInvoiceHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ArchiveInvoice(ctx, &invoicepb.ArchiveInvoiceRequest{InvoiceId: invoiceId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"invoice": convertInvoice(grpcRes.Invoice)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) UnarchiveInvoiceHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.UnarchiveInvoice(ctx, &invoicepb.ArchiveInvoiceRequest{InvoiceId: invoiceId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
 	}
 
-	err = h.encodeJSON(w, http.StatusOK, envelope{"invoice": updateInvResp}, nil)
+	err = h.encodeJSON(w, http.StatusOK, envelope{"invoice": convertInvoice(grpcRes.Invoice)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) MarkInvoicePaidHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.MarkPaid(ctx, &invoicepb.MarkPaidRequest{InvoiceId: invoiceId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"invoice": convertInvoice(grpcRes.Invoice)}, nil)
 	if err != nil {
 		h.serverErrorResponse(w, r, err)
 	}
@@ -209,15 +558,13 @@ func (h *Handler) GetInvoicesHandler(w http.ResponseWriter, r *http.Request) {
 
 	// Read url query params
 	qs := r.URL.Query()
-	pageSize := h.ReadInt(qs, "page_size", 10)
-	pageToken := h.ReadString(qs, "page_token", "")
-
-	// Convert the HTTP request into the gRPC ListInvoicesRequest
-	grpcReq := &invoicepb.ListInvoicesRequest{
-		UserId:    user.Id,
-		PageSize:  int32(pageSize),
-		PageToken: pageToken,
+	grpcReq, err := h.readInvoiceFilters(qs, user.Id)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
 	}
+	grpcReq.PageSize = int32(h.ReadInt(qs, "page_size", 10))
+	grpcReq.PageToken = h.ReadString(qs, "page_token", "")
 
 	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
 	defer cancel()
@@ -231,9 +578,9 @@ func (h *Handler) GetInvoicesHandler(w http.ResponseWriter, r *http.Request) {
 	defer conn.Close()
 
 	client := invoicepb.NewInvoiceServiceClient(conn)
-	grpcRes, err := client.ListInvoices(context.Background(), grpcReq)
+	grpcRes, err := client.ListInvoices(ctx, grpcReq)
 	if err != nil {
-		h.serverErrorResponse(w, r, err)
+		h.grpcErrorResponse(w, r, err)
 		return
 	}
 
@@ -249,6 +596,44 @@ func (h *Handler) GetInvoicesHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// readInvoiceFilters reads the url query params that filter and sort an invoice list into a ListInvoicesRequest
+func (h *Handler) readInvoiceFilters(qs url.Values, userID int64) (*invoicepb.ListInvoicesRequest, error) {
+	grpcReq := &invoicepb.ListInvoicesRequest{
+		UserId:   userID,
+		Statuses: h.ReadCSV(qs, "status"),
+		Currency: h.ReadString(qs, "currency", ""),
+		Sort:     h.ReadString(qs, "sort", ""),
+	}
+
+	// Read the filters that must parse
+	var err error
+	if grpcReq.CustomerId, err = h.ReadInt64(qs, "customer_id"); err != nil {
+		return nil, err
+	}
+	if grpcReq.MinTotal, err = h.ReadInt64(qs, "min_total"); err != nil {
+		return nil, err
+	}
+	if grpcReq.MaxTotal, err = h.ReadInt64(qs, "max_total"); err != nil {
+		return nil, err
+	}
+	if grpcReq.IssueDateFrom, err = h.ReadDate(qs, "issue_date_from"); err != nil {
+		return nil, err
+	}
+	if grpcReq.IssueDateTo, err = h.ReadDate(qs, "issue_date_to"); err != nil {
+		return nil, err
+	}
+	if grpcReq.DueDateFrom, err = h.ReadDate(qs, "due_date_from"); err != nil {
+		return nil, err
+	}
+	if grpcReq.DueDateTo, err = h.ReadDate(qs, "due_date_to"); err != nil {
+		return nil, err
+	}
+	if grpcReq.IncludeArchived, err = h.ReadBool(qs, "include_archived"); err != nil {
+		return nil, err
+	}
+	return grpcReq, nil
+}
+
 func (h *Handler) ScheduleInvoiceReminderHandler(w http.ResponseWriter, r *http.Request) {
 	// Extract invoice ID param
 	invoiceId, err := h.readIDParam(r)
@@ -394,24 +779,69 @@ func (h *Handler) SendInvoiceHandler(w http.ResponseWriter, r *http.Request) {
 
 // Struct to capture the HTTP request JSON data
 type CreateInvoiceHTTPReq struct {
-	CustomerID         int64         `json:"customer_id"`
-	IssueDate          time.Time     `json:"issue_date"`
-	DueDate            time.Time     `json:"due_date"`
-	Currency           string        `json:"currency"`
-	Items              []InvoiceItem `json:"items"`
-	DiscountPercentage int64         `json:"discount_percentage"`
-	AccountName        string        `json:"account_name"`
-	AccountNumber      string        `json:"account_number"`
-	BankName           string        `json:"bank_name"`
-	RoutingNumber      string        `json:"routing_number"`
-	Note               string        `json:"note"`
+	CustomerID         int64                `json:"customer_id"`
+	IssueDate          time.Time            `json:"issue_date"`
+	DueDate            time.Time            `json:"due_date"`
+	Currency           string               `json:"currency"`
+	Items              []InvoiceItemHTTPReq `json:"items"`
+	DiscountPercentage int64                `json:"discount_percentage"`
+	FixedDiscount      int64                `json:"fixed_discount"`
+	Charges            []InvoiceChargeHTTP  `json:"charges"`
+	AccountName        string               `json:"account_name"`
+	AccountNumber      string               `json:"account_number"`
+	BankName           string               `json:"bank_name"`
+	RoutingNumber      string               `json:"routing_number"`
+	Note               string               `json:"note"`
+	BrandingProfileID  int64                `json:"branding_profile_id"`
+}
+
+// Struct for the items of an invoice create or update request. Items with a CatalogItemID take the description,
+// unit and SKU they leave empty and the price and tax rates they leave out from the catalog, so a price of 0 or
+// an empty tax_rate_ids list overrides the catalog item's.
+type InvoiceItemHTTPReq struct {
+	CatalogItemID      int64    `json:"catalog_item_id"`
+	SKU                string   `json:"sku"`
+	Description        string   `json:"description"`
+	Quantity           int32    `json:"quantity"`
+	Unit               string   `json:"unit"`
+	UnitPrice          *int64   `json:"price"`
+	DiscountPercentage int64    `json:"discount_percentage"`
+	FixedDiscount      int64    `json:"fixed_discount"`
+	TaxRateIDs         *[]int64 `json:"tax_rate_ids"`
 }
 
-// Struct for invoice items
+// Struct for invoice items. DiscountAmount and Amount, the line amount after its discount, are only set in
+// responses.
 type InvoiceItem struct {
-	Description string `json:"description"`
-	Quantity    int32  `json:"quantity"`
-	UnitPrice   int64  `json:"price"`
+	CatalogItemID      int64   `json:"catalog_item_id,omitempty"`
+	SKU                string  `json:"sku,omitempty"`
+	Description        string  `json:"description"`
+	Quantity           int32   `json:"quantity"`
+	Unit               string  `json:"unit,omitempty"`
+	UnitPrice          int64   `json:"price"`
+	DiscountPercentage int64   `json:"discount_percentage,omitempty"`
+	FixedDiscount      int64   `json:"fixed_discount,omitempty"`
+	DiscountAmount     int64   `json:"discount_amount,omitempty"`
+	Amount             int64   `json:"amount,omitempty"`
+	TaxRateIDs         []int64 `json:"tax_rate_ids,omitempty"`
+}
+
+// Struct for extra invoice charges, such as shipping
+type InvoiceChargeHTTP struct {
+	Description string  `json:"description"`
+	Amount      int64   `json:"amount"`
+	TaxRateIDs  []int64 `json:"tax_rate_ids,omitempty"`
+}
+
+// Struct to represent a line of an invoice's tax breakdown
+type InvoiceTaxHTTP struct {
+	TaxRateID     int64  `json:"tax_rate_id"`
+	Name          string `json:"name"`
+	Rate          int64  `json:"rate"`
+	Inclusive     bool   `json:"inclusive"`
+	Compound      bool   `json:"compound"`
+	TaxableAmount int64  `json:"taxable_amount"`
+	Amount        int64  `json:"amount"`
 }
 
 // Struct to capture the HTTP response
@@ -421,40 +851,73 @@ type CreateInvoiceHTTPResp struct {
 
 // Struct to capture the HTTP response
 type GetInvoiceHTTPResp struct {
-	InvoiceID          int64         `json:"invoice_id"`
-	UserID             int64         `json:"user_id"`
-	CustomerID         int64         `json:"customer_id"`
-	IssueDate          time.Time     `json:"issue_date"`
-	DueDate            time.Time     `json:"due_date"`
-	Currency           string        `json:"currency"`
-	Items              []InvoiceItem `json:"items"`
-	DiscountPercentage int64         `json:"discount_percentage"`
-	AccountName        string        `json:"account_name"`
-	AccountNumber      string        `json:"account_number"`
-	BankName           string        `json:"bank_name"`
-	RoutingNumber      string        `json:"routing_number"`
-	Note               string        `json:"note"`
+	InvoiceID          int64               `json:"invoice_id"`
+	UserID             int64               `json:"user_id"`
+	CustomerID         int64               `json:"customer_id"`
+	InvoiceNumber      string              `json:"invoice_number"`
+	Status             string              `json:"status"`
+	IssueDate          time.Time           `json:"issue_date"`
+	DueDate            time.Time           `json:"due_date"`
+	Currency           string              `json:"currency"`
+	Items              []InvoiceItem       `json:"items"`
+	DiscountPercentage int64               `json:"discount_percentage"`
+	Subtotal           int64               `json:"subtotal"`
+	DiscountAmount     int64               `json:"discount_amount"`
+	FixedDiscount      int64               `json:"fixed_discount"`
+	Charges            []InvoiceChargeHTTP `json:"charges"`
+	ChargeTotal        int64               `json:"charge_total"`
+	Taxes              []InvoiceTaxHTTP    `json:"taxes"`
+	TaxTotal           int64               `json:"tax_total"`
+	Total              int64               `json:"total"`
+	AmountPaid         int64               `json:"amount_paid"`
+	AmountCredited     int64               `json:"amount_credited"`
+	CreditNoteTotal    int64               `json:"credit_note_total"`
+	LateFees           []LateFeeHTTP       `json:"late_fees"`
+	LateFeeTotal       int64               `json:"late_fee_total"`
+	BalanceDue         int64               `json:"balance_due"`
+	BaseCurrency       string              `json:"base_currency,omitempty"`
+	ExchangeRate       string              `json:"exchange_rate,omitempty"`
+	RecurringInvoiceID int64               `json:"recurring_invoice_id,omitempty"`
+	QuoteID            int64               `json:"quote_id,omitempty"`
+	BrandingProfileID  int64               `json:"branding_profile_id,omitempty"`
+	AccountName        string              `json:"account_name"`
+	AccountNumber      string              `json:"account_number"`
+	BankName           string              `json:"bank_name"`
+	RoutingNumber      string              `json:"routing_number"`
+	Note               string              `json:"note"`
+	Version            int64               `json:"version"`
+	ArchivedAt         *time.Time          `json:"archived_at,omitempty"`
 }
 
-// Struct to capture the HTTP request JSON data
+// Struct to capture the HTTP request JSON data. Fields left out of the body are nil and stay unchanged.
 type UpdateInvoiceHTTPReq struct {
-	Status             string        `json:"status"`
-	IssueDate          time.Time     `json:"issue_date"`
-	DueDate            time.Time     `json:"due_date"`
-	Currency           string        `json:"currency"`
-	Items              []InvoiceItem `json:"items"`
-	DiscountPercentage int64         `json:"discount_percentage"`
-	AccountName        string        `json:"account_name"`
-	AccountNumber      string        `json:"account_number"`
-	BankName           string        `json:"bank_name"`
-	RoutingNumber      string        `json:"routing_number"`
-	Note               string        `json:"note"`
+	Status             *string               `json:"status"`
+	IssueDate          *time.Time            `json:"issue_date"`
+	DueDate            *time.Time            `json:"due_date"`
+	Currency           *string               `json:"currency"`
+	Items              *[]InvoiceItemHTTPReq `json:"items"`
+	DiscountPercentage *int64                `json:"discount_percentage"`
+	FixedDiscount      *int64                `json:"fixed_discount"`
+	Charges            *[]InvoiceChargeHTTP  `json:"charges"`
+	AccountName        *string               `json:"account_name"`
+	AccountNumber      *string               `json:"account_number"`
+	BankName           *string               `json:"bank_name"`
+	RoutingNumber      *string               `json:"routing_number"`
+	Note               *string               `json:"note"`
+	BrandingProfileID  *int64                `json:"branding_profile_id"`
+}
+
+// Struct to capture the HTTP response
+type DeleteInvoiceHTTPResp struct {
+	InvoiceID int64  `json:"invoice_id"`
+	Message   string `json:"message"`
 }
 
 // Struct to capture the HTTP response
 type UpdateInvoiceHTTPResp struct {
 	InvoiceID int64  `json:"invoice_id"`
 	Message   string `json:"message"`
+	Version   int64  `json:"version"`
 }
 
 // Struct to capture the HTTP response
@@ -465,19 +928,47 @@ type GetInvoicesHTTPResp struct {
 
 // Struct to represent an Invoice in the HTTP response
 type InvoiceHTTP struct {
-	InvoiceID          int64         `json:"invoice_id"`
-	UserID             int64         `json:"user_id"`
-	CustomerID         int64         `json:"customer_id"`
-	IssueDate          time.Time     `json:"issue_date"`
-	DueDate            time.Time     `json:"due_date"`
-	Currency           string        `json:"currency"`
-	Items              []InvoiceItem `json:"items"`
-	DiscountPercentage int64         `json:"discount_percentage"`
-	AccountName        string        `json:"account_name"`
-	AccountNumber      string        `json:"account_number"`
-	BankName           string        `json:"bank_name"`
-	RoutingNumber      string        `json:"routing_number"`
-	Note               string        `json:"note"`
+	InvoiceID          int64               `json:"invoice_id"`
+	UserID             int64               `json:"user_id"`
+	CustomerID         int64               `json:"customer_id"`
+	InvoiceNumber      string              `json:"invoice_number"`
+	Status             string              `json:"status"`
+	IssueDate          time.Time           `json:"issue_date"`
+	DueDate            time.Time           `json:"due_date"`
+	Currency           string              `json:"currency"`
+	Items              []InvoiceItem       `json:"items"`
+	DiscountPercentage int64               `json:"discount_percentage"`
+	Subtotal           int64               `json:"subtotal"`
+	DiscountAmount     int64               `json:"discount_amount"`
+	FixedDiscount      int64               `json:"fixed_discount"`
+	Charges            []InvoiceChargeHTTP `json:"charges"`
+	ChargeTotal        int64               `json:"charge_total"`
+	Taxes              []InvoiceTaxHTTP    `json:"taxes"`
+	TaxTotal           int64               `json:"tax_total"`
+	Total              int64               `json:"total"`
+	AmountPaid         int64               `json:"amount_paid"`
+	AmountCredited     int64               `json:"amount_credited"`
+	CreditNoteTotal    int64               `json:"credit_note_total"`
+	LateFees           []LateFeeHTTP       `json:"late_fees"`
+	LateFeeTotal       int64               `json:"late_fee_total"`
+	BalanceDue         int64               `json:"balance_due"`
+	BaseCurrency       string              `json:"base_currency,omitempty"`
+	ExchangeRate       string              `json:"exchange_rate,omitempty"`
+	RecurringInvoiceID int64               `json:"recurring_invoice_id,omitempty"`
+	QuoteID            int64               `json:"quote_id,omitempty"`
+	BrandingProfileID  int64               `json:"branding_profile_id,omitempty"`
+	AccountName        string              `json:"account_name"`
+	AccountNumber      string              `json:"account_number"`
+	BankName           string              `json:"bank_name"`
+	RoutingNumber      string              `json:"routing_number"`
+	Note               string              `json:"note"`
+	Version            int64               `json:"version"`
+	ArchivedAt         *time.Time          `json:"archived_at,omitempty"`
+}
+
+// Struct to capture the HTTP request JSON data
+type VoidInvoiceHTTPReq struct {
+	Reason string `json:"reason"`
 }
 
 // Struct to capture the HTTP response
@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *Handler) GetLateFeePolicyHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.GetLateFeePolicy(ctx, &invoicepb.GetLateFeePolicyRequest{UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"late_fee_policy": convertLateFeePolicy(grpcRes.Policy)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) UpdateLateFeePolicyHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq UpdateLateFeePolicyHTTPReq
+	err := h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.SetLateFeePolicy(ctx, &invoicepb.SetLateFeePolicyRequest{
+		UserId:    user.Id,
+		Kind:      httpReq.Kind,
+		Amount:    httpReq.Amount,
+		Rate:      httpReq.Rate,
+		GraceDays: httpReq.GraceDays,
+		Cap:       httpReq.Cap,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"late_fee_policy": convertLateFeePolicy(grpcRes.Policy)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) DeleteLateFeePolicyHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.DeleteLateFeePolicy(ctx, &invoicepb.DeleteLateFeePolicyRequest{UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"message": grpcRes.Message}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetLateFeesHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ListLateFees(ctx, &invoicepb.ListLateFeesRequest{InvoiceId: invoiceId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"late_fees": convertLateFees(grpcRes.LateFees)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) ReverseLateFeeHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract late fee ID param
+	lateFeeId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ReverseLateFee(ctx, &invoicepb.ReverseLateFeeRequest{LateFeeId: lateFeeId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"late_fee": convertLateFee(grpcRes.LateFee), "invoice": convertInvoice(grpcRes.Invoice)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// Convert a gRPC LateFeePolicy to an HTTP LateFeePolicy
+func convertLateFeePolicy(policy *invoicepb.LateFeePolicy) LateFeePolicyHTTP {
+	return LateFeePolicyHTTP{
+		Kind:      policy.Kind,
+		Amount:    policy.Amount,
+		Rate:      policy.Rate,
+		GraceDays: policy.GraceDays,
+		Cap:       policy.Cap,
+		UpdatedAt: policy.UpdatedAt.AsTime(),
+	}
+}
+
+// Convert gRPC LateFees to HTTP LateFees
+func convertLateFees(fees []*invoicepb.LateFee) []LateFeeHTTP {
+	httpFees := make([]LateFeeHTTP, len(fees))
+	for i, fee := range fees {
+		httpFees[i] = convertLateFee(fee)
+	}
+	return httpFees
+}
+
+// Convert a gRPC LateFee to an HTTP LateFee
+func convertLateFee(fee *invoicepb.LateFee) LateFeeHTTP {
+	httpFee := LateFeeHTTP{
+		LateFeeID:   fee.Id,
+		InvoiceID:   fee.InvoiceId,
+		Kind:        fee.Kind,
+		Description: fee.Description,
+		Amount:      fee.Amount,
+		PeriodStart: fee.PeriodStart.AsTime(),
+		PeriodEnd:   fee.PeriodEnd.AsTime(),
+		CreatedAt:   fee.CreatedAt.AsTime(),
+	}
+	if fee.ReversedAt != nil {
+		reversedAt := fee.ReversedAt.AsTime()
+		httpFee.ReversedAt = &reversedAt
+	}
+	return httpFee
+}
+
+// Struct to capture the HTTP request JSON data
+type UpdateLateFeePolicyHTTPReq struct {
+	Kind      string `json:"kind"`
+	Amount    int64  `json:"amount"`
+	Rate      int64  `json:"rate"`
+	GraceDays int32  `json:"grace_days"`
+	Cap       int64  `json:"cap"`
+}
+
+// Struct to represent the late fee policy in the HTTP response
+type LateFeePolicyHTTP struct {
+	Kind      string    `json:"kind"`
+	Amount    int64     `json:"amount"`
+	Rate      int64     `json:"rate"`
+	GraceDays int32     `json:"grace_days"`
+	Cap       int64     `json:"cap"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Struct to represent a late fee in the HTTP response
+type LateFeeHTTP struct {
+	LateFeeID   int64      `json:"late_fee_id"`
+	InvoiceID   int64      `json:"invoice_id"`
+	Kind        string     `json:"kind"`
+	Description string     `json:"description"`
+	Amount      int64      `json:"amount"`
+	PeriodStart time.Time  `json:"period_start"`
+	PeriodEnd   time.Time  `json:"period_end"`
+	ReversedAt  *time.Time `json:"reversed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
@@ -2,13 +2,16 @@ package handler
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 
 	userpb "github.com/emzola/numer/user-service/proto"
 	"github.com/golang-jwt/jwt/v5"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 func (h *Handler) authMiddleware(next http.HandlerFunc, userServiceConn *grpc.ClientConn) http.HandlerFunc {
@@ -55,3 +58,29 @@ func (h *Handler) authMiddleware(next http.HandlerFunc, userServiceConn *grpc.Cl
 		next.ServeHTTP(w, r)
 	})
 }
+
+// maxIdempotencyKeyLength is the longest Idempotency-Key header accepted.
+const maxIdempotencyKeyLength = 255
+
+// idempotencyMiddleware passes the Idempotency-Key header, if any, on to the invoice service along with the user
+// it belongs to, so a retried request returns the original result instead of repeating the change. It must run
+// after authMiddleware.
+func (h *Handler) idempotencyMiddleware(next http.HandlerFunc) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := r.Header.Get("Idempotency-Key")
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		// The key is sent as gRPC metadata, so it must be printable ASCII
+		if len(key) > maxIdempotencyKeyLength || strings.IndexFunc(key, func(c rune) bool { return c < ' ' || c > '~' }) >= 0 {
+			h.badRequestResponse(w, r, fmt.Errorf("the Idempotency-Key header must be at most %d printable ASCII characters", maxIdempotencyKeyLength))
+			return
+		}
+
+		user := h.contextGetUser(r)
+		ctx := metadata.AppendToOutgoingContext(r.Context(), "idempotency-key", key, "user-id", strconv.FormatInt(user.Id, 10))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,100 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *Handler) GetNumberingSettingsHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.GetNumberingScheme(ctx, &invoicepb.GetNumberingSchemeRequest{UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"numbering_settings": convertNumberingScheme(grpcRes)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) UpdateNumberingSettingsHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq UpdateNumberingSettingsHTTPReq
+	err := h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.UpdateNumberingScheme(ctx, &invoicepb.UpdateNumberingSchemeRequest{
+		UserId:      user.Id,
+		Format:      httpReq.Format,
+		YearlyReset: httpReq.YearlyReset,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"numbering_settings": convertNumberingScheme(grpcRes)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// Convert a gRPC numbering scheme to an HTTP numbering settings response
+func convertNumberingScheme(scheme *invoicepb.NumberingSchemeResponse) NumberingSettingsHTTP {
+	return NumberingSettingsHTTP{
+		Format:            scheme.Format,
+		YearlyReset:       scheme.YearlyReset,
+		NextInvoiceNumber: scheme.NextInvoiceNumber,
+	}
+}
+
+// Struct to capture the HTTP request JSON data
+type UpdateNumberingSettingsHTTPReq struct {
+	Format      string `json:"format"`
+	YearlyReset bool   `json:"yearly_reset"`
+}
+
+// Struct to represent the numbering settings in the HTTP response
+type NumberingSettingsHTTP struct {
+	Format            string `json:"format"`
+	YearlyReset       bool   `json:"yearly_reset"`
+	NextInvoiceNumber string `json:"next_invoice_number"`
+}
@@ -0,0 +1,178 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) RecordPaymentHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq RecordPaymentHTTPReq
+	err = h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	// Convert the HTTP request into the gRPC RecordPaymentRequest
+	grpcReq := &invoicepb.RecordPaymentRequest{
+		InvoiceId: invoiceId,
+		UserId:    user.Id,
+		Amount:    httpReq.Amount,
+		Method:    httpReq.Method,
+		Reference: httpReq.Reference,
+	}
+	if !httpReq.PaymentDate.IsZero() {
+		grpcReq.PaymentDate = timestamppb.New(httpReq.PaymentDate)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.RecordPayment(ctx, grpcReq)
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusCreated, envelope{"payment": convertPayment(grpcRes.Payment), "invoice": convertInvoice(grpcRes.Invoice)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetPaymentsHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ListPayments(ctx, &invoicepb.ListPaymentsRequest{InvoiceId: invoiceId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	// Map the gRPC ListPaymentsResponse to the HTTP response
+	payments := make([]PaymentHTTP, len(grpcRes.Payments))
+	for i, payment := range grpcRes.Payments {
+		payments[i] = convertPayment(payment)
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"payments": payments}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) RefundPaymentHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract payment ID param
+	paymentId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.RefundPayment(ctx, &invoicepb.RefundPaymentRequest{PaymentId: paymentId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"payment": convertPayment(grpcRes.Payment), "invoice": convertInvoice(grpcRes.Invoice)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// Convert a gRPC Payment to an HTTP Payment
+func convertPayment(payment *invoicepb.Payment) PaymentHTTP {
+	httpPayment := PaymentHTTP{
+		PaymentID:   payment.Id,
+		InvoiceID:   payment.InvoiceId,
+		Amount:      payment.Amount,
+		Method:      payment.Method,
+		Reference:   payment.Reference,
+		PaymentDate: payment.PaymentDate.AsTime(),
+	}
+	if payment.RefundedAt != nil {
+		refundedAt := payment.RefundedAt.AsTime()
+		httpPayment.RefundedAt = &refundedAt
+	}
+	return httpPayment
+}
+
+// Struct to capture the HTTP request JSON data
+type RecordPaymentHTTPReq struct {
+	Amount      int64     `json:"amount"`
+	Method      string    `json:"method"`
+	Reference   string    `json:"reference"`
+	PaymentDate time.Time `json:"payment_date"`
+}
+
+// Struct to represent a Payment in the HTTP response
+type PaymentHTTP struct {
+	PaymentID   int64      `json:"payment_id"`
+	InvoiceID   int64      `json:"invoice_id"`
+	Amount      int64      `json:"amount"`
+	Method      string     `json:"method"`
+	Reference   string     `json:"reference"`
+	PaymentDate time.Time  `json:"payment_date"`
+	RefundedAt  *time.Time `json:"refunded_at,omitempty"`
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+	userpb "github.com/emzola/numer/user-service/proto"
+)
+
+func (h *Handler) GetInvoicePDFHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to user service
+	userConn, err := grpcutil.ServiceConnection(ctx, "user-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer userConn.Close()
+
+	userClient := userpb.NewUserServiceClient(userConn)
+
+	// Create gRPC connection to invoice service
+	invConn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer invConn.Close()
+
+	invClient := invoicepb.NewInvoiceServiceClient(invConn)
+
+	// Fetch the customer named on the invoice
+	invoiceResp, err := invClient.GetInvoice(ctx, &invoicepb.GetInvoiceRequest{InvoiceId: invoiceId})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+	if invoiceResp.Invoice.UserId != user.Id {
+		h.notFoundResponse(w, r)
+		return
+	}
+	customerResp, err := userClient.GetCustomer(ctx, &userpb.GetCustomerRequest{CustomerId: invoiceResp.Invoice.CustomerId})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	// Call the RenderInvoicePDF gRPC method
+	grpcRes, err := invClient.RenderInvoicePDF(ctx, &invoicepb.RenderInvoicePDFRequest{
+		InvoiceId: invoiceId,
+		UserId:    user.Id,
+		Issuer:    &invoicepb.Party{Email: user.Email},
+		Customer: &invoicepb.Party{
+			Name:    customerResp.Customer.Name,
+			Email:   customerResp.Customer.Email,
+			Address: customerResp.Customer.Address,
+		},
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	// Write the PDF rather than a JSON envelope
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", grpcRes.Filename))
+	w.Header().Set("Content-Length", strconv.Itoa(len(grpcRes.Pdf)))
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(grpcRes.Pdf)
+	if err != nil {
+		h.logError(r, err)
+	}
+}
@@ -0,0 +1,375 @@
+package handler
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	"github.com/emzola/numer/invoice-service/pkg/format"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+	userpb "github.com/emzola/numer/user-service/proto"
+	"github.com/julienschmidt/httprouter"
+)
+
+// The public invoice routes are opened by customers through a share link, without signing in. The token in the
+// path is the only credential, so a forged, expired or revoked token gets the same 404 as a missing invoice.
+
+func (h *Handler) GetPublicInvoiceHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	view, ok := h.fetchSharedInvoice(ctx, w, r, true)
+	if !ok {
+		return
+	}
+
+	setPublicHeaders(w)
+	if !wantsHTML(r) {
+		err := h.encodeJSON(w, http.StatusOK, envelope{"invoice": view}, nil)
+		if err != nil {
+			h.serverErrorResponse(w, r, err)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	err := publicInvoiceTemplate.Execute(w, view)
+	if err != nil {
+		h.logError(r, err)
+	}
+}
+
+func (h *Handler) GetPublicInvoicePDFHandler(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// The customer opened the page to get here, so the download doesn't count as another view
+	view, ok := h.fetchSharedInvoice(ctx, w, r, false)
+	if !ok {
+		return
+	}
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	// The share link was checked above, so the PDF is rendered on behalf of the invoice owner
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.RenderInvoicePDF(ctx, &invoicepb.RenderInvoicePDFRequest{
+		InvoiceId: view.invoiceID,
+		UserId:    view.userID,
+		Issuer:    &invoicepb.Party{Email: view.Issuer.Email},
+		Customer: &invoicepb.Party{
+			Name:    view.Customer.Name,
+			Email:   view.Customer.Email,
+			Address: view.Customer.Address,
+		},
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	// Write the PDF rather than a JSON envelope
+	setPublicHeaders(w)
+	w.Header().Set("Content-Type", "application/pdf")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=%q", grpcRes.Filename))
+	w.Header().Set("Content-Length", strconv.Itoa(len(grpcRes.Pdf)))
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(grpcRes.Pdf)
+	if err != nil {
+		h.logError(r, err)
+	}
+}
+
+// fetchSharedInvoice opens the invoice of the share link token in the path, along with its issuer and customer,
+// recording the view if recordView is set. It writes an error response and reports false if it fails.
+func (h *Handler) fetchSharedInvoice(ctx context.Context, w http.ResponseWriter, r *http.Request, recordView bool) (*PublicInvoiceHTTP, bool) {
+	token := httprouter.ParamsFromContext(r.Context()).ByName("token")
+
+	// Create gRPC connection to invoice service
+	invConn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return nil, false
+	}
+	defer invConn.Close()
+
+	invClient := invoicepb.NewInvoiceServiceClient(invConn)
+	grpcRes, err := invClient.ViewSharedInvoice(ctx, &invoicepb.ViewSharedInvoiceRequest{Token: token, SkipView: !recordView})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return nil, false
+	}
+
+	// Create gRPC connection to user service
+	userConn, err := grpcutil.ServiceConnection(ctx, "user-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return nil, false
+	}
+	defer userConn.Close()
+
+	userClient := userpb.NewUserServiceClient(userConn)
+	userResp, err := userClient.GetUser(ctx, &userpb.GetUserRequest{UserId: grpcRes.Invoice.UserId})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return nil, false
+	}
+	customerResp, err := userClient.GetCustomer(ctx, &userpb.GetCustomerRequest{CustomerId: grpcRes.Invoice.CustomerId})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return nil, false
+	}
+
+	view := convertPublicInvoice(grpcRes.Invoice, grpcRes.Share)
+	if grpcRes.Branding != nil {
+		view.Branding = convertPublicBranding(grpcRes.Branding)
+	}
+	view.Issuer = PublicPartyHTTP{Email: userResp.User.Email}
+	view.Customer = PublicPartyHTTP{
+		Name:    customerResp.Customer.Name,
+		Email:   customerResp.Customer.Email,
+		Address: customerResp.Customer.Address,
+	}
+	return &view, true
+}
+
+// setPublicHeaders keeps share link pages out of caches and search engines, and stops the token in the URL from
+// leaking to other sites through the Referer header.
+func setPublicHeaders(w http.ResponseWriter) {
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.Header().Set("X-Robots-Tag", "noindex")
+}
+
+// wantsHTML reports whether the client asked for the HTML view, with ?format=html or an Accept header that
+// prefers HTML as browsers send.
+func wantsHTML(r *http.Request) bool {
+	switch r.URL.Query().Get("format") {
+	case "html":
+		return true
+	case "json":
+		return false
+	}
+	return strings.Contains(r.Header.Get("Accept"), "text/html")
+}
+
+// Convert a gRPC Invoice to the read-only HTTP view a customer sees
+func convertPublicInvoice(inv *invoicepb.Invoice, share *invoicepb.InvoiceShare) PublicInvoiceHTTP {
+	return PublicInvoiceHTTP{
+		InvoiceNumber:  inv.InvoiceNumber,
+		Status:         inv.Status,
+		IssueDate:      inv.IssueDate.AsTime(),
+		DueDate:        inv.DueDate.AsTime(),
+		Currency:       inv.Currency,
+		Items:          convertInvoiceItems(inv.Items),
+		Subtotal:       inv.Subtotal,
+		DiscountAmount: inv.DiscountAmount,
+		Charges:        convertInvoiceCharges(inv.Charges),
+		Taxes:          convertInvoiceTaxes(inv.Taxes),
+		TaxTotal:       inv.TaxTotal,
+		Total:          inv.Total,
+		AmountPaid:     inv.AmountPaid,
+		AmountCredited: inv.AmountCredited,
+		LateFees:       convertPublicLateFees(inv.LateFees),
+		BalanceDue:     inv.BalanceDue,
+		AccountName:    inv.AccountName,
+		AccountNumber:  inv.AccountNumber,
+		BankName:       inv.BankName,
+		RoutingNumber:  inv.RoutingNumber,
+		Note:           inv.Note,
+		PDFURL:         "/public/invoices/" + share.Token + "/pdf",
+		LinkExpiresAt:  share.ExpiresAt.AsTime(),
+		invoiceID:      inv.Id,
+		userID:         inv.UserId,
+	}
+}
+
+// Convert a gRPC BrandingProfile to the branding a customer sees. The logo is inlined as a data URL, so the page
+// needs no further requests that would have to carry the token.
+func convertPublicBranding(profile *invoicepb.BrandingProfile) *PublicBrandingHTTP {
+	branding := &PublicBrandingHTTP{
+		AccentColor:         profile.AccentColor,
+		Footer:              profile.Footer,
+		PaymentInstructions: profile.PaymentInstructions,
+	}
+	// Only image types the invoice service accepts are inlined, since the URL is trusted by the template
+	if len(profile.Logo) > 0 && (profile.LogoContentType == "image/png" || profile.LogoContentType == "image/jpeg") {
+		branding.Logo = template.URL("data:" + profile.LogoContentType + ";base64," + base64.StdEncoding.EncodeToString(profile.Logo))
+	}
+	return branding
+}
+
+// Convert gRPC LateFees to the late fees a customer sees, leaving out reversed ones
+func convertPublicLateFees(fees []*invoicepb.LateFee) []PublicLateFeeHTTP {
+	httpFees := []PublicLateFeeHTTP{}
+	for _, fee := range fees {
+		if fee.ReversedAt == nil {
+			httpFees = append(httpFees, PublicLateFeeHTTP{Description: fee.Description, Amount: fee.Amount})
+		}
+	}
+	return httpFees
+}
+
+var publicInvoiceTemplate = template.Must(template.New("invoice").Funcs(template.FuncMap{
+	"money": format.Money,
+	"date":  format.Date,
+	"neg":   func(cents int64) int64 { return -cents },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<meta name="robots" content="noindex">
+<title>Invoice {{.InvoiceNumber}}</title>
+<style>
+body { font-family: Helvetica, Arial, sans-serif; color: #222; max-width: 760px; margin: 2em auto; padding: 0 1em; }
+h1 { margin-bottom: 0; }
+.muted { color: #777; font-size: 0.9em; }
+table { width: 100%; border-collapse: collapse; margin: 1.5em 0; }
+th, td { padding: 0.5em; text-align: left; border-bottom: 1px solid #ddd; }
+th.num, td.num { text-align: right; }
+.totals td { border: none; }
+.totals .total td { font-weight: bold; border-top: 1px solid #999; }
+.parties { display: flex; justify-content: space-between; gap: 2em; }
+.address { white-space: pre-line; }
+</style>
+</head>
+<body>
+{{- with .Branding}}{{if .Logo}}
+<img src="{{.Logo}}" alt="" style="max-width: 200px; max-height: 64px;">
+{{- end}}{{end}}
+<h1{{with .Branding}}{{if .AccentColor}} style="color: {{.AccentColor}}"{{end}}{{end}}>Invoice {{.InvoiceNumber}}</h1>
+<p class="muted">Issued {{date .IssueDate}} &middot; Due {{date .DueDate}} &middot; {{.Status}}</p>
+
+<div class="parties">
+<div><div class="muted">From</div><div>{{.Issuer.Email}}</div></div>
+<div><div class="muted">Bill to</div><div>{{.Customer.Name}}</div><div>{{.Customer.Email}}</div><div class="address">{{.Customer.Address}}</div></div>
+</div>
+
+<table>
+<thead><tr{{with .Branding}}{{if .AccentColor}} style="border-bottom: 2px solid {{.AccentColor}}"{{end}}{{end}}><th>Description</th><th class="num">Qty</th><th class="num">Unit price</th><th class="num">Amount</th></tr></thead>
+<tbody>
+{{- range .Items}}
+<tr>
+<td>{{.Description}}{{if .DiscountAmount}}<div class="muted">Discount: {{money $.Currency (neg .DiscountAmount)}}</div>{{end}}</td>
+<td class="num">{{.Quantity}}</td>
+<td class="num">{{money $.Currency .UnitPrice}}</td>
+<td class="num">{{money $.Currency .Amount}}</td>
+</tr>
+{{- end}}
+</tbody>
+</table>
+
+<table class="totals">
+<tr><td>Subtotal</td><td class="num">{{money .Currency .Subtotal}}</td></tr>
+{{- if .DiscountAmount}}
+<tr><td>Discount</td><td class="num">{{money .Currency (neg .DiscountAmount)}}</td></tr>
+{{- end}}
+{{- range .Charges}}
+<tr><td>{{.Description}}</td><td class="num">{{money $.Currency .Amount}}</td></tr>
+{{- end}}
+{{- range .Taxes}}
+<tr><td>{{.Name}}{{if .Inclusive}} (included){{end}}</td><td class="num">{{money $.Currency .Amount}}</td></tr>
+{{- end}}
+<tr class="total"><td>Total</td><td class="num">{{money .Currency .Total}}</td></tr>
+{{- range .LateFees}}
+<tr><td>{{.Description}}</td><td class="num">{{money $.Currency .Amount}}</td></tr>
+{{- end}}
+{{- if .AmountPaid}}
+<tr><td>Amount paid</td><td class="num">{{money .Currency (neg .AmountPaid)}}</td></tr>
+{{- end}}
+{{- if .AmountCredited}}
+<tr><td>Credited</td><td class="num">{{money .Currency (neg .AmountCredited)}}</td></tr>
+{{- end}}
+<tr class="total"><td>Balance due</td><td class="num">{{money .Currency .BalanceDue}}</td></tr>
+</table>
+
+{{- if or .BankName .AccountNumber (and .Branding .Branding.PaymentInstructions)}}
+<h3>Payment details</h3>
+{{- if or .BankName .AccountNumber}}
+<p>{{if .BankName}}Bank: {{.BankName}}<br>{{end}}{{if .AccountName}}Account name: {{.AccountName}}<br>{{end}}{{if .AccountNumber}}Account number: {{.AccountNumber}}<br>{{end}}{{if .RoutingNumber}}Routing number: {{.RoutingNumber}}{{end}}</p>
+{{- end}}
+{{- with .Branding}}{{if .PaymentInstructions}}
+<p class="address">{{.PaymentInstructions}}</p>
+{{- end}}{{end}}
+{{- end}}
+{{- if .Note}}
+<h3>Note</h3>
+<p class="address">{{.Note}}</p>
+{{- end}}
+
+<p><a href="{{.PDFURL}}">Download PDF</a></p>
+<p class="muted">This link expires on {{date .LinkExpiresAt}}.</p>
+{{- with .Branding}}{{if .Footer}}
+<p class="muted" style="border-top: 1px solid #ddd; padding-top: 1em;">{{.Footer}}</p>
+{{- end}}{{end}}
+</body>
+</html>
+`))
+
+// Struct to represent a late fee in the public invoice view
+type PublicLateFeeHTTP struct {
+	Description string `json:"description"`
+	Amount      int64  `json:"amount"`
+}
+
+// Struct to represent an issuer or customer in the public invoice view
+type PublicPartyHTTP struct {
+	Name    string `json:"name,omitempty"`
+	Email   string `json:"email"`
+	Address string `json:"address,omitempty"`
+}
+
+// Struct to represent the branding of the public invoice view
+type PublicBrandingHTTP struct {
+	AccentColor         string       `json:"accent_color,omitempty"`
+	Footer              string       `json:"footer,omitempty"`
+	PaymentInstructions string       `json:"payment_instructions,omitempty"`
+	Logo                template.URL `json:"logo,omitempty"` // Data URL
+}
+
+// Struct to represent the read-only invoice a share link opens
+type PublicInvoiceHTTP struct {
+	InvoiceNumber  string              `json:"invoice_number"`
+	Status         string              `json:"status"`
+	IssueDate      time.Time           `json:"issue_date"`
+	DueDate        time.Time           `json:"due_date"`
+	Currency       string              `json:"currency"`
+	Issuer         PublicPartyHTTP     `json:"issuer"`
+	Customer       PublicPartyHTTP     `json:"customer"`
+	Items          []InvoiceItem       `json:"items"`
+	Subtotal       int64               `json:"subtotal"`
+	DiscountAmount int64               `json:"discount_amount"`
+	Charges        []InvoiceChargeHTTP `json:"charges"`
+	Taxes          []InvoiceTaxHTTP    `json:"taxes"`
+	TaxTotal       int64               `json:"tax_total"`
+	Total          int64               `json:"total"`
+	AmountPaid     int64               `json:"amount_paid"`
+	AmountCredited int64               `json:"amount_credited"`
+	LateFees       []PublicLateFeeHTTP `json:"late_fees"`
+	BalanceDue     int64               `json:"balance_due"`
+	AccountName    string              `json:"account_name"`
+	AccountNumber  string              `json:"account_number"`
+	BankName       string              `json:"bank_name"`
+	RoutingNumber  string              `json:"routing_number"`
+	Note           string              `json:"note"`
+	Branding       *PublicBrandingHTTP `json:"branding,omitempty"`
+	PDFURL         string              `json:"pdf_url"`
+	LinkExpiresAt  time.Time           `json:"link_expires_at"`
+
+	invoiceID int64
+	userID    int64
+}
@@ -0,0 +1,360 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) CreateQuoteHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq CreateQuoteHTTPReq
+	err := h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	// Convert the HTTP request into the gRPC CreateQuoteRequest
+	grpcReq := &invoicepb.CreateQuoteRequest{
+		UserId:             user.Id,
+		CustomerId:         httpReq.CustomerID,
+		Currency:           httpReq.Currency,
+		DiscountPercentage: httpReq.DiscountPercentage,
+		PaymentTermsDays:   httpReq.PaymentTermsDays,
+		Note:               httpReq.Note,
+	}
+	if !httpReq.IssueDate.IsZero() {
+		grpcReq.IssueDate = timestamppb.New(httpReq.IssueDate)
+	}
+	if !httpReq.ExpiryDate.IsZero() {
+		grpcReq.ExpiryDate = timestamppb.New(httpReq.ExpiryDate)
+	}
+	for _, item := range httpReq.Items {
+		grpcReq.Items = append(grpcReq.Items, &invoicepb.InvoiceItem{
+			Description:        item.Description,
+			Quantity:           item.Quantity,
+			UnitPrice:          proto.Int64(item.UnitPrice),
+			DiscountPercentage: item.DiscountPercentage,
+			FixedDiscount:      item.FixedDiscount,
+			TaxRateIds:         item.TaxRateIDs,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.CreateQuote(ctx, grpcReq)
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusCreated, envelope{"quote": convertQuote(grpcRes.Quote)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetQuotesHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Read the optional status filter
+	status := h.ReadString(r.URL.Query(), "status", "")
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ListQuotes(ctx, &invoicepb.ListQuotesRequest{UserId: user.Id, Status: status})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	// Map the gRPC ListQuotesResponse to the HTTP response
+	quotes := make([]QuoteHTTP, len(grpcRes.Quotes))
+	for i, quote := range grpcRes.Quotes {
+		quotes[i] = convertQuote(quote)
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"quotes": quotes}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetQuoteHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract quote ID param
+	quoteId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.GetQuote(ctx, &invoicepb.QuoteRequest{
+		QuoteId: quoteId,
+		UserId:  user.Id,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"quote": convertQuote(grpcRes.Quote)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) SendQuoteHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract quote ID param
+	quoteId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.MarkQuoteSent(ctx, &invoicepb.QuoteRequest{
+		QuoteId: quoteId,
+		UserId:  user.Id,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"quote": convertQuote(grpcRes.Quote)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) AcceptQuoteHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract quote ID param
+	quoteId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.AcceptQuote(ctx, &invoicepb.QuoteRequest{
+		QuoteId: quoteId,
+		UserId:  user.Id,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"quote": convertQuote(grpcRes.Quote)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) DeclineQuoteHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract quote ID param
+	quoteId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.DeclineQuote(ctx, &invoicepb.QuoteRequest{
+		QuoteId: quoteId,
+		UserId:  user.Id,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"quote": convertQuote(grpcRes.Quote)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) ConvertQuoteHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract quote ID param
+	quoteId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ConvertQuoteToInvoice(ctx, &invoicepb.QuoteRequest{
+		QuoteId: quoteId,
+		UserId:  user.Id,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusCreated, envelope{
+		"quote":   convertQuote(grpcRes.Quote),
+		"invoice": convertInvoice(grpcRes.Invoice),
+	}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// Convert a gRPC Quote to an HTTP Quote
+func convertQuote(quote *invoicepb.Quote) QuoteHTTP {
+	return QuoteHTTP{
+		ID:                 quote.Id,
+		CustomerID:         quote.CustomerId,
+		QuoteNumber:        quote.QuoteNumber,
+		Status:             quote.Status,
+		IssueDate:          quote.IssueDate.AsTime(),
+		ExpiryDate:         quote.ExpiryDate.AsTime(),
+		Currency:           quote.Currency,
+		Items:              convertInvoiceItems(quote.Items),
+		DiscountPercentage: quote.DiscountPercentage,
+		Subtotal:           quote.Subtotal,
+		DiscountAmount:     quote.DiscountAmount,
+		Taxes:              convertInvoiceTaxes(quote.Taxes),
+		TaxTotal:           quote.TaxTotal,
+		Total:              quote.Total,
+		PaymentTermsDays:   quote.PaymentTermsDays,
+		Note:               quote.Note,
+		InvoiceID:          quote.InvoiceId,
+	}
+}
+
+// Struct to capture the HTTP request JSON data
+type CreateQuoteHTTPReq struct {
+	CustomerID         int64         `json:"customer_id"`
+	IssueDate          time.Time     `json:"issue_date"`
+	ExpiryDate         time.Time     `json:"expiry_date"`
+	Currency           string        `json:"currency"`
+	Items              []InvoiceItem `json:"items"`
+	DiscountPercentage int64         `json:"discount_percentage"`
+	PaymentTermsDays   int32         `json:"payment_terms_days"`
+	Note               string        `json:"note"`
+}
+
+// Struct to represent a quote in the HTTP response
+type QuoteHTTP struct {
+	ID                 int64            `json:"id"`
+	CustomerID         int64            `json:"customer_id"`
+	QuoteNumber        string           `json:"quote_number"`
+	Status             string           `json:"status"`
+	IssueDate          time.Time        `json:"issue_date"`
+	ExpiryDate         time.Time        `json:"expiry_date"`
+	Currency           string           `json:"currency"`
+	Items              []InvoiceItem    `json:"items"`
+	DiscountPercentage int64            `json:"discount_percentage"`
+	Subtotal           int64            `json:"subtotal"`
+	DiscountAmount     int64            `json:"discount_amount"`
+	Taxes              []InvoiceTaxHTTP `json:"taxes"`
+	TaxTotal           int64            `json:"tax_total"`
+	Total              int64            `json:"total"`
+	PaymentTermsDays   int32            `json:"payment_terms_days"`
+	Note               string           `json:"note"`
+	InvoiceID          int64            `json:"invoice_id,omitempty"`
+}
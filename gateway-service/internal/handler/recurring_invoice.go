@@ -0,0 +1,399 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+	userpb "github.com/emzola/numer/user-service/proto"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) CreateRecurringInvoiceHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq CreateRecurringInvoiceHTTPReq
+	err := h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	// Convert the HTTP request into the gRPC CreateRecurringInvoiceRequest
+	grpcReq := &invoicepb.CreateRecurringInvoiceRequest{
+		UserId:             user.Id,
+		CustomerId:         httpReq.CustomerID,
+		Currency:           httpReq.Currency,
+		DiscountPercentage: httpReq.DiscountPercentage,
+		AccountName:        httpReq.AccountName,
+		AccountNumber:      httpReq.AccountNumber,
+		BankName:           httpReq.BankName,
+		RoutingNumber:      httpReq.RoutingNumber,
+		Note:               httpReq.Note,
+		PaymentTermsDays:   httpReq.PaymentTermsDays,
+		Frequency:          httpReq.Frequency,
+		IntervalDays:       httpReq.IntervalDays,
+		MaxOccurrences:     httpReq.MaxOccurrences,
+		AutoSend:           httpReq.AutoSend,
+	}
+	if !httpReq.StartDate.IsZero() {
+		grpcReq.StartDate = timestamppb.New(httpReq.StartDate)
+	}
+	if httpReq.EndDate != nil {
+		grpcReq.EndDate = timestamppb.New(*httpReq.EndDate)
+	}
+	for _, item := range httpReq.Items {
+		grpcReq.Items = append(grpcReq.Items, &invoicepb.InvoiceItem{
+			Description:        item.Description,
+			Quantity:           item.Quantity,
+			UnitPrice:          proto.Int64(item.UnitPrice),
+			DiscountPercentage: item.DiscountPercentage,
+			FixedDiscount:      item.FixedDiscount,
+			TaxRateIds:         item.TaxRateIDs,
+		})
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Auto-sent invoices are emailed to the customer, so look up their email up front
+	if httpReq.AutoSend {
+		userConn, err := grpcutil.ServiceConnection(ctx, "user-service", h.registry)
+		if err != nil {
+			h.serverErrorResponse(w, r, err)
+			return
+		}
+		defer userConn.Close()
+
+		userClient := userpb.NewUserServiceClient(userConn)
+		customerResp, err := userClient.GetCustomer(ctx, &userpb.GetCustomerRequest{CustomerId: httpReq.CustomerID})
+		if err != nil {
+			h.grpcErrorResponse(w, r, err)
+			return
+		}
+		grpcReq.CustomerEmail = customerResp.Customer.Email
+	}
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.CreateRecurringInvoice(ctx, grpcReq)
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusCreated, envelope{"recurring_invoice": convertRecurringInvoice(grpcRes.RecurringInvoice)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetRecurringInvoicesHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ListRecurringInvoices(ctx, &invoicepb.ListRecurringInvoicesRequest{UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	// Map the gRPC ListRecurringInvoicesResponse to the HTTP response
+	recurringInvoices := make([]RecurringInvoiceHTTP, len(grpcRes.RecurringInvoices))
+	for i, recurring := range grpcRes.RecurringInvoices {
+		recurringInvoices[i] = convertRecurringInvoice(recurring)
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"recurring_invoices": recurringInvoices}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetRecurringInvoiceHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract recurring invoice ID param
+	recurringInvoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.GetRecurringInvoice(ctx, &invoicepb.RecurringInvoiceRequest{
+		RecurringInvoiceId: recurringInvoiceId,
+		UserId:             user.Id,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"recurring_invoice": convertRecurringInvoice(grpcRes.RecurringInvoice)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) PauseRecurringInvoiceHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract recurring invoice ID param
+	recurringInvoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.PauseRecurringInvoice(ctx, &invoicepb.RecurringInvoiceRequest{
+		RecurringInvoiceId: recurringInvoiceId,
+		UserId:             user.Id,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"recurring_invoice": convertRecurringInvoice(grpcRes.RecurringInvoice)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) ResumeRecurringInvoiceHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract recurring invoice ID param
+	recurringInvoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ResumeRecurringInvoice(ctx, &invoicepb.RecurringInvoiceRequest{
+		RecurringInvoiceId: recurringInvoiceId,
+		UserId:             user.Id,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"recurring_invoice": convertRecurringInvoice(grpcRes.RecurringInvoice)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) CancelRecurringInvoiceHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract recurring invoice ID param
+	recurringInvoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.CancelRecurringInvoice(ctx, &invoicepb.RecurringInvoiceRequest{
+		RecurringInvoiceId: recurringInvoiceId,
+		UserId:             user.Id,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"recurring_invoice": convertRecurringInvoice(grpcRes.RecurringInvoice)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetRecurringInvoiceInvoicesHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract recurring invoice ID param
+	recurringInvoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ListRecurringInvoiceInvoices(ctx, &invoicepb.RecurringInvoiceRequest{
+		RecurringInvoiceId: recurringInvoiceId,
+		UserId:             user.Id,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"invoices": convertInvoices(grpcRes.Invoices)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// Convert a gRPC RecurringInvoice to an HTTP RecurringInvoice
+func convertRecurringInvoice(recurring *invoicepb.RecurringInvoice) RecurringInvoiceHTTP {
+	httpRecurring := RecurringInvoiceHTTP{
+		ID:                 recurring.Id,
+		CustomerID:         recurring.CustomerId,
+		Currency:           recurring.Currency,
+		Items:              convertInvoiceItems(recurring.Items),
+		DiscountPercentage: recurring.DiscountPercentage,
+		AccountName:        recurring.AccountName,
+		AccountNumber:      recurring.AccountNumber,
+		BankName:           recurring.BankName,
+		RoutingNumber:      recurring.RoutingNumber,
+		Note:               recurring.Note,
+		PaymentTermsDays:   recurring.PaymentTermsDays,
+		Frequency:          recurring.Frequency,
+		IntervalDays:       recurring.IntervalDays,
+		StartDate:          recurring.StartDate.AsTime(),
+		MaxOccurrences:     recurring.MaxOccurrences,
+		Occurrences:        recurring.Occurrences,
+		NextRunDate:        recurring.NextRunDate.AsTime(),
+		AutoSend:           recurring.AutoSend,
+		Status:             recurring.Status,
+	}
+	if recurring.EndDate != nil {
+		endDate := recurring.EndDate.AsTime()
+		httpRecurring.EndDate = &endDate
+	}
+	return httpRecurring
+}
+
+// Struct to capture the HTTP request JSON data
+type CreateRecurringInvoiceHTTPReq struct {
+	CustomerID         int64         `json:"customer_id"`
+	Currency           string        `json:"currency"`
+	Items              []InvoiceItem `json:"items"`
+	DiscountPercentage int64         `json:"discount_percentage"`
+	AccountName        string        `json:"account_name"`
+	AccountNumber      string        `json:"account_number"`
+	BankName           string        `json:"bank_name"`
+	RoutingNumber      string        `json:"routing_number"`
+	Note               string        `json:"note"`
+	PaymentTermsDays   int32         `json:"payment_terms_days"`
+	Frequency          string        `json:"frequency"`
+	IntervalDays       int32         `json:"interval_days"`
+	StartDate          time.Time     `json:"start_date"`
+	EndDate            *time.Time    `json:"end_date"`
+	MaxOccurrences     int32         `json:"max_occurrences"`
+	AutoSend           bool          `json:"auto_send"`
+}
+
+// Struct to represent a recurring invoice in the HTTP response
+type RecurringInvoiceHTTP struct {
+	ID                 int64         `json:"id"`
+	CustomerID         int64         `json:"customer_id"`
+	Currency           string        `json:"currency"`
+	Items              []InvoiceItem `json:"items"`
+	DiscountPercentage int64         `json:"discount_percentage"`
+	AccountName        string        `json:"account_name"`
+	AccountNumber      string        `json:"account_number"`
+	BankName           string        `json:"bank_name"`
+	RoutingNumber      string        `json:"routing_number"`
+	Note               string        `json:"note"`
+	PaymentTermsDays   int32         `json:"payment_terms_days"`
+	Frequency          string        `json:"frequency"`
+	IntervalDays       int32         `json:"interval_days,omitempty"`
+	StartDate          time.Time     `json:"start_date"`
+	EndDate            *time.Time    `json:"end_date,omitempty"`
+	MaxOccurrences     int32         `json:"max_occurrences,omitempty"`
+	Occurrences        int32         `json:"occurrences"`
+	NextRunDate        time.Time     `json:"next_run_date"`
+	AutoSend           bool          `json:"auto_send"`
+	Status             string        `json:"status"`
+}
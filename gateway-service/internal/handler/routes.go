@@ -14,11 +14,96 @@ func (h *Handler) Routes(userServiceConn *grpc.ClientConn) http.Handler {
 	router.MethodNotAllowed = http.HandlerFunc(h.methodNotAllowedResponse)
 
 	router.HandlerFunc(http.MethodGet, "/invoices", h.authMiddleware(h.GetInvoicesHandler, userServiceConn))
-	router.HandlerFunc(http.MethodPost, "/invoices", h.authMiddleware(h.CreateInvoiceHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/invoices", h.authMiddleware(h.idempotencyMiddleware(h.CreateInvoiceHandler), userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/imports/invoices", h.authMiddleware(h.ImportInvoicesHandler, userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/exports/invoices", h.authMiddleware(h.ExportInvoicesHandler, userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/search", h.authMiddleware(h.SearchHandler, userServiceConn))
 	router.HandlerFunc(http.MethodGet, "/invoices/:id", h.authMiddleware(h.GetInvoiceHandler, userServiceConn))
-	router.HandlerFunc(http.MethodPatch, "/invoices/:id", h.authMiddleware(h.UpdateInvoiceHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPatch, "/invoices/:id", h.authMiddleware(h.idempotencyMiddleware(h.UpdateInvoiceHandler), userServiceConn))
 	router.HandlerFunc(http.MethodGet, "/invoices/:id/send", h.authMiddleware(h.SendInvoiceHandler, userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/invoices/:id/pdf", h.authMiddleware(h.GetInvoicePDFHandler, userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/invoices/:id/ubl", h.authMiddleware(h.GetInvoiceUBLHandler, userServiceConn))
 	router.HandlerFunc(http.MethodPost, "/invoices/:id/reminder", h.authMiddleware(h.ScheduleInvoiceReminderHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/invoices/:id/finalize", h.authMiddleware(h.idempotencyMiddleware(h.FinalizeInvoiceHandler), userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/invoices/:id/void", h.authMiddleware(h.idempotencyMiddleware(h.VoidInvoiceHandler), userServiceConn))
+	router.HandlerFunc(http.MethodDelete, "/invoices/:id", h.authMiddleware(h.idempotencyMiddleware(h.DeleteInvoiceHandler), userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/invoices/:id/duplicate", h.authMiddleware(h.idempotencyMiddleware(h.DuplicateInvoiceHandler), userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/invoices/:id/archive", h.authMiddleware(h.idempotencyMiddleware(h.ArchiveInvoiceHandler), userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/invoices/:id/unarchive", h.authMiddleware(h.idempotencyMiddleware(h.UnarchiveInvoiceHandler), userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/invoices/:id/mark-paid", h.authMiddleware(h.idempotencyMiddleware(h.MarkInvoicePaidHandler), userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/invoices/:id/payments", h.authMiddleware(h.GetPaymentsHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/invoices/:id/payments", h.authMiddleware(h.idempotencyMiddleware(h.RecordPaymentHandler), userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/payments/:id/refund", h.authMiddleware(h.idempotencyMiddleware(h.RefundPaymentHandler), userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/invoices/:id/credit-notes", h.authMiddleware(h.GetInvoiceCreditNotesHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/invoices/:id/credit-notes", h.authMiddleware(h.CreateCreditNoteHandler, userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/invoices/:id/shares", h.authMiddleware(h.GetInvoiceSharesHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/invoices/:id/shares", h.authMiddleware(h.CreateInvoiceShareHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/invoice-shares/:id/revoke", h.authMiddleware(h.RevokeInvoiceShareHandler, userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/invoices/:id/attachments", h.authMiddleware(h.GetAttachmentsHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/invoices/:id/attachments", h.authMiddleware(h.UploadAttachmentHandler, userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/attachments/:id", h.authMiddleware(h.DownloadAttachmentHandler, userServiceConn))
+	router.HandlerFunc(http.MethodDelete, "/attachments/:id", h.authMiddleware(h.DeleteAttachmentHandler, userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/invoices/:id/late-fees", h.authMiddleware(h.GetLateFeesHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/late-fees/:id/reverse", h.authMiddleware(h.ReverseLateFeeHandler, userServiceConn))
+
+	// Share links are opened by customers, who have no account
+	router.HandlerFunc(http.MethodGet, "/public/invoices/:token", h.GetPublicInvoiceHandler)
+	router.HandlerFunc(http.MethodGet, "/public/invoices/:token/pdf", h.GetPublicInvoicePDFHandler)
+
+	router.HandlerFunc(http.MethodGet, "/credit-notes", h.authMiddleware(h.GetCreditNotesHandler, userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/credit-notes/:id", h.authMiddleware(h.GetCreditNoteHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/credit-notes/:id/apply", h.authMiddleware(h.ApplyCreditNoteHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/credit-notes/:id/refund", h.authMiddleware(h.RefundCreditNoteHandler, userServiceConn))
+
+	router.HandlerFunc(http.MethodGet, "/recurring-invoices", h.authMiddleware(h.GetRecurringInvoicesHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/recurring-invoices", h.authMiddleware(h.CreateRecurringInvoiceHandler, userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/recurring-invoices/:id", h.authMiddleware(h.GetRecurringInvoiceHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/recurring-invoices/:id/pause", h.authMiddleware(h.PauseRecurringInvoiceHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/recurring-invoices/:id/resume", h.authMiddleware(h.ResumeRecurringInvoiceHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/recurring-invoices/:id/cancel", h.authMiddleware(h.CancelRecurringInvoiceHandler, userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/recurring-invoices/:id/invoices", h.authMiddleware(h.GetRecurringInvoiceInvoicesHandler, userServiceConn))
+
+	router.HandlerFunc(http.MethodGet, "/quotes", h.authMiddleware(h.GetQuotesHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/quotes", h.authMiddleware(h.CreateQuoteHandler, userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/quotes/:id", h.authMiddleware(h.GetQuoteHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/quotes/:id/send", h.authMiddleware(h.SendQuoteHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/quotes/:id/accept", h.authMiddleware(h.AcceptQuoteHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/quotes/:id/decline", h.authMiddleware(h.DeclineQuoteHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/quotes/:id/convert", h.authMiddleware(h.ConvertQuoteHandler, userServiceConn))
+
+	router.HandlerFunc(http.MethodGet, "/tax-rates", h.authMiddleware(h.GetTaxRatesHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/tax-rates", h.authMiddleware(h.CreateTaxRateHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPatch, "/tax-rates/:id", h.authMiddleware(h.UpdateTaxRateHandler, userServiceConn))
+	router.HandlerFunc(http.MethodDelete, "/tax-rates/:id", h.authMiddleware(h.DeleteTaxRateHandler, userServiceConn))
+
+	router.HandlerFunc(http.MethodGet, "/catalog-items", h.authMiddleware(h.GetCatalogItemsHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/catalog-items", h.authMiddleware(h.CreateCatalogItemHandler, userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/catalog-items/:id", h.authMiddleware(h.GetCatalogItemHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPatch, "/catalog-items/:id", h.authMiddleware(h.UpdateCatalogItemHandler, userServiceConn))
+	router.HandlerFunc(http.MethodDelete, "/catalog-items/:id", h.authMiddleware(h.DeleteCatalogItemHandler, userServiceConn))
+
+	router.HandlerFunc(http.MethodGet, "/branding-profiles", h.authMiddleware(h.GetBrandingProfilesHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/branding-profiles", h.authMiddleware(h.CreateBrandingProfileHandler, userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/branding-profiles/:id", h.authMiddleware(h.GetBrandingProfileHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPatch, "/branding-profiles/:id", h.authMiddleware(h.UpdateBrandingProfileHandler, userServiceConn))
+	router.HandlerFunc(http.MethodDelete, "/branding-profiles/:id", h.authMiddleware(h.DeleteBrandingProfileHandler, userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/branding-profiles/:id/logo", h.authMiddleware(h.GetBrandingLogoHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPut, "/branding-profiles/:id/logo", h.authMiddleware(h.UploadBrandingLogoHandler, userServiceConn))
+	router.HandlerFunc(http.MethodDelete, "/branding-profiles/:id/logo", h.authMiddleware(h.DeleteBrandingLogoHandler, userServiceConn))
+
+	router.HandlerFunc(http.MethodGet, "/currency-settings", h.authMiddleware(h.GetCurrencySettingsHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPut, "/currency-settings", h.authMiddleware(h.UpdateCurrencySettingsHandler, userServiceConn))
+	router.HandlerFunc(http.MethodGet, "/exchange-rates", h.authMiddleware(h.GetExchangeRatesHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/exchange-rates", h.authMiddleware(h.CreateExchangeRateHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/exchange-rates/import", h.authMiddleware(h.ImportExchangeRatesHandler, userServiceConn))
+	router.HandlerFunc(http.MethodDelete, "/exchange-rates/:id", h.authMiddleware(h.DeleteExchangeRateHandler, userServiceConn))
+
+	router.HandlerFunc(http.MethodGet, "/numbering-settings", h.authMiddleware(h.GetNumberingSettingsHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPut, "/numbering-settings", h.authMiddleware(h.UpdateNumberingSettingsHandler, userServiceConn))
+
+	router.HandlerFunc(http.MethodGet, "/late-fee-policy", h.authMiddleware(h.GetLateFeePolicyHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPut, "/late-fee-policy", h.authMiddleware(h.UpdateLateFeePolicyHandler, userServiceConn))
+	router.HandlerFunc(http.MethodDelete, "/late-fee-policy", h.authMiddleware(h.DeleteLateFeePolicyHandler, userServiceConn))
 
 	router.HandlerFunc(http.MethodGet, "/stats", h.authMiddleware(h.GetStatsHandler, userServiceConn))
 
@@ -31,6 +116,7 @@ func (h *Handler) Routes(userServiceConn *grpc.ClientConn) http.Handler {
 	router.HandlerFunc(http.MethodDelete, "/users/:id", h.authMiddleware(h.DeleteUserHandler, userServiceConn))
 
 	router.HandlerFunc(http.MethodPost, "/customers", h.authMiddleware(h.CreateCustomerHandler, userServiceConn))
+	router.HandlerFunc(http.MethodPost, "/imports/customers", h.authMiddleware(h.ImportCustomersHandler, userServiceConn))
 	router.HandlerFunc(http.MethodGet, "/customers/:id", h.authMiddleware(h.GetCustomerHandler, userServiceConn))
 	router.HandlerFunc(http.MethodPatch, "/customers/:id", h.authMiddleware(h.UpdateCustomerHandler, userServiceConn))
 	router.HandlerFunc(http.MethodDelete, "/customers/:id", h.authMiddleware(h.DeleteCustomerHandler, userServiceConn))
@@ -0,0 +1,138 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+	userpb "github.com/emzola/numer/user-service/proto"
+)
+
+// searchCustomerLimit is how many matching customers a search looks up. Their invoices are found along with
+// the invoices that match on their own.
+const searchCustomerLimit = 100
+
+// SearchHandler searches the user's customers and invoices. Customers match on their name and email address;
+// invoices match on their number, item descriptions and note, or on their customer.
+func (h *Handler) SearchHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Read url query params
+	qs := r.URL.Query()
+	query := h.ReadString(qs, "q", "")
+	includeArchived, err := h.ReadBool(qs, "include_archived")
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to user service
+	userConn, err := grpcutil.ServiceConnection(ctx, "user-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer userConn.Close()
+
+	// Find the matching customers first, so their invoices can be found too
+	userClient := userpb.NewUserServiceClient(userConn)
+	customerRes, err := userClient.SearchCustomers(ctx, &userpb.SearchCustomersRequest{
+		UserId: user.Id,
+		Query:  query,
+		Limit:  searchCustomerLimit,
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	// Create gRPC connection to invoice service
+	invConn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer invConn.Close()
+
+	grpcReq := &invoicepb.SearchInvoicesRequest{
+		UserId:          user.Id,
+		Query:           query,
+		PageSize:        int32(h.ReadInt(qs, "page_size", 20)),
+		PageToken:       h.ReadString(qs, "page_token", ""),
+		IncludeArchived: includeArchived,
+	}
+	for _, result := range customerRes.Results {
+		grpcReq.Customers = append(grpcReq.Customers, &invoicepb.CustomerMatch{CustomerId: result.Customer.Id, Rank: result.Rank})
+	}
+
+	invClient := invoicepb.NewInvoiceServiceClient(invConn)
+	invoiceRes, err := invClient.SearchInvoices(ctx, grpcReq)
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	// Map the gRPC responses back to the HTTP response
+	searchResp := SearchHTTPResp{
+		Customers:     make([]CustomerSearchResultHTTP, len(customerRes.Results)),
+		Invoices:      make([]InvoiceSearchResultHTTP, len(invoiceRes.Results)),
+		NextPageToken: invoiceRes.NextPageToken,
+	}
+	for i, result := range customerRes.Results {
+		searchResp.Customers[i] = CustomerSearchResultHTTP{
+			Customer:      convertCustomer(result.Customer),
+			Rank:          result.Rank,
+			NameHighlight: result.NameHighlight,
+		}
+	}
+	for i, result := range invoiceRes.Results {
+		searchResp.Invoices[i] = InvoiceSearchResultHTTP{
+			Invoice: convertInvoice(result.Invoice),
+			Rank:    result.Rank,
+			Highlights: InvoiceHighlightsHTTP{
+				InvoiceNumber: result.Highlights.GetInvoiceNumber(),
+				Note:          result.Highlights.GetNote(),
+				Items:         result.Highlights.GetItems(),
+			},
+		}
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"search": searchResp}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// Struct to represent search results in the HTTP response
+type SearchHTTPResp struct {
+	Customers     []CustomerSearchResultHTTP `json:"customers"`
+	Invoices      []InvoiceSearchResultHTTP  `json:"invoices"`
+	NextPageToken string                     `json:"next_page_token"`
+}
+
+// Struct to represent a customer found by a search in the HTTP response
+type CustomerSearchResultHTTP struct {
+	Customer      CustomerHTTPResp `json:"customer"`
+	Rank          float32          `json:"rank"`
+	NameHighlight string           `json:"name_highlight,omitempty"`
+}
+
+// Struct to represent an invoice found by a search in the HTTP response
+type InvoiceSearchResultHTTP struct {
+	Invoice    InvoiceHTTP           `json:"invoice"`
+	Rank       float32               `json:"rank"`
+	Highlights InvoiceHighlightsHTTP `json:"highlights"`
+}
+
+// Struct to represent the highlighted parts of an invoice in the HTTP response
+type InvoiceHighlightsHTTP struct {
+	InvoiceNumber string `json:"invoice_number,omitempty"`
+	Note          string `json:"note,omitempty"`
+	Items         string `json:"items,omitempty"`
+}
@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (h *Handler) CreateInvoiceShareHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq CreateInvoiceShareHTTPReq
+	err = h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	grpcReq := &invoicepb.CreateInvoiceShareRequest{InvoiceId: invoiceId, UserId: user.Id}
+	if httpReq.ExpiresAt != nil {
+		grpcReq.ExpiresAt = timestamppb.New(*httpReq.ExpiresAt)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.CreateInvoiceShare(ctx, grpcReq)
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusCreated, envelope{"share": convertInvoiceShare(grpcRes.Share)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetInvoiceSharesHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ListInvoiceShares(ctx, &invoicepb.ListInvoiceSharesRequest{InvoiceId: invoiceId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	shares := make([]InvoiceShareHTTP, len(grpcRes.Shares))
+	for i, share := range grpcRes.Shares {
+		shares[i] = convertInvoiceShare(share)
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"shares": shares}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) RevokeInvoiceShareHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract share ID param
+	shareId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.RevokeInvoiceShare(ctx, &invoicepb.RevokeInvoiceShareRequest{ShareId: shareId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"share": convertInvoiceShare(grpcRes.Share)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// Convert a gRPC InvoiceShare to an HTTP InvoiceShare
+func convertInvoiceShare(share *invoicepb.InvoiceShare) InvoiceShareHTTP {
+	httpShare := InvoiceShareHTTP{
+		ShareID:   share.Id,
+		InvoiceID: share.InvoiceId,
+		Token:     share.Token,
+		ExpiresAt: share.ExpiresAt.AsTime(),
+		ViewCount: share.ViewCount,
+		CreatedAt: share.CreatedAt.AsTime(),
+	}
+	if share.Token != "" {
+		httpShare.URL = "/public/invoices/" + share.Token
+	}
+	if share.RevokedAt != nil {
+		revokedAt := share.RevokedAt.AsTime()
+		httpShare.RevokedAt = &revokedAt
+	}
+	if share.LastViewedAt != nil {
+		lastViewedAt := share.LastViewedAt.AsTime()
+		httpShare.LastViewedAt = &lastViewedAt
+	}
+	return httpShare
+}
+
+// Struct to capture the HTTP request JSON data
+type CreateInvoiceShareHTTPReq struct {
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// Struct to represent an invoice share link in the HTTP response
+type InvoiceShareHTTP struct {
+	ShareID      int64      `json:"share_id"`
+	InvoiceID    int64      `json:"invoice_id"`
+	Token        string     `json:"token,omitempty"`
+	URL          string     `json:"url,omitempty"`
+	ExpiresAt    time.Time  `json:"expires_at"`
+	RevokedAt    *time.Time `json:"revoked_at,omitempty"`
+	ViewCount    int64      `json:"view_count"`
+	LastViewedAt *time.Time `json:"last_viewed_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
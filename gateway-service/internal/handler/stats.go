@@ -31,17 +31,40 @@ func (h *Handler) GetStatsHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Map the gRPC GetInvoiceResponse back to the HTTP response
+	// Map the gRPC GetStatsResponse back to the HTTP response
+	currencies := make([]CurrencyStatsHTTP, len(grpcRes.Currencies))
+	for i, currencyStats := range grpcRes.Currencies {
+		currencies[i] = CurrencyStatsHTTP{
+			Currency:                 currencyStats.Currency,
+			TotalInvoices:            currencyStats.TotalInvoices,
+			TotalAmountPaid:          currencyStats.TotalAmountPaid,
+			TotalAmountPartiallyPaid: currencyStats.TotalAmountPartiallyPaid,
+			TotalAmountOverdue:       currencyStats.TotalAmountOverdue,
+			TotalAmountDraft:         currencyStats.TotalAmountDraft,
+			TotalAmountUnpaid:        currencyStats.TotalAmountUnpaid,
+			TotalAmountVoid:          currencyStats.TotalAmountVoid,
+			TotalAmountCollected:     currencyStats.TotalAmountCollected,
+		}
+	}
+
 	statsResp := GetStatsHTTPResp{
-		TotalInvoices:        grpcRes.TotalInvoices,
-		TotalPaidInvoices:    grpcRes.TotalPaidInvoices,
-		TotalOverdueInvoices: grpcRes.TotalOverdueInvoices,
-		TotalDraftInvoices:   grpcRes.TotalDraftInvoices,
-		TotalUnpaidInvoices:  grpcRes.TotalUnpaidInvoices,
-		TotalAmountPaid:      grpcRes.TotalAmountPaid,
-		TotalAmountOverdue:   grpcRes.TotalAmountOverdue,
-		TotalAmountDraft:     grpcRes.TotalAmountDraft,
-		TotalAmountUnpaid:    grpcRes.TotalAmountUnpaid,
+		TotalInvoices:              grpcRes.TotalInvoices,
+		TotalPaidInvoices:          grpcRes.TotalPaidInvoices,
+		TotalPartiallyPaidInvoices: grpcRes.TotalPartiallyPaidInvoices,
+		TotalOverdueInvoices:       grpcRes.TotalOverdueInvoices,
+		TotalDraftInvoices:         grpcRes.TotalDraftInvoices,
+		TotalUnpaidInvoices:        grpcRes.TotalUnpaidInvoices,
+		TotalVoidInvoices:          grpcRes.TotalVoidInvoices,
+		TotalAmountPaid:            grpcRes.TotalAmountPaid,
+		TotalAmountPartiallyPaid:   grpcRes.TotalAmountPartiallyPaid,
+		TotalAmountOverdue:         grpcRes.TotalAmountOverdue,
+		TotalAmountDraft:           grpcRes.TotalAmountDraft,
+		TotalAmountUnpaid:          grpcRes.TotalAmountUnpaid,
+		TotalAmountVoid:            grpcRes.TotalAmountVoid,
+		TotalAmountCollected:       grpcRes.TotalAmountCollected,
+		BaseCurrency:               grpcRes.BaseCurrency,
+		UnconvertedInvoices:        grpcRes.UnconvertedInvoices,
+		Currencies:                 currencies,
 	}
 
 	err = h.encodeJSON(w, http.StatusOK, envelope{"stats": statsResp}, nil)
@@ -52,14 +75,39 @@ func (h *Handler) GetStatsHandler(w http.ResponseWriter, r *http.Request) {
 
 // Struct to capture the HTTP response
 type GetStatsHTTPResp struct {
-	TotalInvoices        int64 `json:"total_invoices"`
-	TotalPaidInvoices    int64 `json:"total_paid_invoices"`
-	TotalOverdueInvoices int64 `json:"total_overdue_invoices"`
-	TotalDraftInvoices   int64 `json:"total_draft_invoices"`
-	TotalUnpaidInvoices  int64 `json:"total_unpaid_invoices"`
-
-	TotalAmountPaid    int64 `json:"total_amount_paid"`
-	TotalAmountOverdue int64 `json:"total_amount_overdue"`
-	TotalAmountDraft   int64 `json:"total_amount_draft"`
-	TotalAmountUnpaid  int64 `json:"total_amount_unpaid"`
+	TotalInvoices              int64 `json:"total_invoices"`
+	TotalPaidInvoices          int64 `json:"total_paid_invoices"`
+	TotalPartiallyPaidInvoices int64 `json:"total_partially_paid_invoices"`
+	TotalOverdueInvoices       int64 `json:"total_overdue_invoices"`
+	TotalDraftInvoices         int64 `json:"total_draft_invoices"`
+	TotalUnpaidInvoices        int64 `json:"total_unpaid_invoices"`
+	TotalVoidInvoices          int64 `json:"total_void_invoices"`
+
+	TotalAmountPaid          int64 `json:"total_amount_paid"`
+	TotalAmountPartiallyPaid int64 `json:"total_amount_partially_paid"`
+	TotalAmountOverdue       int64 `json:"total_amount_overdue"`
+	TotalAmountDraft         int64 `json:"total_amount_draft"`
+	TotalAmountUnpaid        int64 `json:"total_amount_unpaid"`
+	TotalAmountVoid          int64 `json:"total_amount_void"`
+
+	TotalAmountCollected int64 `json:"total_amount_collected"`
+
+	BaseCurrency        string              `json:"base_currency"`
+	UnconvertedInvoices int64               `json:"unconverted_invoices"`
+	Currencies          []CurrencyStatsHTTP `json:"currencies"`
+}
+
+// Struct to represent the stats of one currency in the HTTP response
+type CurrencyStatsHTTP struct {
+	Currency      string `json:"currency"`
+	TotalInvoices int64  `json:"total_invoices"`
+
+	TotalAmountPaid          int64 `json:"total_amount_paid"`
+	TotalAmountPartiallyPaid int64 `json:"total_amount_partially_paid"`
+	TotalAmountOverdue       int64 `json:"total_amount_overdue"`
+	TotalAmountDraft         int64 `json:"total_amount_draft"`
+	TotalAmountUnpaid        int64 `json:"total_amount_unpaid"`
+	TotalAmountVoid          int64 `json:"total_amount_void"`
+
+	TotalAmountCollected int64 `json:"total_amount_collected"`
 }
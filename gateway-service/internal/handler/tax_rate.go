@@ -0,0 +1,207 @@
+package handler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *Handler) CreateTaxRateHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq TaxRateHTTPReq
+	err := h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	// Convert the HTTP request into the gRPC CreateTaxRateRequest
+	grpcReq := &invoicepb.CreateTaxRateRequest{
+		UserId:    user.Id,
+		Name:      httpReq.Name,
+		Rate:      httpReq.Rate,
+		Inclusive: httpReq.Inclusive,
+		Compound:  httpReq.Compound,
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.CreateTaxRate(ctx, grpcReq)
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusCreated, envelope{"tax_rate": convertTaxRate(grpcRes.TaxRate)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) GetTaxRatesHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.ListTaxRates(ctx, &invoicepb.ListTaxRatesRequest{UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	// Map the gRPC ListTaxRatesResponse to the HTTP response
+	taxRates := make([]TaxRateHTTP, len(grpcRes.TaxRates))
+	for i, taxRate := range grpcRes.TaxRates {
+		taxRates[i] = convertTaxRate(taxRate)
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"tax_rates": taxRates}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) UpdateTaxRateHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract tax rate ID param
+	taxRateId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	// Decode the JSON body into the HTTP request struct
+	var httpReq TaxRateHTTPReq
+	err = h.decodeJSON(w, r, &httpReq)
+	if err != nil {
+		h.badRequestResponse(w, r, err)
+		return
+	}
+
+	// Convert the HTTP request into the gRPC UpdateTaxRateRequest
+	grpcReq := &invoicepb.UpdateTaxRateRequest{
+		TaxRateId: taxRateId,
+		UserId:    user.Id,
+		Name:      httpReq.Name,
+		Rate:      httpReq.Rate,
+		Inclusive: httpReq.Inclusive,
+		Compound:  httpReq.Compound,
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.UpdateTaxRate(ctx, grpcReq)
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"tax_rate": convertTaxRate(grpcRes.TaxRate)}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+func (h *Handler) DeleteTaxRateHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract tax rate ID param
+	taxRateId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to invoice service
+	conn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer conn.Close()
+
+	client := invoicepb.NewInvoiceServiceClient(conn)
+	grpcRes, err := client.DeleteTaxRate(ctx, &invoicepb.DeleteTaxRateRequest{TaxRateId: taxRateId, UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	err = h.encodeJSON(w, http.StatusOK, envelope{"message": grpcRes.Message}, nil)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+	}
+}
+
+// Convert a gRPC TaxRate to an HTTP TaxRate
+func convertTaxRate(taxRate *invoicepb.TaxRate) TaxRateHTTP {
+	return TaxRateHTTP{
+		TaxRateID: taxRate.Id,
+		UserID:    taxRate.UserId,
+		Name:      taxRate.Name,
+		Rate:      taxRate.Rate,
+		Inclusive: taxRate.Inclusive,
+		Compound:  taxRate.Compound,
+	}
+}
+
+// Struct to capture the HTTP request JSON data
+type TaxRateHTTPReq struct {
+	Name      string `json:"name"`
+	Rate      int64  `json:"rate"`
+	Inclusive bool   `json:"inclusive"`
+	Compound  bool   `json:"compound"`
+}
+
+// Struct to represent a TaxRate in the HTTP response
+type TaxRateHTTP struct {
+	TaxRateID int64  `json:"tax_rate_id"`
+	UserID    int64  `json:"user_id"`
+	Name      string `json:"name"`
+	Rate      int64  `json:"rate"`
+	Inclusive bool   `json:"inclusive"`
+	Compound  bool   `json:"compound"`
+}
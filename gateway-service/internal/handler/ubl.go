@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/emzola/numer/gateway-service/internal/grpcutil"
+	invoicepb "github.com/emzola/numer/invoice-service/proto"
+	userpb "github.com/emzola/numer/user-service/proto"
+)
+
+func (h *Handler) GetInvoiceUBLHandler(w http.ResponseWriter, r *http.Request) {
+	// Extract user from context
+	user := h.contextGetUser(r)
+
+	// Extract invoice ID param
+	invoiceId, err := h.readIDParam(r)
+	if err != nil {
+		h.notFoundResponse(w, r)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	// Create gRPC connection to user service
+	userConn, err := grpcutil.ServiceConnection(ctx, "user-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer userConn.Close()
+
+	userClient := userpb.NewUserServiceClient(userConn)
+
+	// Create gRPC connection to invoice service
+	invConn, err := grpcutil.ServiceConnection(ctx, "invoice-service", h.registry)
+	if err != nil {
+		h.serverErrorResponse(w, r, err)
+		return
+	}
+	defer invConn.Close()
+
+	invClient := invoicepb.NewInvoiceServiceClient(invConn)
+
+	// Fetch the issuer and the customer named on the invoice
+	invoiceResp, err := invClient.GetInvoice(ctx, &invoicepb.GetInvoiceRequest{InvoiceId: invoiceId})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+	if invoiceResp.Invoice.UserId != user.Id {
+		h.notFoundResponse(w, r)
+		return
+	}
+	userResp, err := userClient.GetUser(ctx, &userpb.GetUserRequest{UserId: user.Id})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+	customerResp, err := userClient.GetCustomer(ctx, &userpb.GetCustomerRequest{CustomerId: invoiceResp.Invoice.CustomerId})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	// Call the RenderInvoiceUBL gRPC method
+	issuer, customer := userResp.User, customerResp.Customer
+	grpcRes, err := invClient.RenderInvoiceUBL(ctx, &invoicepb.RenderInvoiceUBLRequest{
+		InvoiceId: invoiceId,
+		UserId:    user.Id,
+		Issuer: &invoicepb.Party{
+			Name:     issuer.BusinessName,
+			Email:    issuer.Email,
+			Address:  issuer.Address,
+			Country:  issuer.Country,
+			TaxId:    issuer.TaxId,
+			PeppolId: issuer.PeppolId,
+		},
+		Customer: &invoicepb.Party{
+			Name:     customer.Name,
+			Email:    customer.Email,
+			Address:  customer.Address,
+			Country:  customer.Country,
+			TaxId:    customer.TaxId,
+			PeppolId: customer.PeppolId,
+		},
+	})
+	if err != nil {
+		h.grpcErrorResponse(w, r, err)
+		return
+	}
+
+	// Write the XML rather than a JSON envelope
+	w.Header().Set("Content-Type", "application/xml")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", grpcRes.Filename))
+	w.Header().Set("Content-Length", strconv.Itoa(len(grpcRes.Xml)))
+	w.WriteHeader(http.StatusOK)
+	_, err = w.Write(grpcRes.Xml)
+	if err != nil {
+		h.logError(r, err)
+	}
+}
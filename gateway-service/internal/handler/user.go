@@ -44,11 +44,7 @@ func (h *Handler) CreateUserHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Map the gRPC UserResponse back to the HTTP response
-	userResp := UserHTTPResp{
-		ID:    grpcRes.User.Id,
-		Email: grpcRes.User.Email,
-		Role:  grpcRes.User.Role,
-	}
+	userResp := convertUser(grpcRes.User)
 
 	err = h.encodeJSON(w, http.StatusCreated, envelope{"user": userResp}, nil)
 	if err != nil {
@@ -88,11 +84,7 @@ func (h *Handler) GetUserHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// Map the gRPC UserResponse back to the HTTP response
-	userResp := UserHTTPResp{
-		ID:    grpcRes.User.Id,
-		Email: grpcRes.User.Email,
-		Role:  grpcRes.User.Role,
-	}
+	userResp := convertUser(grpcRes.User)
 
 	err = h.encodeJSON(w, http.StatusOK, envelope{"user": userResp}, nil)
 	if err != nil {
@@ -127,10 +119,15 @@ func (h *Handler) UpdateUserHandler(w http.ResponseWriter, r *http.Request) {
 
 	// Convert the HTTP request into the gRPC CreateUserRequest
 	grpcReq := &userpb.UpdateUserRequest{
-		UserId:   user.Id,
-		Email:    httpReq.Email,
-		Password: httpReq.Password,
-		Role:     httpReq.Role,
+		UserId:       user.Id,
+		Email:        httpReq.Email,
+		Password:     httpReq.Password,
+		Role:         httpReq.Role,
+		BusinessName: httpReq.BusinessName,
+		Address:      httpReq.Address,
+		Country:      httpReq.Country,
+		TaxId:        httpReq.TaxID,
+		PeppolId:     httpReq.PeppolID,
 	}
 
 	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
@@ -147,16 +144,12 @@ func (h *Handler) UpdateUserHandler(w http.ResponseWriter, r *http.Request) {
 	client := userpb.NewUserServiceClient(conn)
 	grpcRes, err := client.UpdateUser(ctx, grpcReq)
 	if err != nil {
-		h.serverErrorResponse(w, r, err)
+		h.grpcErrorResponse(w, r, err)
 		return
 	}
 
 	// Map the gRPC UserResponse back to the HTTP response
-	userResp := UserHTTPResp{
-		ID:    grpcRes.User.Id,
-		Email: grpcRes.User.Email,
-		Role:  grpcRes.User.Role,
-	}
+	userResp := convertUser(grpcRes.User)
 
 	err = h.encodeJSON(w, http.StatusOK, envelope{"user": userResp}, nil)
 	if err != nil {
@@ -214,6 +207,20 @@ func (h *Handler) DeleteUserHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Convert a gRPC User to an HTTP User
+func convertUser(user *userpb.User) UserHTTPResp {
+	return UserHTTPResp{
+		ID:           user.Id,
+		Email:        user.Email,
+		Role:         user.Role,
+		BusinessName: user.BusinessName,
+		Address:      user.Address,
+		Country:      user.Country,
+		TaxID:        user.TaxId,
+		PeppolID:     user.PeppolId,
+	}
+}
+
 // Struct to capture the HTTP request JSON data
 type CreateUserHTTPReq struct {
 	Email    string `json:"email"`
@@ -223,18 +230,28 @@ type CreateUserHTTPReq struct {
 
 // Struct to capture the HTTP response
 type UserHTTPResp struct {
-	ID        int64  `json:"user_id"`
-	Email     string `json:"email"`
-	Role      string `json:"role"`
-	CreatedAt string `json:"created_at"`
+	ID           int64  `json:"user_id"`
+	Email        string `json:"email"`
+	Role         string `json:"role"`
+	BusinessName string `json:"business_name"`
+	Address      string `json:"address"`
+	Country      string `json:"country"`
+	TaxID        string `json:"tax_id"`
+	PeppolID     string `json:"peppol_id"`
+	CreatedAt    string `json:"created_at"`
 }
 
 // Struct to capture the HTTP request JSON data
 type UpdateUserHTTPReq struct {
-	ID       int64  `json:"user_id"`
-	Email    string `json:"email"`
-	Password string `json:"password"`
-	Role     string `json:"role"`
+	ID           int64  `json:"user_id"`
+	Email        string `json:"email"`
+	Password     string `json:"password"`
+	Role         string `json:"role"`
+	BusinessName string `json:"business_name"`
+	Address      string `json:"address"`
+	Country      string `json:"country"`
+	TaxID        string `json:"tax_id"`
+	PeppolID     string `json:"peppol_id"`
 }
 
 // Struct to capture the HTTP response
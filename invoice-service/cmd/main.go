@@ -18,12 +18,15 @@ import (
 	"github.com/emzola/numer/invoice-service/internal/handler"
 	"github.com/emzola/numer/invoice-service/internal/repository"
 	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/emzola/numer/invoice-service/internal/service/blob"
 	"github.com/emzola/numer/invoice-service/internal/service/rabbitmq"
+	invoicescheduler "github.com/emzola/numer/invoice-service/internal/service/scheduler"
 	"github.com/emzola/numer/invoice-service/pkg/discovery"
 	consul "github.com/emzola/numer/invoice-service/pkg/discovery/consul"
 	pb "github.com/emzola/numer/invoice-service/proto"
 	notificationpb "github.com/emzola/numer/notification-service/proto"
 	reminderpb "github.com/emzola/numer/reminder-service/proto"
+	"github.com/go-co-op/gocron"
 	_ "github.com/jackc/pgx/v5/stdlib"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -41,6 +44,24 @@ func main() {
 	var cfg config.Params
 	flag.StringVar(&cfg.GRPCServerAddress, "server-address", os.Getenv("GRPC_SERVER_ADDRESS"), "GRPC server address")
 	flag.StringVar(&cfg.DatabaseURL, "database-url", os.Getenv("INVOICE_DB_URL"), "POSTGRESQL database URL")
+	flag.DurationVar(&cfg.OverdueSweepInterval, "overdue-sweep-interval", time.Hour, "Interval between overdue invoice sweeps")
+	flag.IntVar(&cfg.OverdueSweepBatchSize, "overdue-sweep-batch-size", 100, "Maximum number of invoices marked overdue per batch")
+	flag.DurationVar(&cfg.RecurringInvoiceInterval, "recurring-invoice-interval", time.Hour, "Interval between recurring invoice runs")
+	flag.IntVar(&cfg.RecurringInvoiceBatchSize, "recurring-invoice-batch-size", 100, "Maximum number of recurring invoices generated per batch")
+	flag.DurationVar(&cfg.QuoteExpiryInterval, "quote-expiry-interval", time.Hour, "Interval between quote expiry sweeps")
+	flag.IntVar(&cfg.QuoteExpiryBatchSize, "quote-expiry-batch-size", 100, "Maximum number of quotes expired per batch")
+	flag.DurationVar(&cfg.LateFeeInterval, "late-fee-interval", time.Hour, "Interval between late fee runs")
+	flag.IntVar(&cfg.LateFeeBatchSize, "late-fee-batch-size", 100, "Maximum number of invoices assessed for late fees per batch")
+	flag.DurationVar(&cfg.OutboxRelayInterval, "outbox-relay-interval", time.Second, "Interval between outbox relay runs")
+	flag.IntVar(&cfg.OutboxBatchSize, "outbox-batch-size", 100, "Maximum number of outbox events published per batch")
+	flag.DurationVar(&cfg.OutboxRetention, "outbox-retention", 7*24*time.Hour, "How long published outbox events are kept")
+	flag.DurationVar(&cfg.IdempotencyWindow, "idempotency-window", 24*time.Hour, "How long requests made with an idempotency key are remembered")
+	flag.StringVar(&cfg.ShareSecret, "share-secret", os.Getenv("INVOICE_SHARE_SECRET"), "Key invoice share links are signed with")
+	flag.StringVar(&cfg.AttachmentDir, "attachment-dir", os.Getenv("INVOICE_ATTACHMENT_DIR"), "Directory invoice attachments are stored in")
+	flag.Parse()
+	if cfg.AttachmentDir == "" {
+		cfg.AttachmentDir = "attachments"
+	}
 
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -73,12 +94,20 @@ func main() {
 
 	// Initialize repository, service and publisher
 	repo := repository.NewInvoiceRepository(dbpool)
-	svc := service.NewInvoiceService(repo)
-	publisher, err := rabbitmq.NewPublisher(os.Getenv("RABBITMQ_URL"), "activity_logs")
+	blobs, err := blob.NewLocalStore(cfg.AttachmentDir)
 	if err != nil {
-		logger.Error("failed to initialize RabbitMQ publisher", slog.Any("error", err))
+		logger.Error("failed to open attachment store", slog.Any("error", err))
 		return
 	}
+	svc := service.NewInvoiceService(repo, service.WithShareSecret([]byte(cfg.ShareSecret)), service.WithBlobStore(blobs),
+		service.WithIdempotencyWindow(cfg.IdempotencyWindow))
+	if cfg.ShareSecret == "" {
+		logger.Warn("no share secret configured, invoice share links are disabled")
+	}
+
+	// Activities are written to the outbox with the changes they describe, and the outbox relay publishes them
+	// once RabbitMQ is reachable
+	publisher := rabbitmq.NewPublisher(os.Getenv("RABBITMQ_URL"), "activity_logs")
 	defer publisher.Close()
 
 	// Set up connection to the Reminder service
@@ -99,10 +128,52 @@ func main() {
 
 	notifClient := notificationpb.NewNotificationServiceClient(notifConn)
 
-	// Initialize gRPC handler with service and publisher
-	handler := handler.NewInvoiceHandler(svc, publisher, reminderClient, notifClient)
+	// Initialize gRPC handler with service
+	handler := handler.NewInvoiceHandler(svc, reminderClient, notifClient)
+
+	// Schedule the overdue invoice sweep, recurring invoice runs, quote expiry sweep, late fee runs, outbox relay
+	// and idempotency key cleanup. The Postgres locker makes sure only one instance runs each job.
+	scheduler := gocron.NewScheduler(time.UTC)
+	scheduler.WithDistributedLocker(invoicescheduler.NewPostgresLocker(dbpool))
+	sweeper := invoicescheduler.NewOverdueSweeper(svc, cfg.OverdueSweepBatchSize, logger)
+	_, err = scheduler.Every(cfg.OverdueSweepInterval).Name("overdue-invoice-sweep").Do(sweeper.Sweep, ctx)
+	if err != nil {
+		logger.Error("failed to schedule overdue invoice sweep", slog.Any("error", err))
+	}
+	runner := invoicescheduler.NewRecurringInvoiceRunner(svc, handler, cfg.RecurringInvoiceBatchSize, logger)
+	_, err = scheduler.Every(cfg.RecurringInvoiceInterval).Name("recurring-invoice-run").Do(runner.Run, ctx)
+	if err != nil {
+		logger.Error("failed to schedule recurring invoice runs", slog.Any("error", err))
+	}
+	quoteSweeper := invoicescheduler.NewQuoteExpirySweeper(svc, cfg.QuoteExpiryBatchSize, logger)
+	_, err = scheduler.Every(cfg.QuoteExpiryInterval).Name("quote-expiry-sweep").Do(quoteSweeper.Sweep, ctx)
+	if err != nil {
+		logger.Error("failed to schedule quote expiry sweep", slog.Any("error", err))
+	}
+	lateFees := invoicescheduler.NewLateFeeAssessor(svc, cfg.LateFeeBatchSize, logger)
+	_, err = scheduler.Every(cfg.LateFeeInterval).Name("late-fee-run").Do(lateFees.Run, ctx)
+	if err != nil {
+		logger.Error("failed to schedule late fee runs", slog.Any("error", err))
+	}
+	relay := invoicescheduler.NewOutboxRelay(svc, publisher, cfg.OutboxBatchSize, cfg.OutboxRetention, logger)
+	_, err = scheduler.Every(cfg.OutboxRelayInterval).Name("outbox-relay").Do(relay.Run, ctx)
+	if err != nil {
+		logger.Error("failed to schedule outbox relay", slog.Any("error", err))
+	}
+	_, err = scheduler.Every(time.Hour).Name("outbox-cleanup").Do(relay.Cleanup, ctx)
+	if err != nil {
+		logger.Error("failed to schedule outbox cleanup", slog.Any("error", err))
+	}
+	idempotencyKeys := invoicescheduler.NewIdempotencyKeyCleaner(svc, logger)
+	_, err = scheduler.Every(time.Hour).Name("idempotency-key-cleanup").Do(idempotencyKeys.Cleanup, ctx)
+	if err != nil {
+		logger.Error("failed to schedule idempotency key cleanup", slog.Any("error", err))
+	}
+	scheduler.StartAsync()
+	defer scheduler.Stop()
 
-	grpcServer := grpc.NewServer()
+	// Allow for attachments, which are sent whole
+	grpcServer := grpc.NewServer(grpc.MaxRecvMsgSize(16<<20), grpc.UnaryInterceptor(handler.IdempotencyInterceptor))
 	reflection.Register(grpcServer)
 	pb.RegisterInvoiceServiceServer(grpcServer, handler)
 
@@ -1,6 +1,22 @@
 package config
 
+import "time"
+
 type Params struct {
-	GRPCServerAddress string
-	DatabaseURL       string
+	GRPCServerAddress         string
+	DatabaseURL               string
+	OverdueSweepInterval      time.Duration
+	OverdueSweepBatchSize     int
+	RecurringInvoiceInterval  time.Duration
+	RecurringInvoiceBatchSize int
+	QuoteExpiryInterval       time.Duration
+	QuoteExpiryBatchSize      int
+	ShareSecret               string
+	AttachmentDir             string
+	LateFeeInterval           time.Duration
+	LateFeeBatchSize          int
+	OutboxRelayInterval       time.Duration
+	OutboxBatchSize           int
+	OutboxRetention           time.Duration
+	IdempotencyWindow         time.Duration
 }
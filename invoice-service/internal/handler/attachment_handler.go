@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *InvoiceHandler) UploadAttachment(ctx context.Context, req *pb.UploadAttachmentRequest) (*pb.AttachmentResponse, error) {
+	attachment, err := h.service.AddAttachment(ctx, req.InvoiceId, req.UserId, req.Filename, req.ContentType, req.Content)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.AttachmentResponse{Attachment: models.ConvertAttachmentToProto(attachment)}, nil
+}
+
+func (h *InvoiceHandler) ListAttachments(ctx context.Context, req *pb.ListAttachmentsRequest) (*pb.ListAttachmentsResponse, error) {
+	attachments, err := h.service.ListAttachments(ctx, req.InvoiceId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoAttachments := make([]*pb.Attachment, len(attachments))
+	for i, attachment := range attachments {
+		protoAttachments[i] = models.ConvertAttachmentToProto(attachment)
+	}
+
+	return &pb.ListAttachmentsResponse{Attachments: protoAttachments}, nil
+}
+
+func (h *InvoiceHandler) GetAttachment(ctx context.Context, req *pb.AttachmentRequest) (*pb.AttachmentResponse, error) {
+	attachment, err := h.service.GetAttachment(ctx, req.AttachmentId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.AttachmentResponse{Attachment: models.ConvertAttachmentToProto(attachment)}, nil
+}
+
+func (h *InvoiceHandler) DeleteAttachment(ctx context.Context, req *pb.AttachmentRequest) (*pb.AttachmentResponse, error) {
+	attachment, err := h.service.DeleteAttachment(ctx, req.AttachmentId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.AttachmentResponse{Attachment: models.ConvertAttachmentToProto(attachment)}, nil
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *InvoiceHandler) CreateBrandingProfile(ctx context.Context, req *pb.BrandingProfileRequest) (*pb.BrandingProfileResponse, error) {
+	profile, err := h.service.CreateBrandingProfile(ctx, convertProtoBrandingProfile(req))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.BrandingProfileResponse{Profile: models.ConvertBrandingProfileToProto(profile)}, nil
+}
+
+func (h *InvoiceHandler) GetBrandingProfile(ctx context.Context, req *pb.GetBrandingProfileRequest) (*pb.BrandingProfileResponse, error) {
+	profile, err := h.service.GetBrandingProfile(ctx, req.ProfileId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.BrandingProfileResponse{Profile: models.ConvertBrandingProfileToProto(profile)}, nil
+}
+
+func (h *InvoiceHandler) ListBrandingProfiles(ctx context.Context, req *pb.ListBrandingProfilesRequest) (*pb.ListBrandingProfilesResponse, error) {
+	profiles, err := h.service.ListBrandingProfiles(ctx, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoProfiles := make([]*pb.BrandingProfile, len(profiles))
+	for i, profile := range profiles {
+		protoProfiles[i] = models.ConvertBrandingProfileToProto(profile)
+	}
+
+	return &pb.ListBrandingProfilesResponse{Profiles: protoProfiles}, nil
+}
+
+func (h *InvoiceHandler) UpdateBrandingProfile(ctx context.Context, req *pb.BrandingProfileRequest) (*pb.BrandingProfileResponse, error) {
+	profile, err := h.service.UpdateBrandingProfile(ctx, convertProtoBrandingProfile(req))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.BrandingProfileResponse{Profile: models.ConvertBrandingProfileToProto(profile)}, nil
+}
+
+func (h *InvoiceHandler) DeleteBrandingProfile(ctx context.Context, req *pb.GetBrandingProfileRequest) (*pb.BrandingProfileResponse, error) {
+	profile, err := h.service.DeleteBrandingProfile(ctx, req.ProfileId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	profile.Logo = nil
+	return &pb.BrandingProfileResponse{Profile: models.ConvertBrandingProfileToProto(profile)}, nil
+}
+
+func (h *InvoiceHandler) SetBrandingProfileLogo(ctx context.Context, req *pb.SetBrandingProfileLogoRequest) (*pb.BrandingProfileResponse, error) {
+	profile, err := h.service.SetBrandingProfileLogo(ctx, req.ProfileId, req.UserId, req.ContentType, req.Logo)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	// The caller already has the logo, so don't send it back
+	profile.Logo = nil
+	return &pb.BrandingProfileResponse{Profile: models.ConvertBrandingProfileToProto(profile)}, nil
+}
+
+func convertProtoBrandingProfile(req *pb.BrandingProfileRequest) *models.BrandingProfile {
+	return &models.BrandingProfile{
+		ID:                  req.ProfileId,
+		UserID:              req.UserId,
+		Name:                req.Name,
+		AccentColor:         req.AccentColor,
+		Footer:              req.Footer,
+		PaymentInstructions: req.PaymentInstructions,
+		DefaultNote:         req.DefaultNote,
+		IsDefault:           req.IsDefault,
+	}
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *InvoiceHandler) CreateCatalogItem(ctx context.Context, req *pb.CatalogItemRequest) (*pb.CatalogItemResponse, error) {
+	item, err := h.service.CreateCatalogItem(ctx, convertProtoCatalogItem(req))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.CatalogItemResponse{CatalogItem: models.ConvertCatalogItemToProto(item)}, nil
+}
+
+func (h *InvoiceHandler) GetCatalogItem(ctx context.Context, req *pb.GetCatalogItemRequest) (*pb.CatalogItemResponse, error) {
+	item, err := h.service.GetCatalogItem(ctx, req.CatalogItemId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.CatalogItemResponse{CatalogItem: models.ConvertCatalogItemToProto(item)}, nil
+}
+
+func (h *InvoiceHandler) ListCatalogItems(ctx context.Context, req *pb.ListCatalogItemsRequest) (*pb.ListCatalogItemsResponse, error) {
+	items, err := h.service.ListCatalogItems(ctx, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoItems := make([]*pb.CatalogItem, len(items))
+	for i, item := range items {
+		protoItems[i] = models.ConvertCatalogItemToProto(item)
+	}
+
+	return &pb.ListCatalogItemsResponse{CatalogItems: protoItems}, nil
+}
+
+func (h *InvoiceHandler) UpdateCatalogItem(ctx context.Context, req *pb.CatalogItemRequest) (*pb.CatalogItemResponse, error) {
+	item, err := h.service.UpdateCatalogItem(ctx, convertProtoCatalogItem(req))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.CatalogItemResponse{CatalogItem: models.ConvertCatalogItemToProto(item)}, nil
+}
+
+func (h *InvoiceHandler) DeleteCatalogItem(ctx context.Context, req *pb.GetCatalogItemRequest) (*pb.CatalogItemResponse, error) {
+	item, err := h.service.DeleteCatalogItem(ctx, req.CatalogItemId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.CatalogItemResponse{CatalogItem: models.ConvertCatalogItemToProto(item)}, nil
+}
+
+func convertProtoCatalogItem(req *pb.CatalogItemRequest) *models.CatalogItem {
+	return &models.CatalogItem{
+		ID:          req.CatalogItemId,
+		UserID:      req.UserId,
+		SKU:         req.Sku,
+		Description: req.Description,
+		UnitPrice:   req.UnitPrice,
+		Unit:        req.Unit,
+		TaxRateID:   req.TaxRateId,
+	}
+}
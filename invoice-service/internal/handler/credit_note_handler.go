@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *InvoiceHandler) CreateCreditNote(ctx context.Context, req *pb.CreateCreditNoteRequest) (*pb.CreditNoteResponse, error) {
+	creditNote := &models.CreditNote{
+		UserID:    req.UserId,
+		InvoiceID: req.InvoiceId,
+		Reason:    req.Reason,
+	}
+	if req.IssueDate != nil {
+		creditNote.IssueDate = req.IssueDate.AsTime()
+	}
+	for _, item := range req.Items {
+		creditNote.Items = append(creditNote.Items, &models.CreditNoteItem{
+			InvoiceItemID: item.InvoiceItemId,
+			Description:   item.Description,
+			Quantity:      item.Quantity,
+		})
+	}
+
+	creditNote, invoice, err := h.service.CreateCreditNote(ctx, creditNote)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.CreditNoteResponse{
+		CreditNote: models.ConvertCreditNoteToProto(creditNote),
+		Invoice:    models.ConvertInvoiceToProto(invoice),
+	}, nil
+}
+
+func (h *InvoiceHandler) GetCreditNote(ctx context.Context, req *pb.GetCreditNoteRequest) (*pb.CreditNoteResponse, error) {
+	creditNote, err := h.service.GetCreditNote(ctx, req.CreditNoteId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.CreditNoteResponse{CreditNote: models.ConvertCreditNoteToProto(creditNote)}, nil
+}
+
+func (h *InvoiceHandler) ListCreditNotes(ctx context.Context, req *pb.ListCreditNotesRequest) (*pb.ListCreditNotesResponse, error) {
+	creditNotes, err := h.service.ListCreditNotes(ctx, req.UserId, req.InvoiceId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoCreditNotes := make([]*pb.CreditNote, len(creditNotes))
+	for i, creditNote := range creditNotes {
+		protoCreditNotes[i] = models.ConvertCreditNoteToProto(creditNote)
+	}
+
+	return &pb.ListCreditNotesResponse{CreditNotes: protoCreditNotes}, nil
+}
+
+func (h *InvoiceHandler) ApplyCreditNote(ctx context.Context, req *pb.ApplyCreditNoteRequest) (*pb.CreditNoteResponse, error) {
+	creditNote, invoice, err := h.service.ApplyCreditNote(ctx, req.CreditNoteId, req.UserId, req.InvoiceId, req.Amount)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.CreditNoteResponse{
+		CreditNote: models.ConvertCreditNoteToProto(creditNote),
+		Invoice:    models.ConvertInvoiceToProto(invoice),
+	}, nil
+}
+
+func (h *InvoiceHandler) RefundCreditNote(ctx context.Context, req *pb.RefundCreditNoteRequest) (*pb.CreditNoteResponse, error) {
+	creditNote, err := h.service.RefundCreditNote(ctx, req.CreditNoteId, req.UserId, req.Amount, req.Method, req.Reference)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.CreditNoteResponse{CreditNote: models.ConvertCreditNoteToProto(creditNote)}, nil
+}
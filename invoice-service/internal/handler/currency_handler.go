@@ -0,0 +1,84 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+	"github.com/shopspring/decimal"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (h *InvoiceHandler) GetCurrencySettings(ctx context.Context, req *pb.GetCurrencySettingsRequest) (*pb.GetCurrencySettingsResponse, error) {
+	baseCurrency, err := h.service.GetBaseCurrency(ctx, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.GetCurrencySettingsResponse{BaseCurrency: baseCurrency}, nil
+}
+
+func (h *InvoiceHandler) UpdateCurrencySettings(ctx context.Context, req *pb.UpdateCurrencySettingsRequest) (*pb.UpdateCurrencySettingsResponse, error) {
+	baseCurrency, err := h.service.SetBaseCurrency(ctx, req.UserId, req.BaseCurrency)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.UpdateCurrencySettingsResponse{BaseCurrency: baseCurrency}, nil
+}
+
+func (h *InvoiceHandler) CreateExchangeRate(ctx context.Context, req *pb.CreateExchangeRateRequest) (*pb.CreateExchangeRateResponse, error) {
+	rate, err := decimal.NewFromString(req.Rate)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid exchange rate %q", req.Rate)
+	}
+
+	exchangeRate := &models.ExchangeRate{
+		UserID:   req.UserId,
+		Currency: req.Currency,
+		Rate:     rate,
+	}
+	if req.EffectiveDate != nil {
+		exchangeRate.EffectiveDate = req.EffectiveDate.AsTime()
+	}
+
+	exchangeRate, err = h.service.CreateExchangeRate(ctx, exchangeRate)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.CreateExchangeRateResponse{ExchangeRate: models.ConvertExchangeRateToProto(exchangeRate)}, nil
+}
+
+func (h *InvoiceHandler) ListExchangeRates(ctx context.Context, req *pb.ListExchangeRatesRequest) (*pb.ListExchangeRatesResponse, error) {
+	rates, err := h.service.ListExchangeRates(ctx, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoRates := make([]*pb.ExchangeRate, len(rates))
+	for i, rate := range rates {
+		protoRates[i] = models.ConvertExchangeRateToProto(rate)
+	}
+
+	return &pb.ListExchangeRatesResponse{ExchangeRates: protoRates}, nil
+}
+
+func (h *InvoiceHandler) ImportExchangeRates(ctx context.Context, req *pb.ImportExchangeRatesRequest) (*pb.ImportExchangeRatesResponse, error) {
+	rates, err := h.service.ImportExchangeRates(ctx, req.UserId, req.Data)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.ImportExchangeRatesResponse{Imported: int32(len(rates))}, nil
+}
+
+func (h *InvoiceHandler) DeleteExchangeRate(ctx context.Context, req *pb.DeleteExchangeRateRequest) (*pb.DeleteExchangeRateResponse, error) {
+	err := h.service.DeleteExchangeRate(ctx, req.ExchangeRateId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.DeleteExchangeRateResponse{Message: "exchange rate successfully deleted"}, nil
+}
@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// toStatusError maps a service error to the matching gRPC status error.
+func toStatusError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrInvalidTransition), errors.Is(err, service.ErrInvoiceLocked),
+		errors.Is(err, service.ErrNotDraft):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrConflict), errors.Is(err, service.ErrRequestInProgress):
+		return status.Error(codes.Aborted, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"log/slog"
+	"strconv"
+	"time"
+
+	pb "github.com/emzola/numer/invoice-service/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// The metadata the gateway sends with requests made with an Idempotency-Key header. Keys are scoped to the user.
+const (
+	idempotencyKeyMetadata = "idempotency-key"
+	userIDMetadata         = "user-id"
+)
+
+// idempotentMethods are the methods that honour an idempotency key.
+var idempotentMethods = map[string]bool{
+	pb.InvoiceService_CreateInvoice_FullMethodName:    true,
+	pb.InvoiceService_UpdateInvoice_FullMethodName:    true,
+	pb.InvoiceService_FinalizeInvoice_FullMethodName:  true,
+	pb.InvoiceService_VoidInvoice_FullMethodName:      true,
+	pb.InvoiceService_DeleteInvoice_FullMethodName:    true,
+	pb.InvoiceService_DuplicateInvoice_FullMethodName: true,
+	pb.InvoiceService_ArchiveInvoice_FullMethodName:   true,
+	pb.InvoiceService_UnarchiveInvoice_FullMethodName: true,
+	pb.InvoiceService_MarkPaid_FullMethodName:         true,
+	pb.InvoiceService_RecordPayment_FullMethodName:    true,
+	pb.InvoiceService_RefundPayment_FullMethodName:    true,
+}
+
+// IdempotencyInterceptor is a unary server interceptor that makes the mutating invoice and payment methods
+// idempotent for requests carrying an idempotency key. The first request with a key is handled and its response
+// kept; a retry with the same key and request gets that response back, and a different request with the same
+// key is rejected.
+func (h *InvoiceHandler) IdempotencyInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, next grpc.UnaryHandler) (any, error) {
+	if !idempotentMethods[info.FullMethod] {
+		return next(ctx, req)
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(idempotencyKeyMetadata)
+	if len(keys) == 0 {
+		return next(ctx, req)
+	}
+	key := keys[0]
+
+	var userID int64
+	if userIDs := md.Get(userIDMetadata); len(userIDs) > 0 {
+		var err error
+		userID, err = strconv.ParseInt(userIDs[0], 10, 64)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid user ID in request metadata")
+		}
+	}
+
+	fingerprint, err := requestFingerprint(info.FullMethod, req)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	stored, err := h.service.BeginIdempotentRequest(ctx, userID, key, fingerprint, time.Now())
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	if stored != nil {
+		var response anypb.Any
+		err = proto.Unmarshal(stored, &response)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		res, err := response.UnmarshalNew()
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return res, nil
+	}
+
+	// Settle the key even if the caller stops waiting for the response
+	settleCtx := context.WithoutCancel(ctx)
+
+	res, err := next(ctx, req)
+	if err != nil {
+		// The change was rolled back, so a retry may make it again
+		releaseErr := h.service.ReleaseIdempotencyKey(settleCtx, userID, key, fingerprint)
+		if releaseErr != nil {
+			slog.Error("failed to release idempotency key", slog.String("method", info.FullMethod), slog.Any("error", releaseErr))
+		}
+		return nil, err
+	}
+
+	// If the response can't be stored the key stays in progress until it expires, which turns retries away
+	// rather than repeating the change
+	err = h.storeIdempotentResponse(settleCtx, userID, key, fingerprint, res)
+	if err != nil {
+		slog.Error("failed to store idempotent response", slog.String("method", info.FullMethod), slog.Any("error", err))
+	}
+	return res, nil
+}
+
+// storeIdempotentResponse stores a response, along with its type, to answer retries of its request with.
+func (h *InvoiceHandler) storeIdempotentResponse(ctx context.Context, userID int64, key, fingerprint string, res any) error {
+	response, err := anypb.New(res.(proto.Message))
+	if err != nil {
+		return err
+	}
+	serialized, err := proto.Marshal(response)
+	if err != nil {
+		return err
+	}
+	return h.service.CompleteIdempotentRequest(ctx, userID, key, fingerprint, serialized)
+}
+
+// requestFingerprint identifies a request to a method, so a retry can be told apart from a different request.
+func requestFingerprint(method string, req any) (string, error) {
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(req.(proto.Message))
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write(data)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *InvoiceHandler) ImportInvoices(ctx context.Context, req *pb.ImportInvoicesRequest) (*pb.ImportInvoicesResponse, error) {
+	invoices, importErrors, err := h.service.ImportInvoices(ctx, req.UserId, req.Data, req.DryRun)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoInvoices := make([]*pb.Invoice, len(invoices))
+	for i, invoice := range invoices {
+		protoInvoices[i] = models.ConvertInvoiceToProto(invoice)
+	}
+
+	return &pb.ImportInvoicesResponse{
+		Invoices: protoInvoices,
+		Errors:   models.ConvertImportErrorsToProto(importErrors),
+	}, nil
+}
@@ -7,7 +7,7 @@ import (
 
 	"github.com/emzola/numer/invoice-service/internal/models"
 	"github.com/emzola/numer/invoice-service/internal/service"
-	"github.com/emzola/numer/invoice-service/internal/service/rabbitmq"
+	"github.com/emzola/numer/invoice-service/internal/service/mail"
 	pb "github.com/emzola/numer/invoice-service/proto"
 	notificationpb "github.com/emzola/numer/notification-service/proto"
 	reminderpb "github.com/emzola/numer/reminder-service/proto"
@@ -24,16 +24,14 @@ const (
 
 type InvoiceHandler struct {
 	service            *service.InvoiceService
-	publisher          *rabbitmq.Publisher
 	reminderClient     reminderpb.ReminderServiceClient
 	notificationClient notificationpb.NotificationServiceClient
 	pb.UnimplementedInvoiceServiceServer
 }
 
-func NewInvoiceHandler(service *service.InvoiceService, publisher *rabbitmq.Publisher, reminderClient reminderpb.ReminderServiceClient, notificationClient notificationpb.NotificationServiceClient) *InvoiceHandler {
+func NewInvoiceHandler(service *service.InvoiceService, reminderClient reminderpb.ReminderServiceClient, notificationClient notificationpb.NotificationServiceClient) *InvoiceHandler {
 	return &InvoiceHandler{
 		service:            service,
-		publisher:          publisher,
 		reminderClient:     reminderClient,
 		notificationClient: notificationClient,
 	}
@@ -47,37 +45,38 @@ func (h *InvoiceHandler) CreateInvoice(ctx context.Context, req *pb.CreateInvoic
 		DueDate:            req.DueDate.AsTime(),
 		Currency:           req.Currency,
 		DiscountPercentage: req.DiscountPercentage,
+		FixedDiscount:      req.FixedDiscount,
+		Charges:            models.ConvertProtoChargesToInvoiceCharges(req.Charges),
 		AccountName:        req.AccountName,
 		AccountNumber:      req.AccountNumber,
 		BankName:           req.BankName,
 		RoutingNumber:      req.RoutingNumber,
 		Note:               req.Note,
+		BrandingProfileID:  req.BrandingProfileId,
 	}
 
 	// Add invoice items
 	for _, item := range req.Items {
 		invoice.Items = append(invoice.Items, &models.InvoiceItem{
-			Description: item.Description,
-			Quantity:    item.Quantity,
-			UnitPrice:   item.UnitPrice,
+			CatalogItemID:      item.CatalogItemId,
+			SKU:                item.Sku,
+			Description:        item.Description,
+			Quantity:           item.Quantity,
+			Unit:               item.Unit,
+			UnitPrice:          item.GetUnitPrice(),
+			DiscountPercentage: item.DiscountPercentage,
+			FixedDiscount:      item.FixedDiscount,
+			TaxRateIDs:         item.TaxRateIds,
+			UnitPriceSet:       item.UnitPrice != nil,
+			TaxRateIDsSet:      item.TaxRateIdsSet,
 		})
 	}
 
 	invoice, err := h.service.CreateInvoice(ctx, invoice)
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, toStatusError(err)
 	}
 
-	// Publish activity to rabbitMQ
-	activity := map[string]interface{}{
-		"invoice_id":  invoice.ID,
-		"user_id":     invoice.UserID,
-		"action":      "Invoice creation",
-		"description": fmt.Sprintf("Created invoice %s", invoice.InvoiceNumber),
-	}
-
-	h.publisher.Publish(activity)
-
 	return &pb.CreateInvoiceResponse{InvoiceId: invoice.ID}, nil
 }
 
@@ -91,48 +90,148 @@ func (h *InvoiceHandler) GetInvoice(ctx context.Context, req *pb.GetInvoiceReque
 }
 
 func (h *InvoiceHandler) UpdateInvoice(ctx context.Context, req *pb.UpdateInvoiceRequest) (*pb.UpdateInvoiceResponse, error) {
-	invoice, err := h.service.GetInvoice(ctx, req.InvoiceId)
-	if err != nil {
-		return nil, status.Error(codes.NotFound, "invoice not found")
+	invoice := &models.Invoice{
+		ID:                 req.InvoiceId,
+		Status:             req.Status,
+		IssueDate:          req.IssueDate.AsTime(),
+		DueDate:            req.DueDate.AsTime(),
+		Currency:           req.Currency,
+		DiscountPercentage: req.DiscountPercentage,
+		FixedDiscount:      req.FixedDiscount,
+		Charges:            models.ConvertProtoChargesToInvoiceCharges(req.Charges),
+		AccountName:        req.AccountName,
+		AccountNumber:      req.AccountNumber,
+		BankName:           req.BankName,
+		RoutingNumber:      req.RoutingNumber,
+		Note:               req.Note,
+		BrandingProfileID:  req.BrandingProfileId,
+		Version:            req.Version,
 	}
 
-	invoice.Status = req.Status
-	invoice.IssueDate = req.IssueDate.AsTime()
-	invoice.DueDate = req.DueDate.AsTime()
-	invoice.Currency = req.Currency
-	invoice.DiscountPercentage = req.DiscountPercentage
-	invoice.AccountName = req.AccountName
-	invoice.AccountName = req.AccountNumber
-	invoice.BankName = req.BankName
-	invoice.RoutingNumber = req.RoutingNumber
-	invoice.Note = req.Note
-
 	// Update invoice items
 	invoice.Items = []*models.InvoiceItem{}
 	for _, itemReq := range req.Items {
 		invoice.Items = append(invoice.Items, &models.InvoiceItem{
-			Description: itemReq.Description,
-			Quantity:    itemReq.Quantity,
-			UnitPrice:   itemReq.UnitPrice,
+			CatalogItemID:      itemReq.CatalogItemId,
+			SKU:                itemReq.Sku,
+			Description:        itemReq.Description,
+			Quantity:           itemReq.Quantity,
+			Unit:               itemReq.Unit,
+			UnitPrice:          itemReq.GetUnitPrice(),
+			DiscountPercentage: itemReq.DiscountPercentage,
+			FixedDiscount:      itemReq.FixedDiscount,
+			TaxRateIDs:         itemReq.TaxRateIds,
+			UnitPriceSet:       itemReq.UnitPrice != nil,
+			TaxRateIDsSet:      itemReq.TaxRateIdsSet,
 		})
 	}
 
-	// Call service to update invoice
-	err = h.service.UpdateInvoice(ctx, invoice)
+	// Call service to update the fields named in the mask
+	invoice, err := h.service.UpdateInvoice(ctx, invoice, req.UpdateMask.GetPaths())
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, toStatusError(err)
 	}
 
 	return &pb.UpdateInvoiceResponse{
 		InvoiceId: invoice.ID,
 		Message:   "invoice successfully updated",
+		Invoice:   models.ConvertInvoiceToProto(invoice),
 	}, nil
 }
 
+func (h *InvoiceHandler) FinalizeInvoice(ctx context.Context, req *pb.FinalizeInvoiceRequest) (*pb.FinalizeInvoiceResponse, error) {
+	invoice, err := h.service.FinalizeInvoice(ctx, req.InvoiceId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.FinalizeInvoiceResponse{Invoice: models.ConvertInvoiceToProto(invoice)}, nil
+}
+
+func (h *InvoiceHandler) VoidInvoice(ctx context.Context, req *pb.VoidInvoiceRequest) (*pb.VoidInvoiceResponse, error) {
+	invoice, err := h.service.VoidInvoice(ctx, req.InvoiceId, req.UserId, req.Reason)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.VoidInvoiceResponse{Invoice: models.ConvertInvoiceToProto(invoice)}, nil
+}
+
+func (h *InvoiceHandler) DeleteInvoice(ctx context.Context, req *pb.DeleteInvoiceRequest) (*pb.DeleteInvoiceResponse, error) {
+	invoice, err := h.service.DeleteInvoice(ctx, req.InvoiceId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.DeleteInvoiceResponse{
+		InvoiceId: invoice.ID,
+		Message:   "invoice successfully deleted",
+	}, nil
+}
+
+func (h *InvoiceHandler) DuplicateInvoice(ctx context.Context, req *pb.DuplicateInvoiceRequest) (*pb.DuplicateInvoiceResponse, error) {
+	invoice, _, err := h.service.DuplicateInvoice(ctx, req.InvoiceId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.DuplicateInvoiceResponse{Invoice: models.ConvertInvoiceToProto(invoice)}, nil
+}
+
+func (h *InvoiceHandler) ArchiveInvoice(ctx context.Context, req *pb.ArchiveInvoiceRequest) (*pb.ArchiveInvoiceResponse, error) {
+	invoice, err := h.service.ArchiveInvoice(ctx, req.InvoiceId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.ArchiveInvoiceResponse{Invoice: models.ConvertInvoiceToProto(invoice)}, nil
+}
+
+func (h *InvoiceHandler) UnarchiveInvoice(ctx context.Context, req *pb.ArchiveInvoiceRequest) (*pb.ArchiveInvoiceResponse, error) {
+	invoice, err := h.service.UnarchiveInvoice(ctx, req.InvoiceId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.ArchiveInvoiceResponse{Invoice: models.ConvertInvoiceToProto(invoice)}, nil
+}
+
+func (h *InvoiceHandler) MarkPaid(ctx context.Context, req *pb.MarkPaidRequest) (*pb.MarkPaidResponse, error) {
+	invoice, err := h.service.MarkPaid(ctx, req.InvoiceId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.MarkPaidResponse{Invoice: models.ConvertInvoiceToProto(invoice)}, nil
+}
+
 func (s *InvoiceHandler) ListInvoices(ctx context.Context, req *pb.ListInvoicesRequest) (*pb.ListInvoicesResponse, error) {
-	invoices, nextPageToken, err := s.service.ListInvoicesByUserID(ctx, req.UserId, int(req.PageSize), req.PageToken)
+	filter := models.InvoiceFilter{
+		UserID:          req.UserId,
+		Statuses:        req.Statuses,
+		CustomerID:      req.CustomerId,
+		Currency:        req.Currency,
+		MinTotal:        req.MinTotal,
+		MaxTotal:        req.MaxTotal,
+		WithoutItems:    req.WithoutItems,
+		IncludeArchived: req.IncludeArchived,
+	}
+	if req.IssueDateFrom != nil {
+		filter.IssueDateFrom = req.IssueDateFrom.AsTime()
+	}
+	if req.IssueDateTo != nil {
+		filter.IssueDateTo = req.IssueDateTo.AsTime()
+	}
+	if req.DueDateFrom != nil {
+		filter.DueDateFrom = req.DueDateFrom.AsTime()
+	}
+	if req.DueDateTo != nil {
+		filter.DueDateTo = req.DueDateTo.AsTime()
+	}
+
+	invoices, nextPageToken, err := s.service.ListInvoices(ctx, filter, req.Sort, int(req.PageSize), req.PageToken)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, toStatusError(err)
 	}
 
 	protoInvoices := make([]*pb.Invoice, len(invoices))
@@ -193,39 +292,74 @@ func (h *InvoiceHandler) SendInvoiceEmail(ctx context.Context, req *pb.SendInvoi
 		return nil, fmt.Errorf("failed to get invoice: %v", err)
 	}
 
-	// Prepare the email message body
-	message := fmt.Sprintf("Dear %d, \n\nPlease find your invoice for $%d due on %s. \n\n%s",
-		invoice.ID, invoice.Total, invoice.DueDate, invoice.Note)
-
-	// Retry sending email
-	err = h.retrySendEmail(ctx, req.CustomerEmail, "Your Invoice", message)
+	err = h.DeliverInvoice(ctx, invoice, req.CustomerEmail)
 	if err != nil {
 		return nil, err
 	}
 
-	// Publish activity to rabbitMQ
-	activity := map[string]interface{}{
-		"invoice_id":  invoice.ID,
-		"user_id":     invoice.UserID,
-		"action":      "Invoice sent",
-		"description": fmt.Sprintf("Sent invoice %s to user %d", invoice.InvoiceNumber, invoice.UserID),
-	}
-
-	h.publisher.Publish(activity)
-
 	return &pb.SendInvoiceResponse{
 		Status: "email sent successfully",
 	}, nil
 }
 
-func (h *InvoiceHandler) retrySendEmail(ctx context.Context, email, subject, message string) error {
+// DeliverInvoice emails an invoice and its attachments to the customer through the notification service, in the
+// style of the invoice's branding profile, and records the activity.
+func (h *InvoiceHandler) DeliverInvoice(ctx context.Context, invoice *models.Invoice, email string) error {
+	// Prepare the email message body
+	branding, err := h.service.InvoiceBranding(ctx, invoice)
+	if err != nil {
+		return fmt.Errorf("failed to load branding: %w", err)
+	}
+	message, err := mail.RenderInvoiceEmail(invoice, branding)
+	if err != nil {
+		return fmt.Errorf("failed to render email: %w", err)
+	}
+
+	// Load the attachments to send with it
+	attachments, err := h.service.LoadInvoiceAttachments(ctx, invoice.ID)
+	if err != nil {
+		return fmt.Errorf("failed to load attachments: %w", err)
+	}
+	emailAttachments := make([]*notificationpb.Attachment, 0, len(attachments)+1)
+	if message.Logo != nil {
+		emailAttachments = append(emailAttachments, &notificationpb.Attachment{
+			Filename:    message.Logo.Filename,
+			ContentType: message.Logo.ContentType,
+			Content:     message.Logo.Content,
+			ContentId:   message.Logo.ContentID,
+		})
+	}
+	for _, attachment := range attachments {
+		emailAttachments = append(emailAttachments, &notificationpb.Attachment{
+			Filename:    attachment.Filename,
+			ContentType: attachment.ContentType,
+			Content:     attachment.Content,
+		})
+	}
+
+	// Retry sending email
+	err = h.retrySendEmail(ctx, &notificationpb.SendNotificationRequest{
+		Email:       email,
+		Subject:     message.Subject,
+		Message:     message.Text,
+		HtmlMessage: message.HTML,
+		Attachments: emailAttachments,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = h.service.RecordInvoiceSent(ctx, invoice)
+	if err != nil {
+		return fmt.Errorf("invoice sent but the activity was not recorded: %w", err)
+	}
+	return nil
+}
+
+func (h *InvoiceHandler) retrySendEmail(ctx context.Context, req *notificationpb.SendNotificationRequest) error {
 	var err error
 	for i := 0; i < maxRetries; i++ {
-		_, err = h.notificationClient.SendNotification(ctx, &notificationpb.SendNotificationRequest{
-			Email:   email,
-			Subject: subject,
-			Message: message,
-		})
+		_, err = h.notificationClient.SendNotification(ctx, req)
 		if err == nil {
 			return nil
 		}
@@ -0,0 +1,68 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *InvoiceHandler) GetLateFeePolicy(ctx context.Context, req *pb.GetLateFeePolicyRequest) (*pb.LateFeePolicyResponse, error) {
+	policy, err := h.service.GetLateFeePolicy(ctx, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.LateFeePolicyResponse{Policy: models.ConvertLateFeePolicyToProto(policy)}, nil
+}
+
+func (h *InvoiceHandler) SetLateFeePolicy(ctx context.Context, req *pb.SetLateFeePolicyRequest) (*pb.LateFeePolicyResponse, error) {
+	policy, err := h.service.SetLateFeePolicy(ctx, &models.LateFeePolicy{
+		UserID:    req.UserId,
+		Kind:      req.Kind,
+		Amount:    req.Amount,
+		Rate:      req.Rate,
+		GraceDays: req.GraceDays,
+		Cap:       req.Cap,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.LateFeePolicyResponse{Policy: models.ConvertLateFeePolicyToProto(policy)}, nil
+}
+
+func (h *InvoiceHandler) DeleteLateFeePolicy(ctx context.Context, req *pb.DeleteLateFeePolicyRequest) (*pb.DeleteLateFeePolicyResponse, error) {
+	err := h.service.DeleteLateFeePolicy(ctx, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.DeleteLateFeePolicyResponse{Message: "late fee policy successfully deleted"}, nil
+}
+
+func (h *InvoiceHandler) ListLateFees(ctx context.Context, req *pb.ListLateFeesRequest) (*pb.ListLateFeesResponse, error) {
+	fees, err := h.service.ListLateFees(ctx, req.InvoiceId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoFees := make([]*pb.LateFee, len(fees))
+	for i, fee := range fees {
+		protoFees[i] = models.ConvertLateFeeToProto(fee)
+	}
+
+	return &pb.ListLateFeesResponse{LateFees: protoFees}, nil
+}
+
+func (h *InvoiceHandler) ReverseLateFee(ctx context.Context, req *pb.ReverseLateFeeRequest) (*pb.ReverseLateFeeResponse, error) {
+	fee, invoice, err := h.service.ReverseLateFee(ctx, req.LateFeeId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.ReverseLateFeeResponse{
+		LateFee: models.ConvertLateFeeToProto(fee),
+		Invoice: models.ConvertInvoiceToProto(invoice),
+	}, nil
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *InvoiceHandler) GetNumberingScheme(ctx context.Context, req *pb.GetNumberingSchemeRequest) (*pb.NumberingSchemeResponse, error) {
+	scheme, nextNumber, err := h.service.GetNumberingScheme(ctx, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.NumberingSchemeResponse{
+		Format:            scheme.Format,
+		YearlyReset:       scheme.YearlyReset,
+		NextInvoiceNumber: nextNumber,
+	}, nil
+}
+
+func (h *InvoiceHandler) UpdateNumberingScheme(ctx context.Context, req *pb.UpdateNumberingSchemeRequest) (*pb.NumberingSchemeResponse, error) {
+	scheme, nextNumber, err := h.service.UpdateNumberingScheme(ctx, &models.NumberingScheme{
+		UserID:      req.UserId,
+		Format:      req.Format,
+		YearlyReset: req.YearlyReset,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.NumberingSchemeResponse{
+		Format:            scheme.Format,
+		YearlyReset:       scheme.YearlyReset,
+		NextInvoiceNumber: nextNumber,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// ListOutboxEvents shows operators how activity events are being delivered. It is not exposed by the gateway.
+func (h *InvoiceHandler) ListOutboxEvents(ctx context.Context, req *pb.ListOutboxEventsRequest) (*pb.ListOutboxEventsResponse, error) {
+	events, stats, err := h.service.ListOutboxEvents(ctx, req.Status, int(req.Limit))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &pb.ListOutboxEventsResponse{
+		Events:    make([]*pb.OutboxEvent, len(events)),
+		Pending:   stats.Pending,
+		Published: stats.Published,
+		Failed:    stats.Failed,
+	}
+	for i, event := range events {
+		res.Events[i] = models.ConvertOutboxEventToProto(event)
+	}
+	if stats.OldestPendingAt != nil {
+		res.OldestPendingAt = timestamppb.New(*stats.OldestPendingAt)
+	}
+	return res, nil
+}
+
+// RetryOutboxEvent lets an operator retry an event the relay gave up on. It is not exposed by the gateway.
+func (h *InvoiceHandler) RetryOutboxEvent(ctx context.Context, req *pb.RetryOutboxEventRequest) (*pb.OutboxEventResponse, error) {
+	event, err := h.service.RetryOutboxEvent(ctx, req.EventId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.OutboxEventResponse{Event: models.ConvertOutboxEventToProto(event)}, nil
+}
@@ -0,0 +1,56 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *InvoiceHandler) RecordPayment(ctx context.Context, req *pb.RecordPaymentRequest) (*pb.RecordPaymentResponse, error) {
+	payment := &models.Payment{
+		InvoiceID: req.InvoiceId,
+		Amount:    req.Amount,
+		Method:    req.Method,
+		Reference: req.Reference,
+	}
+	if req.PaymentDate != nil {
+		payment.PaymentDate = req.PaymentDate.AsTime()
+	}
+
+	invoice, err := h.service.RecordPayment(ctx, payment, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.RecordPaymentResponse{
+		Payment: models.ConvertPaymentToProto(payment),
+		Invoice: models.ConvertInvoiceToProto(invoice),
+	}, nil
+}
+
+func (h *InvoiceHandler) ListPayments(ctx context.Context, req *pb.ListPaymentsRequest) (*pb.ListPaymentsResponse, error) {
+	payments, err := h.service.ListPayments(ctx, req.InvoiceId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoPayments := make([]*pb.Payment, len(payments))
+	for i, payment := range payments {
+		protoPayments[i] = models.ConvertPaymentToProto(payment)
+	}
+
+	return &pb.ListPaymentsResponse{Payments: protoPayments}, nil
+}
+
+func (h *InvoiceHandler) RefundPayment(ctx context.Context, req *pb.RefundPaymentRequest) (*pb.RefundPaymentResponse, error) {
+	payment, invoice, err := h.service.RefundPayment(ctx, req.PaymentId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.RefundPaymentResponse{
+		Payment: models.ConvertPaymentToProto(payment),
+		Invoice: models.ConvertInvoiceToProto(invoice),
+	}, nil
+}
@@ -0,0 +1,22 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *InvoiceHandler) RenderInvoicePDF(ctx context.Context, req *pb.RenderInvoicePDFRequest) (*pb.RenderInvoicePDFResponse, error) {
+	doc, invoice, err := h.service.RenderInvoicePDF(ctx, req.InvoiceId, req.UserId,
+		models.ConvertProtoToParty(req.Issuer), models.ConvertProtoToParty(req.Customer))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.RenderInvoicePDFResponse{
+		Pdf:      doc,
+		Filename: fmt.Sprintf("invoice-%s.pdf", invoice.InvoiceNumber),
+	}, nil
+}
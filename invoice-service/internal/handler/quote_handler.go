@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *InvoiceHandler) CreateQuote(ctx context.Context, req *pb.CreateQuoteRequest) (*pb.QuoteResponse, error) {
+	quote := &models.Quote{
+		UserID:             req.UserId,
+		CustomerID:         req.CustomerId,
+		Currency:           req.Currency,
+		DiscountPercentage: req.DiscountPercentage,
+		PaymentTermsDays:   req.PaymentTermsDays,
+		Note:               req.Note,
+	}
+	if req.IssueDate != nil {
+		quote.IssueDate = req.IssueDate.AsTime()
+	}
+	if req.ExpiryDate != nil {
+		quote.ExpiryDate = req.ExpiryDate.AsTime()
+	}
+
+	// Add quote items
+	for _, item := range req.Items {
+		quote.Items = append(quote.Items, &models.InvoiceItem{
+			Description:        item.Description,
+			Quantity:           item.Quantity,
+			UnitPrice:          item.GetUnitPrice(),
+			DiscountPercentage: item.DiscountPercentage,
+			FixedDiscount:      item.FixedDiscount,
+			TaxRateIDs:         item.TaxRateIds,
+		})
+	}
+
+	quote, err := h.service.CreateQuote(ctx, quote)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.QuoteResponse{Quote: models.ConvertQuoteToProto(quote)}, nil
+}
+
+func (h *InvoiceHandler) GetQuote(ctx context.Context, req *pb.QuoteRequest) (*pb.QuoteResponse, error) {
+	quote, err := h.service.GetQuote(ctx, req.QuoteId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.QuoteResponse{Quote: models.ConvertQuoteToProto(quote)}, nil
+}
+
+func (h *InvoiceHandler) ListQuotes(ctx context.Context, req *pb.ListQuotesRequest) (*pb.ListQuotesResponse, error) {
+	quotes, err := h.service.ListQuotes(ctx, req.UserId, req.Status)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoQuotes := make([]*pb.Quote, len(quotes))
+	for i, quote := range quotes {
+		protoQuotes[i] = models.ConvertQuoteToProto(quote)
+	}
+
+	return &pb.ListQuotesResponse{Quotes: protoQuotes}, nil
+}
+
+func (h *InvoiceHandler) MarkQuoteSent(ctx context.Context, req *pb.QuoteRequest) (*pb.QuoteResponse, error) {
+	quote, err := h.service.MarkQuoteSent(ctx, req.QuoteId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.QuoteResponse{Quote: models.ConvertQuoteToProto(quote)}, nil
+}
+
+func (h *InvoiceHandler) AcceptQuote(ctx context.Context, req *pb.QuoteRequest) (*pb.QuoteResponse, error) {
+	quote, err := h.service.AcceptQuote(ctx, req.QuoteId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.QuoteResponse{Quote: models.ConvertQuoteToProto(quote)}, nil
+}
+
+func (h *InvoiceHandler) DeclineQuote(ctx context.Context, req *pb.QuoteRequest) (*pb.QuoteResponse, error) {
+	quote, err := h.service.DeclineQuote(ctx, req.QuoteId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.QuoteResponse{Quote: models.ConvertQuoteToProto(quote)}, nil
+}
+
+func (h *InvoiceHandler) ConvertQuoteToInvoice(ctx context.Context, req *pb.QuoteRequest) (*pb.QuoteResponse, error) {
+	invoice, quote, err := h.service.ConvertQuoteToInvoice(ctx, req.QuoteId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.QuoteResponse{
+		Quote:   models.ConvertQuoteToProto(quote),
+		Invoice: models.ConvertInvoiceToProto(invoice),
+	}, nil
+}
@@ -0,0 +1,118 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *InvoiceHandler) CreateRecurringInvoice(ctx context.Context, req *pb.CreateRecurringInvoiceRequest) (*pb.RecurringInvoiceResponse, error) {
+	recurring := &models.RecurringInvoice{
+		UserID:             req.UserId,
+		CustomerID:         req.CustomerId,
+		CustomerEmail:      req.CustomerEmail,
+		Currency:           req.Currency,
+		DiscountPercentage: req.DiscountPercentage,
+		AccountName:        req.AccountName,
+		AccountNumber:      req.AccountNumber,
+		BankName:           req.BankName,
+		RoutingNumber:      req.RoutingNumber,
+		Note:               req.Note,
+		PaymentTermsDays:   req.PaymentTermsDays,
+		Frequency:          req.Frequency,
+		IntervalDays:       req.IntervalDays,
+		MaxOccurrences:     req.MaxOccurrences,
+		AutoSend:           req.AutoSend,
+	}
+	if req.StartDate != nil {
+		recurring.StartDate = req.StartDate.AsTime()
+	}
+	if req.EndDate != nil {
+		endDate := req.EndDate.AsTime()
+		recurring.EndDate = &endDate
+	}
+
+	// Add template items
+	for _, item := range req.Items {
+		recurring.Items = append(recurring.Items, &models.InvoiceItem{
+			Description:        item.Description,
+			Quantity:           item.Quantity,
+			UnitPrice:          item.GetUnitPrice(),
+			DiscountPercentage: item.DiscountPercentage,
+			FixedDiscount:      item.FixedDiscount,
+			TaxRateIDs:         item.TaxRateIds,
+		})
+	}
+
+	recurring, err := h.service.CreateRecurringInvoice(ctx, recurring)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.RecurringInvoiceResponse{RecurringInvoice: models.ConvertRecurringInvoiceToProto(recurring)}, nil
+}
+
+func (h *InvoiceHandler) GetRecurringInvoice(ctx context.Context, req *pb.RecurringInvoiceRequest) (*pb.RecurringInvoiceResponse, error) {
+	recurring, err := h.service.GetRecurringInvoice(ctx, req.RecurringInvoiceId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.RecurringInvoiceResponse{RecurringInvoice: models.ConvertRecurringInvoiceToProto(recurring)}, nil
+}
+
+func (h *InvoiceHandler) ListRecurringInvoices(ctx context.Context, req *pb.ListRecurringInvoicesRequest) (*pb.ListRecurringInvoicesResponse, error) {
+	recurringInvoices, err := h.service.ListRecurringInvoices(ctx, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoRecurringInvoices := make([]*pb.RecurringInvoice, len(recurringInvoices))
+	for i, recurring := range recurringInvoices {
+		protoRecurringInvoices[i] = models.ConvertRecurringInvoiceToProto(recurring)
+	}
+
+	return &pb.ListRecurringInvoicesResponse{RecurringInvoices: protoRecurringInvoices}, nil
+}
+
+func (h *InvoiceHandler) PauseRecurringInvoice(ctx context.Context, req *pb.RecurringInvoiceRequest) (*pb.RecurringInvoiceResponse, error) {
+	recurring, err := h.service.PauseRecurringInvoice(ctx, req.RecurringInvoiceId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.RecurringInvoiceResponse{RecurringInvoice: models.ConvertRecurringInvoiceToProto(recurring)}, nil
+}
+
+func (h *InvoiceHandler) ResumeRecurringInvoice(ctx context.Context, req *pb.RecurringInvoiceRequest) (*pb.RecurringInvoiceResponse, error) {
+	recurring, err := h.service.ResumeRecurringInvoice(ctx, req.RecurringInvoiceId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.RecurringInvoiceResponse{RecurringInvoice: models.ConvertRecurringInvoiceToProto(recurring)}, nil
+}
+
+func (h *InvoiceHandler) CancelRecurringInvoice(ctx context.Context, req *pb.RecurringInvoiceRequest) (*pb.RecurringInvoiceResponse, error) {
+	recurring, err := h.service.CancelRecurringInvoice(ctx, req.RecurringInvoiceId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.RecurringInvoiceResponse{RecurringInvoice: models.ConvertRecurringInvoiceToProto(recurring)}, nil
+}
+
+func (h *InvoiceHandler) ListRecurringInvoiceInvoices(ctx context.Context, req *pb.RecurringInvoiceRequest) (*pb.ListInvoicesResponse, error) {
+	invoices, err := h.service.ListRecurringInvoiceInvoices(ctx, req.RecurringInvoiceId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoInvoices := make([]*pb.Invoice, len(invoices))
+	for i, invoice := range invoices {
+		protoInvoices[i] = models.ConvertInvoiceToProto(invoice)
+	}
+
+	return &pb.ListInvoicesResponse{Invoices: protoInvoices}, nil
+}
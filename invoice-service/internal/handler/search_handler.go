@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *InvoiceHandler) SearchInvoices(ctx context.Context, req *pb.SearchInvoicesRequest) (*pb.SearchInvoicesResponse, error) {
+	customers := make([]models.CustomerMatch, len(req.Customers))
+	for i, customer := range req.Customers {
+		customers[i] = models.CustomerMatch{CustomerID: customer.CustomerId, Rank: customer.Rank}
+	}
+
+	results, nextPageToken, err := h.service.SearchInvoices(ctx, req.UserId, req.Query, customers, req.IncludeArchived, int(req.PageSize), req.PageToken)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.SearchInvoicesResponse{
+		Results:       models.ConvertInvoiceSearchResultsToProto(results),
+		NextPageToken: nextPageToken,
+	}, nil
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *InvoiceHandler) CreateInvoiceShare(ctx context.Context, req *pb.CreateInvoiceShareRequest) (*pb.InvoiceShareResponse, error) {
+	var expiresAt time.Time
+	if req.ExpiresAt != nil {
+		expiresAt = req.ExpiresAt.AsTime()
+	}
+
+	share, err := h.service.CreateInvoiceShare(ctx, req.InvoiceId, req.UserId, expiresAt)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.InvoiceShareResponse{Share: models.ConvertInvoiceShareToProto(share)}, nil
+}
+
+func (h *InvoiceHandler) ListInvoiceShares(ctx context.Context, req *pb.ListInvoiceSharesRequest) (*pb.ListInvoiceSharesResponse, error) {
+	shares, err := h.service.ListInvoiceShares(ctx, req.InvoiceId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoShares := make([]*pb.InvoiceShare, len(shares))
+	for i, share := range shares {
+		protoShares[i] = models.ConvertInvoiceShareToProto(share)
+	}
+
+	return &pb.ListInvoiceSharesResponse{Shares: protoShares}, nil
+}
+
+func (h *InvoiceHandler) RevokeInvoiceShare(ctx context.Context, req *pb.RevokeInvoiceShareRequest) (*pb.InvoiceShareResponse, error) {
+	share, err := h.service.RevokeInvoiceShare(ctx, req.ShareId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.InvoiceShareResponse{Share: models.ConvertInvoiceShareToProto(share)}, nil
+}
+
+func (h *InvoiceHandler) ViewSharedInvoice(ctx context.Context, req *pb.ViewSharedInvoiceRequest) (*pb.ViewSharedInvoiceResponse, error) {
+	viewSharedInvoice := h.service.ViewSharedInvoice
+	if req.SkipView {
+		viewSharedInvoice = h.service.GetSharedInvoice
+	}
+	invoice, share, err := viewSharedInvoice(ctx, req.Token)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+	branding, err := h.service.InvoiceBranding(ctx, invoice)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	resp := &pb.ViewSharedInvoiceResponse{
+		Invoice: models.ConvertInvoiceToProto(invoice),
+		Share:   models.ConvertInvoiceShareToProto(share),
+	}
+	if branding != nil {
+		resp.Branding = models.ConvertBrandingProfileToProto(branding)
+	}
+	return resp, nil
+}
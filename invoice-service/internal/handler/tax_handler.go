@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *InvoiceHandler) CreateTaxRate(ctx context.Context, req *pb.CreateTaxRateRequest) (*pb.CreateTaxRateResponse, error) {
+	taxRate, err := h.service.CreateTaxRate(ctx, &models.TaxRate{
+		UserID:    req.UserId,
+		Name:      req.Name,
+		Rate:      req.Rate,
+		Inclusive: req.Inclusive,
+		Compound:  req.Compound,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.CreateTaxRateResponse{TaxRate: models.ConvertTaxRateToProto(taxRate)}, nil
+}
+
+func (h *InvoiceHandler) ListTaxRates(ctx context.Context, req *pb.ListTaxRatesRequest) (*pb.ListTaxRatesResponse, error) {
+	taxRates, err := h.service.ListTaxRates(ctx, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	protoTaxRates := make([]*pb.TaxRate, len(taxRates))
+	for i, taxRate := range taxRates {
+		protoTaxRates[i] = models.ConvertTaxRateToProto(taxRate)
+	}
+
+	return &pb.ListTaxRatesResponse{TaxRates: protoTaxRates}, nil
+}
+
+func (h *InvoiceHandler) UpdateTaxRate(ctx context.Context, req *pb.UpdateTaxRateRequest) (*pb.UpdateTaxRateResponse, error) {
+	taxRate, err := h.service.UpdateTaxRate(ctx, &models.TaxRate{
+		ID:        req.TaxRateId,
+		UserID:    req.UserId,
+		Name:      req.Name,
+		Rate:      req.Rate,
+		Inclusive: req.Inclusive,
+		Compound:  req.Compound,
+	})
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.UpdateTaxRateResponse{TaxRate: models.ConvertTaxRateToProto(taxRate)}, nil
+}
+
+func (h *InvoiceHandler) DeleteTaxRate(ctx context.Context, req *pb.DeleteTaxRateRequest) (*pb.DeleteTaxRateResponse, error) {
+	err := h.service.DeleteTaxRate(ctx, req.TaxRateId, req.UserId)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.DeleteTaxRateResponse{Message: "tax rate successfully deleted"}, nil
+}
@@ -0,0 +1,22 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	pb "github.com/emzola/numer/invoice-service/proto"
+)
+
+func (h *InvoiceHandler) RenderInvoiceUBL(ctx context.Context, req *pb.RenderInvoiceUBLRequest) (*pb.RenderInvoiceUBLResponse, error) {
+	doc, invoice, err := h.service.RenderInvoiceUBL(ctx, req.InvoiceId, req.UserId,
+		models.ConvertProtoToParty(req.Issuer), models.ConvertProtoToParty(req.Customer))
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	return &pb.RenderInvoiceUBLResponse{
+		Xml:      doc,
+		Filename: fmt.Sprintf("invoice-%s.xml", invoice.InvoiceNumber),
+	}, nil
+}
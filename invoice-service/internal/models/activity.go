@@ -0,0 +1,68 @@
+package models
+
+// Activity actions published to the activity log for invoice events.
+const (
+	ActivityInvoiceCreated     = "Invoice creation"
+	ActivityInvoiceSent        = "Invoice sent"
+	ActivityInvoiceFinalized   = "Invoice finalized"
+	ActivityInvoiceVoided      = "Invoice voided"
+	ActivityInvoicePaid        = "Invoice paid"
+	ActivityInvoiceOverdue     = "Invoice overdue"
+	ActivityInvoiceDeleted     = "Invoice deleted"
+	ActivityInvoiceDuplicated  = "Invoice duplicated"
+	ActivityInvoiceArchived    = "Invoice archived"
+	ActivityInvoiceUnarchived  = "Invoice unarchived"
+	ActivityInvoiceViewed      = "Invoice viewed"
+	ActivityPaymentRecorded    = "Payment recorded"
+	ActivityPaymentRefunded    = "Payment refunded"
+	ActivityCreditNoteIssued   = "Credit note issued"
+	ActivityCreditNoteApplied  = "Credit note applied"
+	ActivityCreditNoteRefunded = "Credit note refunded"
+	ActivityLateFeeApplied     = "Late fee applied"
+	ActivityLateFeeReversed    = "Late fee reversed"
+)
+
+// Activity is the event published to the activity_logs queue.
+type Activity struct {
+	InvoiceID   int64  `json:"invoice_id"`
+	UserID      int64  `json:"user_id"`
+	Action      string `json:"action"`
+	Description string `json:"description"`
+}
+
+// PendingActivities collects the activities a change records until the repository writes them to the outbox,
+// in the same transaction as the change itself.
+type PendingActivities struct {
+	activities []pendingActivity
+}
+
+type pendingActivity struct {
+	action   string
+	describe func() string
+}
+
+// RecordActivity records an activity to be written with the next change saved.
+func (p *PendingActivities) RecordActivity(action, description string) {
+	p.RecordActivityFunc(action, func() string { return description })
+}
+
+// RecordActivityFunc records an activity whose description is only known once the change is saved, such as one
+// naming the number an invoice is given when it is inserted.
+func (p *PendingActivities) RecordActivityFunc(action string, describe func() string) {
+	p.activities = append(p.activities, pendingActivity{action: action, describe: describe})
+}
+
+// TakeActivities returns the recorded activities for an invoice and clears them.
+func (p *PendingActivities) TakeActivities(invoiceID, userID int64) []Activity {
+	activities := make([]Activity, len(p.activities))
+	for i, activity := range p.activities {
+		activities[i] = Activity{
+			InvoiceID:   invoiceID,
+			UserID:      userID,
+			Action:      activity.action,
+			Description: activity.describe(),
+		}
+	}
+	p.activities = nil
+	return activities
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// Attachment is a file, such as a timesheet or contract, kept with an invoice and emailed along with it.
+type Attachment struct {
+	ID          int64
+	InvoiceID   int64
+	UserID      int64
+	Filename    string
+	ContentType string
+	Size        int64  // Represented in bytes
+	Checksum    string // Hex-encoded SHA-256 of the contents
+	StorageKey  string // Where the contents are kept in the blob store
+	Content     []byte // Only set when the contents are loaded
+	CreatedAt   time.Time
+}
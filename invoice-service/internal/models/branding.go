@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// BrandingProfile is how a user's invoices look: the logo, accent colour and texts shown on the PDF, the shared
+// invoice page and the invoice email.
+type BrandingProfile struct {
+	ID                  int64
+	UserID              int64
+	Name                string
+	AccentColor         string // Hex colour such as "#1a73e8", empty for the plain look
+	Footer              string // Shown at the bottom of every page
+	PaymentInstructions string // Shown with the payment details
+	DefaultNote         string // Note of new invoices that don't give one
+	IsDefault           bool   // Used by the user's invoices that don't pick a profile
+	LogoContentType     string // image/png or image/jpeg, empty if there is no logo
+	Logo                []byte // Only set when the logo is loaded
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
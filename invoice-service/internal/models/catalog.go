@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// CatalogItem is a product or service a user sells, which invoice items can be filled in from.
+type CatalogItem struct {
+	ID          int64
+	UserID      int64
+	SKU         string
+	Description string
+	UnitPrice   int64  // Represented in cents
+	Unit        string // Unit of measure, such as "hour" or "kg"
+	TaxRateID   int64  // Tax rate applied by default, zero for none
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
@@ -0,0 +1,71 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Credit note allocation kinds.
+const (
+	AllocationKindInvoice = "invoice"
+	AllocationKindRefund  = "refund"
+)
+
+// CreditNote credits some or all of the lines of an issued invoice. Its total is applied to the original
+// invoice's balance when issued, and any credit left over can be applied to another invoice or refunded.
+type CreditNote struct {
+	ID                 int64
+	UserID             int64
+	InvoiceID          int64
+	CustomerID         int64
+	CreditNoteNumber   string
+	IssueDate          time.Time
+	Currency           string
+	Reason             string
+	Items              []*CreditNoteItem
+	DiscountPercentage int64 // Copied from the invoice, represented as hundredths of a percent
+	Subtotal           int64 // Represented in cents
+	DiscountAmount     int64 // Line and invoice discounts, represented in cents
+	Taxes              []*InvoiceTax
+	TaxTotal           int64 // Represented in cents
+	Total              int64 // Represented in cents
+	AmountApplied      int64 // Credit applied to invoices, represented in cents
+	AmountRefunded     int64 // Credit refunded to the customer, represented in cents
+	Allocations        []*CreditNoteAllocation
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+
+	PendingActivities // Activities against the credited invoice to write to the outbox with the next change saved
+}
+
+// FormatCreditNoteNumber returns the credit note number for the seq-th credit note of a user.
+func FormatCreditNoteNumber(seq int64) string {
+	return fmt.Sprintf("CN-%06d", seq)
+}
+
+// RemainingCredit returns the credit that has been neither applied nor refunded in cents.
+func (cn *CreditNote) RemainingCredit() int64 {
+	return cn.Total - cn.AmountApplied - cn.AmountRefunded
+}
+
+type CreditNoteItem struct {
+	ID             int64
+	InvoiceItemID  int64 // The invoice line being credited
+	Description    string
+	Quantity       int32
+	UnitPrice      int64      // Represented in cents
+	DiscountAmount int64      // The credited quantity's share of the line discount, represented in cents
+	Taxes          []*TaxRate // Snapshot of the tax rates applied to the invoice line
+}
+
+// CreditNoteAllocation records credit applied to an invoice or refunded to the customer.
+type CreditNoteAllocation struct {
+	ID           int64
+	CreditNoteID int64
+	Kind         string
+	InvoiceID    int64 // Zero for refunds
+	Amount       int64 // Represented in cents
+	Method       string
+	Reference    string
+	CreatedAt    time.Time
+}
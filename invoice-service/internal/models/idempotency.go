@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// IdempotencyKey is a request made with an idempotency key. A retry with the same key and fingerprint is
+// answered with the stored response until the key expires.
+type IdempotencyKey struct {
+	UserID      int64
+	Key         string
+	Fingerprint string // Hash of the method and request the key was first used for
+	Response    []byte // Serialized response, nil while the request is in progress
+	CreatedAt   time.Time
+	ExpiresAt   time.Time
+}
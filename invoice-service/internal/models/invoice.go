@@ -1,6 +1,20 @@
 package models
 
-import "time"
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Invoice statuses.
+const (
+	StatusDraft         = "draft"
+	StatusUnpaid        = "unpaid"
+	StatusPartiallyPaid = "partially_paid"
+	StatusPaid          = "paid"
+	StatusOverdue       = "overdue"
+	StatusVoid          = "void"
+)
 
 type Invoice struct {
 	ID                 int64
@@ -13,21 +27,91 @@ type Invoice struct {
 	Currency           string
 	Items              []*InvoiceItem
 	DiscountPercentage int64 // Represented as hundredths of a percent (e.g., 1000 = 10%)
-	Subtotal           int64 // Represented in cents
-	DiscountAmount     int64 // Represented in cents
-	Total              int64 // Represented in cents
+	FixedDiscount      int64 // Taken after the percentage discount, represented in cents
+	Charges            []*InvoiceCharge
+	Subtotal           int64 // Line amounts before discounts, represented in cents
+	DiscountAmount     int64 // Line and invoice discounts, represented in cents
+	ChargeTotal        int64 // Represented in cents
+	Taxes              []*InvoiceTax
+	TaxTotal           int64           // Represented in cents
+	Total              int64           // Represented in cents
+	AmountPaid         int64           // Represented in cents
+	AmountCredited     int64           // Credit note credit applied to the balance, represented in cents
+	CreditNoteTotal    int64           // Total of the credit notes issued against the invoice, represented in cents
+	LateFees           []*LateFee      // Late fees and interest charged since the invoice went overdue
+	LateFeeTotal       int64           // Late fees and interest not reversed, represented in cents
+	BaseCurrency       string          // The user's base currency when the invoice was issued
+	ExchangeRate       decimal.Decimal // Units of BaseCurrency per unit of Currency, zero until the invoice is issued
 	AccountName        string
 	AccountNumber      string
 	BankName           string
 	RoutingNumber      string
 	Note               string
+	RecurringInvoiceID int64      // The recurring invoice that generated this invoice, zero if none
+	QuoteID            int64      // The quote this invoice was converted from, zero if none
+	BrandingProfileID  int64      // The branding profile the invoice is rendered with, zero for the user's default
+	Version            int64      // Incremented on every change to the invoice
+	ArchivedAt         *time.Time // Nil unless the invoice is archived
 	CreatedAt          time.Time
 	UpdatedAt          time.Time
+
+	PendingActivities // Activities to write to the outbox with the next change saved
+}
+
+// BalanceDue returns the amount still owed on the invoice, late fees included, in cents.
+func (inv *Invoice) BalanceDue() int64 {
+	return inv.Total + inv.LateFeeTotal - inv.AmountPaid - inv.AmountCredited
 }
 
 type InvoiceItem struct {
+	ID                 int64
+	CatalogItemID      int64  // The catalog item the item was filled in from, zero if none
+	SKU                string // Snapshot of the catalog item's SKU
+	Description        string
+	Quantity           int32
+	Unit               string // Unit of measure, such as "hour" or "kg"
+	UnitPrice          int64  // Represented in cents
+	DiscountPercentage int64  // Line discount, represented as hundredths of a percent
+	FixedDiscount      int64  // Line discount taken after DiscountPercentage, represented in cents
+	TaxRateIDs         []int64
+	Taxes              []*TaxRate // Snapshot of the tax rates applied to the item
+	UnitPriceSet       bool       // UnitPrice was given, so a zero price overrides the catalog item's
+	TaxRateIDsSet      bool       // TaxRateIDs was given, so an empty list overrides the catalog item's tax rate
+}
+
+// Amount returns the line amount before discounts in cents.
+func (item *InvoiceItem) Amount() int64 {
+	return int64(item.Quantity) * item.UnitPrice
+}
+
+// DiscountAmount returns the line discount in cents: the percentage discount rounded half away from zero to
+// the cent, plus the fixed discount, never more than the line amount.
+func (item *InvoiceItem) DiscountAmount() int64 {
+	amount := item.Amount()
+	discount := decimal.NewFromInt(amount).Mul(decimal.NewFromInt(item.DiscountPercentage)).Div(decimal.NewFromInt(10000))
+	return min(discount.Round(0).IntPart()+item.FixedDiscount, amount)
+}
+
+// NetAmount returns the line amount after the line discount in cents.
+func (item *InvoiceItem) NetAmount() int64 {
+	return item.Amount() - item.DiscountAmount()
+}
+
+// InvoiceCharge is an extra charge, such as shipping or handling, added to an invoice after its discounts.
+type InvoiceCharge struct {
 	ID          int64
 	Description string
-	Quantity    int32
-	UnitPrice   int64 // Represented in cents
+	Amount      int64   // Represented in cents
+	TaxRateIDs  []int64 // Empty if the charge is not taxable
+	Taxes       []*TaxRate
+}
+
+// Party is the issuer or customer named on an invoice document.
+type Party struct {
+	Name     string
+	Email    string
+	Address  string
+	Country  string // ISO 3166-1 alpha-2 code
+	TaxID    string // VAT or other tax registration number, prefixed with the country code
+	PeppolID string // Peppol participant identifier, as scheme:identifier
 }
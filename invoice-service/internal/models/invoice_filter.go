@@ -0,0 +1,63 @@
+package models
+
+import "time"
+
+// Fields an invoice list can be sorted by.
+const (
+	SortIssueDate     = "issue_date"
+	SortDueDate       = "due_date"
+	SortCreatedAt     = "created_at"
+	SortTotal         = "total"
+	SortInvoiceNumber = "invoice_number"
+)
+
+// InvoiceFilter selects and orders a page of a user's invoices. Zero-valued fields don't filter, except that
+// archived invoices are left out unless IncludeArchived is set. The date ranges are whole days and include
+// both ends.
+type InvoiceFilter struct {
+	UserID          int64
+	Statuses        []string
+	CustomerID      int64
+	Currency        string
+	IssueDateFrom   time.Time
+	IssueDateTo     time.Time
+	DueDateFrom     time.Time
+	DueDateTo       time.Time
+	MinTotal        int64 // Represented in cents
+	MaxTotal        int64 // Represented in cents
+	SortBy          string
+	Descending      bool
+	After           *InvoiceCursor // Only invoices after this position are listed
+	Limit           int
+	WithoutItems    bool // Leaves out the items and taxes of the invoices
+	IncludeArchived bool // Lists archived invoices along with the others
+}
+
+// InvoiceCursor is the position of an invoice in a sorted list: its sort key, with the ID breaking ties.
+// Only the field matching SortBy is set.
+type InvoiceCursor struct {
+	SortBy        string    `json:"sort_by"`
+	Descending    bool      `json:"descending"`
+	Time          time.Time `json:"time"`
+	Total         int64     `json:"total,omitempty"`
+	InvoiceNumber string    `json:"invoice_number,omitempty"`
+	ID            int64     `json:"id"`
+}
+
+// CursorAfter returns the cursor positioned at invoice in a list sorted by sortBy.
+func CursorAfter(invoice *Invoice, sortBy string, descending bool) *InvoiceCursor {
+	cursor := &InvoiceCursor{SortBy: sortBy, Descending: descending, ID: invoice.ID}
+	switch sortBy {
+	case SortIssueDate:
+		cursor.Time = invoice.IssueDate
+	case SortDueDate:
+		cursor.Time = invoice.DueDate
+	case SortCreatedAt:
+		cursor.Time = invoice.CreatedAt
+	case SortTotal:
+		cursor.Total = invoice.Total
+	case SortInvoiceNumber:
+		cursor.InvoiceNumber = invoice.InvoiceNumber
+	}
+	return cursor
+}
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// Late fee kinds.
+const (
+	LateFeeFlat       = "flat"       // A fixed fee, charged once
+	LateFeePercentage = "percentage" // A percentage of the overdue balance, charged once
+	LateFeeInterest   = "interest"   // Simple interest on the overdue balance at an annual rate, accrued daily
+)
+
+// LateFeePolicy is how a user's overdue invoices are charged for paying late. Fees start once the invoice has
+// been overdue for GraceDays days.
+type LateFeePolicy struct {
+	UserID    int64
+	Kind      string
+	Amount    int64 // Flat fee, represented in cents
+	Rate      int64 // Percentage or annual interest rate, represented as hundredths of a percent (e.g., 150 = 1.5%)
+	GraceDays int32
+	Cap       int64 // Most that late fees can add to one invoice in cents, zero for no limit
+	UpdatedAt time.Time
+}
+
+// LateFee is a late fee or interest charge added to an overdue invoice on top of its items and charges.
+type LateFee struct {
+	ID          int64
+	InvoiceID   int64
+	UserID      int64
+	Kind        string
+	Description string
+	Amount      int64     // Represented in cents
+	PeriodStart time.Time // For interest, the first day charged for; otherwise the day the fee was charged
+	PeriodEnd   time.Time // For interest, the day after the last day charged for; otherwise the day the fee was charged
+	ReversedAt  *time.Time
+	CreatedAt   time.Time
+}
@@ -2,6 +2,7 @@ package models
 
 import (
 	pb "github.com/emzola/numer/invoice-service/proto"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -10,27 +11,47 @@ func ConvertProtoItemsToInvoiceItems(protoItems []*pb.InvoiceItem) []InvoiceItem
 	items := make([]InvoiceItem, len(protoItems))
 	for i, protoItem := range protoItems {
 		items[i] = InvoiceItem{
-			Description: protoItem.Description,
-			Quantity:    protoItem.Quantity,
-			UnitPrice:   protoItem.UnitPrice,
+			CatalogItemID:      protoItem.CatalogItemId,
+			SKU:                protoItem.Sku,
+			Description:        protoItem.Description,
+			Quantity:           protoItem.Quantity,
+			Unit:               protoItem.Unit,
+			UnitPrice:          protoItem.GetUnitPrice(),
+			DiscountPercentage: protoItem.DiscountPercentage,
+			FixedDiscount:      protoItem.FixedDiscount,
+			TaxRateIDs:         protoItem.TaxRateIds,
+			UnitPriceSet:       protoItem.UnitPrice != nil,
+			TaxRateIDsSet:      protoItem.TaxRateIdsSet,
 		}
 	}
 	return items
 }
 
+// ConvertProtoChargesToInvoiceCharges converts a slice of protobuf InvoiceCharge messages to the corresponding Go model structs.
+func ConvertProtoChargesToInvoiceCharges(protoCharges []*pb.InvoiceCharge) []*InvoiceCharge {
+	charges := make([]*InvoiceCharge, len(protoCharges))
+	for i, protoCharge := range protoCharges {
+		charges[i] = &InvoiceCharge{
+			Description: protoCharge.Description,
+			Amount:      protoCharge.Amount,
+			TaxRateIDs:  protoCharge.TaxRateIds,
+		}
+	}
+	return charges
+}
+
 // ConvertInvoiceToProto converts a Go model struct to protobuf Invoice message.
 func ConvertInvoiceToProto(inv *Invoice) *pb.Invoice {
-	protoInvoiceItems := make([]*pb.InvoiceItem, len(inv.Items))
-	for i, item := range inv.Items {
-		protoInvoiceItems[i] = &pb.InvoiceItem{
-			Id:          item.ID,
-			Description: item.Description,
-			Quantity:    item.Quantity,
-			UnitPrice:   item.UnitPrice,
-		}
+	protoInvoiceItems := convertInvoiceItemsToProto(inv.Items)
+
+	protoInvoiceTaxes := convertInvoiceTaxesToProto(inv.Taxes)
+
+	var exchangeRate string
+	if !inv.ExchangeRate.IsZero() {
+		exchangeRate = inv.ExchangeRate.String()
 	}
 
-	return &pb.Invoice{
+	protoInvoice := &pb.Invoice{
 		Id:                 inv.ID,
 		UserId:             inv.UserID,
 		CustomerId:         inv.CustomerID,
@@ -43,11 +64,402 @@ func ConvertInvoiceToProto(inv *Invoice) *pb.Invoice {
 		DiscountPercentage: inv.DiscountPercentage,
 		Subtotal:           inv.Subtotal,
 		DiscountAmount:     inv.DiscountAmount,
+		FixedDiscount:      inv.FixedDiscount,
+		Charges:            convertInvoiceChargesToProto(inv.Charges),
+		ChargeTotal:        inv.ChargeTotal,
+		Taxes:              protoInvoiceTaxes,
+		TaxTotal:           inv.TaxTotal,
 		Total:              inv.Total,
+		AmountPaid:         inv.AmountPaid,
+		AmountCredited:     inv.AmountCredited,
+		CreditNoteTotal:    inv.CreditNoteTotal,
+		LateFees:           convertLateFeesToProto(inv.LateFees),
+		LateFeeTotal:       inv.LateFeeTotal,
+		BalanceDue:         inv.BalanceDue(),
+		BaseCurrency:       inv.BaseCurrency,
+		ExchangeRate:       exchangeRate,
 		AccountName:        inv.AccountName,
-		AccountNumber:      inv.AccountName,
+		AccountNumber:      inv.AccountNumber,
 		BankName:           inv.BankName,
 		RoutingNumber:      inv.RoutingNumber,
 		Note:               inv.Note,
+		RecurringInvoiceId: inv.RecurringInvoiceID,
+		QuoteId:            inv.QuoteID,
+		BrandingProfileId:  inv.BrandingProfileID,
+		Version:            inv.Version,
+	}
+	if inv.ArchivedAt != nil {
+		protoInvoice.ArchivedAt = timestamppb.New(*inv.ArchivedAt)
+	}
+	return protoInvoice
+}
+
+func convertInvoiceItemsToProto(items []*InvoiceItem) []*pb.InvoiceItem {
+	protoItems := make([]*pb.InvoiceItem, len(items))
+	for i, item := range items {
+		protoTaxes := make([]*pb.TaxRate, len(item.Taxes))
+		for j, tax := range item.Taxes {
+			protoTaxes[j] = ConvertTaxRateToProto(tax)
+		}
+		protoItems[i] = &pb.InvoiceItem{
+			Id:                 item.ID,
+			Description:        item.Description,
+			Quantity:           item.Quantity,
+			UnitPrice:          proto.Int64(item.UnitPrice),
+			TaxRateIds:         item.TaxRateIDs,
+			Taxes:              protoTaxes,
+			DiscountPercentage: item.DiscountPercentage,
+			FixedDiscount:      item.FixedDiscount,
+			DiscountAmount:     item.DiscountAmount(),
+			Amount:             item.NetAmount(),
+			CatalogItemId:      item.CatalogItemID,
+			Sku:                item.SKU,
+			Unit:               item.Unit,
+		}
+	}
+	return protoItems
+}
+
+func convertInvoiceChargesToProto(charges []*InvoiceCharge) []*pb.InvoiceCharge {
+	protoCharges := make([]*pb.InvoiceCharge, len(charges))
+	for i, charge := range charges {
+		protoTaxes := make([]*pb.TaxRate, len(charge.Taxes))
+		for j, tax := range charge.Taxes {
+			protoTaxes[j] = ConvertTaxRateToProto(tax)
+		}
+		protoCharges[i] = &pb.InvoiceCharge{
+			Id:          charge.ID,
+			Description: charge.Description,
+			Amount:      charge.Amount,
+			TaxRateIds:  charge.TaxRateIDs,
+			Taxes:       protoTaxes,
+		}
+	}
+	return protoCharges
+}
+
+func convertInvoiceTaxesToProto(taxes []*InvoiceTax) []*pb.InvoiceTax {
+	protoTaxes := make([]*pb.InvoiceTax, len(taxes))
+	for i, tax := range taxes {
+		protoTaxes[i] = &pb.InvoiceTax{
+			TaxRateId:     tax.TaxRateID,
+			Name:          tax.Name,
+			Rate:          tax.Rate,
+			Inclusive:     tax.Inclusive,
+			Compound:      tax.Compound,
+			TaxableAmount: tax.TaxableAmount,
+			Amount:        tax.Amount,
+		}
+	}
+	return protoTaxes
+}
+
+// ConvertPaymentToProto converts a Go model struct to protobuf Payment message.
+func ConvertPaymentToProto(payment *Payment) *pb.Payment {
+	protoPayment := &pb.Payment{
+		Id:          payment.ID,
+		InvoiceId:   payment.InvoiceID,
+		Amount:      payment.Amount,
+		Method:      payment.Method,
+		Reference:   payment.Reference,
+		PaymentDate: timestamppb.New(payment.PaymentDate),
+	}
+	if payment.RefundedAt != nil {
+		protoPayment.RefundedAt = timestamppb.New(*payment.RefundedAt)
+	}
+	return protoPayment
+}
+
+// ConvertTaxRateToProto converts a Go model struct to protobuf TaxRate message.
+func ConvertTaxRateToProto(taxRate *TaxRate) *pb.TaxRate {
+	return &pb.TaxRate{
+		Id:        taxRate.ID,
+		UserId:    taxRate.UserID,
+		Name:      taxRate.Name,
+		Rate:      taxRate.Rate,
+		Inclusive: taxRate.Inclusive,
+		Compound:  taxRate.Compound,
+	}
+}
+
+// ConvertExchangeRateToProto converts a Go model struct to protobuf ExchangeRate message.
+func ConvertExchangeRateToProto(rate *ExchangeRate) *pb.ExchangeRate {
+	return &pb.ExchangeRate{
+		Id:            rate.ID,
+		UserId:        rate.UserID,
+		BaseCurrency:  rate.BaseCurrency,
+		Currency:      rate.Currency,
+		Rate:          rate.Rate.String(),
+		EffectiveDate: timestamppb.New(rate.EffectiveDate),
+	}
+}
+
+// ConvertRecurringInvoiceToProto converts a Go model struct to protobuf RecurringInvoice message.
+func ConvertRecurringInvoiceToProto(recurring *RecurringInvoice) *pb.RecurringInvoice {
+	protoRecurring := &pb.RecurringInvoice{
+		Id:                 recurring.ID,
+		UserId:             recurring.UserID,
+		CustomerId:         recurring.CustomerID,
+		CustomerEmail:      recurring.CustomerEmail,
+		Currency:           recurring.Currency,
+		Items:              convertInvoiceItemsToProto(recurring.Items),
+		DiscountPercentage: recurring.DiscountPercentage,
+		AccountName:        recurring.AccountName,
+		AccountNumber:      recurring.AccountNumber,
+		BankName:           recurring.BankName,
+		RoutingNumber:      recurring.RoutingNumber,
+		Note:               recurring.Note,
+		PaymentTermsDays:   recurring.PaymentTermsDays,
+		Frequency:          recurring.Frequency,
+		IntervalDays:       recurring.IntervalDays,
+		StartDate:          timestamppb.New(recurring.StartDate),
+		MaxOccurrences:     recurring.MaxOccurrences,
+		Occurrences:        recurring.Occurrences,
+		NextRunDate:        timestamppb.New(recurring.NextRunDate),
+		AutoSend:           recurring.AutoSend,
+		Status:             recurring.Status,
+	}
+	if recurring.EndDate != nil {
+		protoRecurring.EndDate = timestamppb.New(*recurring.EndDate)
+	}
+	return protoRecurring
+}
+
+// ConvertCreditNoteToProto converts a Go model struct to protobuf CreditNote message.
+func ConvertCreditNoteToProto(creditNote *CreditNote) *pb.CreditNote {
+	protoItems := make([]*pb.CreditNoteItem, len(creditNote.Items))
+	for i, item := range creditNote.Items {
+		protoTaxes := make([]*pb.TaxRate, len(item.Taxes))
+		for j, tax := range item.Taxes {
+			protoTaxes[j] = ConvertTaxRateToProto(tax)
+		}
+		protoItems[i] = &pb.CreditNoteItem{
+			Id:             item.ID,
+			InvoiceItemId:  item.InvoiceItemID,
+			Description:    item.Description,
+			Quantity:       item.Quantity,
+			UnitPrice:      item.UnitPrice,
+			DiscountAmount: item.DiscountAmount,
+			Taxes:          protoTaxes,
+		}
+	}
+
+	protoAllocations := make([]*pb.CreditNoteAllocation, len(creditNote.Allocations))
+	for i, allocation := range creditNote.Allocations {
+		protoAllocations[i] = &pb.CreditNoteAllocation{
+			Id:        allocation.ID,
+			Kind:      allocation.Kind,
+			InvoiceId: allocation.InvoiceID,
+			Amount:    allocation.Amount,
+			Method:    allocation.Method,
+			Reference: allocation.Reference,
+			CreatedAt: timestamppb.New(allocation.CreatedAt),
+		}
+	}
+
+	return &pb.CreditNote{
+		Id:                 creditNote.ID,
+		UserId:             creditNote.UserID,
+		InvoiceId:          creditNote.InvoiceID,
+		CustomerId:         creditNote.CustomerID,
+		CreditNoteNumber:   creditNote.CreditNoteNumber,
+		IssueDate:          timestamppb.New(creditNote.IssueDate),
+		Currency:           creditNote.Currency,
+		Reason:             creditNote.Reason,
+		Items:              protoItems,
+		DiscountPercentage: creditNote.DiscountPercentage,
+		Subtotal:           creditNote.Subtotal,
+		DiscountAmount:     creditNote.DiscountAmount,
+		Taxes:              convertInvoiceTaxesToProto(creditNote.Taxes),
+		TaxTotal:           creditNote.TaxTotal,
+		Total:              creditNote.Total,
+		AmountApplied:      creditNote.AmountApplied,
+		AmountRefunded:     creditNote.AmountRefunded,
+		RemainingCredit:    creditNote.RemainingCredit(),
+		Allocations:        protoAllocations,
+	}
+}
+
+// ConvertQuoteToProto converts a Go model struct to protobuf Quote message.
+func ConvertQuoteToProto(quote *Quote) *pb.Quote {
+	return &pb.Quote{
+		Id:                 quote.ID,
+		UserId:             quote.UserID,
+		CustomerId:         quote.CustomerID,
+		QuoteNumber:        quote.QuoteNumber,
+		Status:             quote.Status,
+		IssueDate:          timestamppb.New(quote.IssueDate),
+		ExpiryDate:         timestamppb.New(quote.ExpiryDate),
+		Currency:           quote.Currency,
+		Items:              convertInvoiceItemsToProto(quote.Items),
+		DiscountPercentage: quote.DiscountPercentage,
+		Subtotal:           quote.Subtotal,
+		DiscountAmount:     quote.DiscountAmount,
+		Taxes:              convertInvoiceTaxesToProto(quote.Taxes),
+		TaxTotal:           quote.TaxTotal,
+		Total:              quote.Total,
+		PaymentTermsDays:   quote.PaymentTermsDays,
+		Note:               quote.Note,
+		InvoiceId:          quote.InvoiceID,
+	}
+}
+
+// ConvertProtoToParty converts a protobuf Party message to the Go model struct. A nil message gives an empty party.
+func ConvertProtoToParty(party *pb.Party) Party {
+	return Party{
+		Name:     party.GetName(),
+		Email:    party.GetEmail(),
+		Address:  party.GetAddress(),
+		Country:  party.GetCountry(),
+		TaxID:    party.GetTaxId(),
+		PeppolID: party.GetPeppolId(),
+	}
+}
+
+// ConvertInvoiceShareToProto converts a Go model struct to protobuf InvoiceShare message.
+func ConvertInvoiceShareToProto(share *InvoiceShare) *pb.InvoiceShare {
+	protoShare := &pb.InvoiceShare{
+		Id:        share.ID,
+		InvoiceId: share.InvoiceID,
+		Token:     share.Token,
+		ExpiresAt: timestamppb.New(share.ExpiresAt),
+		ViewCount: share.ViewCount,
+		CreatedAt: timestamppb.New(share.CreatedAt),
+	}
+	if share.RevokedAt != nil {
+		protoShare.RevokedAt = timestamppb.New(*share.RevokedAt)
+	}
+	if share.LastViewedAt != nil {
+		protoShare.LastViewedAt = timestamppb.New(*share.LastViewedAt)
+	}
+	return protoShare
+}
+
+// ConvertAttachmentToProto converts a Go model struct to protobuf Attachment message.
+func ConvertAttachmentToProto(attachment *Attachment) *pb.Attachment {
+	return &pb.Attachment{
+		Id:          attachment.ID,
+		InvoiceId:   attachment.InvoiceID,
+		Filename:    attachment.Filename,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		Checksum:    attachment.Checksum,
+		Content:     attachment.Content,
+		CreatedAt:   timestamppb.New(attachment.CreatedAt),
+	}
+}
+
+// ConvertLateFeePolicyToProto converts a Go model struct to protobuf LateFeePolicy message.
+func ConvertLateFeePolicyToProto(policy *LateFeePolicy) *pb.LateFeePolicy {
+	return &pb.LateFeePolicy{
+		Kind:      policy.Kind,
+		Amount:    policy.Amount,
+		Rate:      policy.Rate,
+		GraceDays: policy.GraceDays,
+		Cap:       policy.Cap,
+		UpdatedAt: timestamppb.New(policy.UpdatedAt),
+	}
+}
+
+// ConvertLateFeeToProto converts a Go model struct to protobuf LateFee message.
+func ConvertLateFeeToProto(fee *LateFee) *pb.LateFee {
+	protoFee := &pb.LateFee{
+		Id:          fee.ID,
+		InvoiceId:   fee.InvoiceID,
+		Kind:        fee.Kind,
+		Description: fee.Description,
+		Amount:      fee.Amount,
+		PeriodStart: timestamppb.New(fee.PeriodStart),
+		PeriodEnd:   timestamppb.New(fee.PeriodEnd),
+		CreatedAt:   timestamppb.New(fee.CreatedAt),
+	}
+	if fee.ReversedAt != nil {
+		protoFee.ReversedAt = timestamppb.New(*fee.ReversedAt)
+	}
+	return protoFee
+}
+
+func convertLateFeesToProto(fees []*LateFee) []*pb.LateFee {
+	protoFees := make([]*pb.LateFee, len(fees))
+	for i, fee := range fees {
+		protoFees[i] = ConvertLateFeeToProto(fee)
+	}
+	return protoFees
+}
+
+func ConvertImportErrorsToProto(importErrors []ImportError) []*pb.ImportError {
+	protoErrors := make([]*pb.ImportError, len(importErrors))
+	for i, importError := range importErrors {
+		protoErrors[i] = &pb.ImportError{Row: int32(importError.Row), Message: importError.Message}
+	}
+	return protoErrors
+}
+
+func ConvertInvoiceSearchResultsToProto(results []*InvoiceSearchResult) []*pb.InvoiceSearchResult {
+	protoResults := make([]*pb.InvoiceSearchResult, len(results))
+	for i, result := range results {
+		protoResults[i] = &pb.InvoiceSearchResult{
+			Invoice: ConvertInvoiceToProto(result.Invoice),
+			Rank:    result.Rank,
+			Highlights: &pb.InvoiceHighlights{
+				InvoiceNumber: result.Highlights.InvoiceNumber,
+				Note:          result.Highlights.Note,
+				Items:         result.Highlights.Items,
+			},
+		}
+	}
+	return protoResults
+}
+
+// ConvertOutboxEventToProto converts a Go model struct to protobuf OutboxEvent message.
+func ConvertOutboxEventToProto(event *OutboxEvent) *pb.OutboxEvent {
+	protoEvent := &pb.OutboxEvent{
+		Id:            event.ID,
+		AggregateType: event.AggregateType,
+		AggregateId:   event.AggregateID,
+		EventType:     event.EventType,
+		Payload:       event.Payload,
+		Status:        event.Status,
+		Attempts:      event.Attempts,
+		LastError:     event.LastError,
+		NextAttemptAt: timestamppb.New(event.NextAttemptAt),
+		CreatedAt:     timestamppb.New(event.CreatedAt),
+	}
+	if event.PublishedAt != nil {
+		protoEvent.PublishedAt = timestamppb.New(*event.PublishedAt)
+	}
+	return protoEvent
+}
+
+// ConvertBrandingProfileToProto converts a Go model struct to protobuf BrandingProfile message.
+func ConvertBrandingProfileToProto(profile *BrandingProfile) *pb.BrandingProfile {
+	return &pb.BrandingProfile{
+		Id:                  profile.ID,
+		UserId:              profile.UserID,
+		Name:                profile.Name,
+		AccentColor:         profile.AccentColor,
+		Footer:              profile.Footer,
+		PaymentInstructions: profile.PaymentInstructions,
+		DefaultNote:         profile.DefaultNote,
+		IsDefault:           profile.IsDefault,
+		LogoContentType:     profile.LogoContentType,
+		Logo:                profile.Logo,
+		CreatedAt:           timestamppb.New(profile.CreatedAt),
+		UpdatedAt:           timestamppb.New(profile.UpdatedAt),
+	}
+}
+
+// ConvertCatalogItemToProto converts a Go model struct to protobuf CatalogItem message.
+func ConvertCatalogItemToProto(item *CatalogItem) *pb.CatalogItem {
+	return &pb.CatalogItem{
+		Id:          item.ID,
+		UserId:      item.UserID,
+		Sku:         item.SKU,
+		Description: item.Description,
+		UnitPrice:   item.UnitPrice,
+		Unit:        item.Unit,
+		TaxRateId:   item.TaxRateID,
+		CreatedAt:   timestamppb.New(item.CreatedAt),
+		UpdatedAt:   timestamppb.New(item.UpdatedAt),
 	}
 }
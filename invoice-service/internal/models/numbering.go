@@ -0,0 +1,69 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultInvoiceNumberFormat is used by users who haven't chosen a numbering scheme.
+const DefaultInvoiceNumberFormat = "{seq:6}"
+
+// NumberingScheme is how a user's invoices are numbered. Format is a template in which {YYYY}, {YY} and
+// {MM} stand for the invoice's issue date and {seq} or {seq:N} for the sequence number zero-padded to N digits,
+// e.g. INV-{YYYY}-{seq:5}.
+type NumberingScheme struct {
+	UserID      int64
+	Format      string
+	YearlyReset bool // The sequence restarts at 1 every calendar year
+	UpdatedAt   time.Time
+}
+
+// NumberPlaceholder matches the placeholders of a numbering format.
+var NumberPlaceholder = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// SequenceYear returns the year whose sequence an invoice issued on date draws from, or 0 if the
+// sequence never resets.
+func (s *NumberingScheme) SequenceYear(date time.Time) int {
+	if !s.YearlyReset {
+		return 0
+	}
+	return date.Year()
+}
+
+// FormatNumber formats the invoice number for sequence number seq on an invoice issued on date.
+// Unknown placeholders are left as they are.
+func (s *NumberingScheme) FormatNumber(seq int64, date time.Time) string {
+	return NumberPlaceholder.ReplaceAllStringFunc(s.Format, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+		switch name {
+		case "YYYY":
+			return fmt.Sprintf("%04d", date.Year())
+		case "YY":
+			return fmt.Sprintf("%02d", date.Year()%100)
+		case "MM":
+			return fmt.Sprintf("%02d", int(date.Month()))
+		case "seq":
+			return strconv.FormatInt(seq, 10)
+		}
+		if width, ok := SequenceWidth(name); ok {
+			return fmt.Sprintf("%0*d", width, seq)
+		}
+		return placeholder
+	})
+}
+
+// SequenceWidth returns N for a seq:N placeholder name.
+func SequenceWidth(name string) (int, bool) {
+	digits, ok := strings.CutPrefix(name, "seq:")
+	if !ok {
+		return 0, false
+	}
+	width, err := strconv.Atoi(digits)
+	if err != nil || width < 1 {
+		return 0, false
+	}
+	return width, true
+}
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// Outbox event statuses.
+const (
+	OutboxStatusPending   = "pending"   // Waiting to be published, possibly after a failed attempt
+	OutboxStatusPublished = "published" // Confirmed by the broker
+	OutboxStatusFailed    = "failed"    // Given up on after too many attempts, until an operator retries it
+)
+
+// AggregateInvoice is the aggregate type of events about an invoice. Events are published in order per aggregate.
+const AggregateInvoice = "invoice"
+
+// OutboxEvent is an event written in the same transaction as the change it describes, waiting to be published.
+type OutboxEvent struct {
+	ID            int64
+	AggregateType string
+	AggregateID   int64
+	EventType     string
+	Payload       []byte // The message body, in JSON
+	Status        string
+	Attempts      int32
+	LastError     string
+	NextAttemptAt time.Time
+	CreatedAt     time.Time
+	PublishedAt   *time.Time // Nil until the event is published
+}
+
+// OutboxStats counts the outbox events in each status.
+type OutboxStats struct {
+	Pending   int64
+	Published int64
+	Failed    int64
+	// OldestPendingAt is when the oldest pending event was written, nil if none are pending
+	OldestPendingAt *time.Time
+}
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// Payment methods.
+const (
+	PaymentMethodBankTransfer = "bank_transfer"
+	PaymentMethodCard         = "card"
+	PaymentMethodCash         = "cash"
+	PaymentMethodCheque       = "cheque"
+	PaymentMethodOther        = "other"
+)
+
+type Payment struct {
+	ID          int64
+	InvoiceID   int64
+	Amount      int64 // Represented in cents
+	Method      string
+	Reference   string
+	PaymentDate time.Time
+	RefundedAt  *time.Time // Nil unless the payment was refunded
+	CreatedAt   time.Time
+}
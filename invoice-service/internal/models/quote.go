@@ -0,0 +1,45 @@
+package models
+
+import (
+	"fmt"
+	"time"
+)
+
+// Quote statuses.
+const (
+	QuoteStatusDraft    = "draft"
+	QuoteStatusSent     = "sent"
+	QuoteStatusAccepted = "accepted"
+	QuoteStatusDeclined = "declined"
+	QuoteStatusExpired  = "expired"
+)
+
+// Quote is an estimate sent to a customer before any work is invoiced. Its items and amounts are calculated
+// like an invoice's, and an accepted quote converts into a draft invoice at the quoted amounts.
+type Quote struct {
+	ID                 int64
+	UserID             int64
+	CustomerID         int64
+	QuoteNumber        string
+	Status             string
+	IssueDate          time.Time
+	ExpiryDate         time.Time // The last day the quote can be accepted
+	Currency           string
+	Items              []*InvoiceItem
+	DiscountPercentage int64 // Represented as hundredths of a percent (e.g., 1000 = 10%)
+	Subtotal           int64 // Represented in cents
+	DiscountAmount     int64 // Represented in cents
+	Taxes              []*InvoiceTax
+	TaxTotal           int64 // Represented in cents
+	Total              int64 // Represented in cents
+	PaymentTermsDays   int32 // Days between the issue and due dates of the invoice the quote converts into
+	Note               string
+	InvoiceID          int64 // The invoice the quote was converted into, zero if none
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// FormatQuoteNumber returns the quote number for the seq-th quote of a user.
+func FormatQuoteNumber(seq int64) string {
+	return fmt.Sprintf("Q-%06d", seq)
+}
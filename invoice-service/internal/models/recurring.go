@@ -0,0 +1,48 @@
+package models
+
+import "time"
+
+// Recurring invoice frequencies.
+const (
+	FrequencyWeekly    = "weekly"
+	FrequencyMonthly   = "monthly"
+	FrequencyQuarterly = "quarterly"
+	FrequencyYearly    = "yearly"
+	FrequencyCustom    = "custom" // Every IntervalDays days
+)
+
+// Recurring invoice statuses.
+const (
+	RecurringStatusActive    = "active"
+	RecurringStatusPaused    = "paused"
+	RecurringStatusCancelled = "cancelled"
+	RecurringStatusCompleted = "completed"
+)
+
+// RecurringInvoice is a template that generates an invoice on a schedule.
+type RecurringInvoice struct {
+	ID                 int64
+	UserID             int64
+	CustomerID         int64
+	CustomerEmail      string // Where generated invoices are sent when AutoSend is set
+	Currency           string
+	Items              []*InvoiceItem
+	DiscountPercentage int64 // Represented as hundredths of a percent (e.g., 1000 = 10%)
+	AccountName        string
+	AccountNumber      string
+	BankName           string
+	RoutingNumber      string
+	Note               string
+	PaymentTermsDays   int32 // Days between a generated invoice's issue date and due date
+	Frequency          string
+	IntervalDays       int32 // Only used by the custom frequency
+	StartDate          time.Time
+	EndDate            *time.Time // No invoices are generated after this date
+	MaxOccurrences     int32      // Zero means no limit
+	Occurrences        int32      // Invoices generated so far
+	NextRunDate        time.Time
+	AutoSend           bool // Finalize and email each generated invoice
+	Status             string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
@@ -0,0 +1,40 @@
+package models
+
+// The repository wraps the words that matched a search in these control characters, which the service turns
+// into markup once the rest of the text is escaped.
+const (
+	HighlightStart = "\x02"
+	HighlightStop  = "\x03"
+)
+
+// InvoiceSearch selects a page of a user's invoices matching a full-text query, best matches first. Archived
+// invoices are left out unless IncludeArchived is set.
+type InvoiceSearch struct {
+	UserID          int64
+	Query           string          // A tsquery, in to_tsquery syntax
+	Customers       []CustomerMatch // Invoices for these customers match whether or not the query does
+	IncludeArchived bool
+	Offset          int
+	Limit           int
+}
+
+// CustomerMatch is a customer whose own details matched a search.
+type CustomerMatch struct {
+	CustomerID int64
+	Rank       float32
+}
+
+// InvoiceSearchResult is an invoice found by a search.
+type InvoiceSearchResult struct {
+	Invoice    *Invoice
+	Rank       float32
+	Highlights InvoiceHighlights
+}
+
+// InvoiceHighlights are the parts of an invoice shown in search results, with the matching words marked.
+// Fields that didn't match are empty.
+type InvoiceHighlights struct {
+	InvoiceNumber string
+	Note          string
+	Items         string
+}
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// InvoiceShare is a link that lets anyone holding its token view an invoice without signing in, until the link
+// expires or the invoice owner revokes it.
+type InvoiceShare struct {
+	ID           int64
+	InvoiceID    int64
+	UserID       int64
+	Token        string // Signed from the ID and expiry, never stored
+	ExpiresAt    time.Time
+	RevokedAt    *time.Time
+	ViewCount    int64
+	LastViewedAt *time.Time
+	CreatedAt    time.Time
+}
+
+// Active reports whether the link can still be used at the given time.
+func (s *InvoiceShare) Active(now time.Time) bool {
+	return s.RevokedAt == nil && now.Before(s.ExpiresAt)
+}
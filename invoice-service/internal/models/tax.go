@@ -0,0 +1,25 @@
+package models
+
+import "time"
+
+type TaxRate struct {
+	ID        int64
+	UserID    int64
+	Name      string
+	Rate      int64 // Represented as hundredths of a percent (e.g., 750 = 7.5%)
+	Inclusive bool  // The tax is already included in the item price
+	Compound  bool  // The tax is charged on top of the item's other taxes
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// InvoiceTax is the total charged for one tax rate across an invoice.
+type InvoiceTax struct {
+	TaxRateID     int64
+	Name          string
+	Rate          int64 // Represented as hundredths of a percent
+	Inclusive     bool
+	Compound      bool
+	TaxableAmount int64 // Represented in cents
+	Amount        int64 // Represented in cents
+}
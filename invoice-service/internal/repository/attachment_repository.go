@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+// CreateAttachment records an attachment if the invoice has room for it. The invoice row is locked so
+// concurrent uploads can't go over the limits together. It returns sql.ErrNoRows if the attachment would take
+// the invoice past maxCount attachments or maxTotalSize bytes.
+func (r *InvoiceRepository) CreateAttachment(ctx context.Context, attachment *models.Attachment, maxCount int, maxTotalSize int64) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Lock the invoice
+	var id int64
+	err = tx.QueryRowContext(ctx, `SELECT id FROM invoices WHERE id = $1 FOR UPDATE`, attachment.InvoiceID).Scan(&id)
+	if err != nil {
+		return err
+	}
+
+	// Check the invoice's existing attachments
+	var count int
+	var totalSize int64
+	query := `
+		SELECT COUNT(*), COALESCE(SUM(size), 0)
+		FROM invoice_attachments
+		WHERE invoice_id = $1`
+	err = tx.QueryRowContext(ctx, query, attachment.InvoiceID).Scan(&count, &totalSize)
+	if err != nil {
+		return err
+	}
+	if count >= maxCount || totalSize+attachment.Size > maxTotalSize {
+		return sql.ErrNoRows
+	}
+
+	query = `
+		INSERT INTO invoice_attachments (invoice_id, user_id, filename, content_type, size, checksum, storage_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at`
+	err = tx.QueryRowContext(ctx, query, attachment.InvoiceID, attachment.UserID, attachment.Filename,
+		attachment.ContentType, attachment.Size, attachment.Checksum, attachment.StorageKey).Scan(
+		&attachment.ID, &attachment.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *InvoiceRepository) GetAttachmentByID(ctx context.Context, attachmentID int64) (*models.Attachment, error) {
+	var attachment models.Attachment
+	query := `
+		SELECT id, invoice_id, user_id, filename, content_type, size, checksum, storage_key, created_at
+		FROM invoice_attachments
+		WHERE id = $1`
+	err := r.db.QueryRowContext(ctx, query, attachmentID).Scan(&attachment.ID, &attachment.InvoiceID,
+		&attachment.UserID, &attachment.Filename, &attachment.ContentType, &attachment.Size, &attachment.Checksum,
+		&attachment.StorageKey, &attachment.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &attachment, nil
+}
+
+// ListAttachmentsByInvoiceID returns the attachments of an invoice in the order they were added.
+func (r *InvoiceRepository) ListAttachmentsByInvoiceID(ctx context.Context, invoiceID int64) ([]*models.Attachment, error) {
+	var attachments []*models.Attachment
+	query := `
+		SELECT id, invoice_id, user_id, filename, content_type, size, checksum, storage_key, created_at
+		FROM invoice_attachments
+		WHERE invoice_id = $1
+		ORDER BY id`
+	rows, err := r.db.QueryContext(ctx, query, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var attachment models.Attachment
+		err := rows.Scan(&attachment.ID, &attachment.InvoiceID, &attachment.UserID, &attachment.Filename,
+			&attachment.ContentType, &attachment.Size, &attachment.Checksum, &attachment.StorageKey,
+			&attachment.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		attachments = append(attachments, &attachment)
+	}
+	return attachments, rows.Err()
+}
+
+// DeleteAttachment deletes an attachment's record. It returns sql.ErrNoRows if it was already deleted.
+func (r *InvoiceRepository) DeleteAttachment(ctx context.Context, attachmentID int64) error {
+	query := `DELETE FROM invoice_attachments WHERE id = $1`
+	result, err := r.db.ExecContext(ctx, query, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+// brandingProfileColumns leaves out the logo itself, which only GetBrandingProfile loads.
+const brandingProfileColumns = `
+	id, user_id, name, accent_color, footer, payment_instructions, default_note, is_default, logo_content_type,
+	created_at, updated_at`
+
+// CreateBrandingProfile inserts a branding profile. If it is the default profile, it takes over from the user's
+// previous default.
+func (r *InvoiceRepository) CreateBrandingProfile(ctx context.Context, profile *models.BrandingProfile) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = clearDefaultBrandingProfile(ctx, tx, profile)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO branding_profiles (user_id, name, accent_color, footer, payment_instructions, default_note, is_default)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at, updated_at`
+	err = tx.QueryRowContext(ctx, query, profile.UserID, profile.Name, profile.AccentColor, profile.Footer,
+		profile.PaymentInstructions, profile.DefaultNote, profile.IsDefault).Scan(
+		&profile.ID, &profile.CreatedAt, &profile.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetBrandingProfile returns one of the user's branding profiles with its logo, or the user's default profile if
+// profileID is zero. It returns sql.ErrNoRows if there is no such profile.
+func (r *InvoiceRepository) GetBrandingProfile(ctx context.Context, userID, profileID int64) (*models.BrandingProfile, error) {
+	query := `
+		SELECT ` + brandingProfileColumns + `, logo
+		FROM branding_profiles
+		WHERE user_id = $1 AND (id = $2 OR ($2 = 0 AND is_default))`
+	var profile models.BrandingProfile
+	err := r.db.QueryRowContext(ctx, query, userID, profileID).Scan(&profile.ID, &profile.UserID, &profile.Name,
+		&profile.AccentColor, &profile.Footer, &profile.PaymentInstructions, &profile.DefaultNote, &profile.IsDefault,
+		&profile.LogoContentType, &profile.CreatedAt, &profile.UpdatedAt, &profile.Logo)
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// ListBrandingProfilesByUserID returns the user's branding profiles without their logos, by name.
+func (r *InvoiceRepository) ListBrandingProfilesByUserID(ctx context.Context, userID int64) ([]*models.BrandingProfile, error) {
+	query := `
+		SELECT ` + brandingProfileColumns + `
+		FROM branding_profiles
+		WHERE user_id = $1
+		ORDER BY name, id`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var profiles []*models.BrandingProfile
+	for rows.Next() {
+		var profile models.BrandingProfile
+		err := rows.Scan(&profile.ID, &profile.UserID, &profile.Name, &profile.AccentColor, &profile.Footer,
+			&profile.PaymentInstructions, &profile.DefaultNote, &profile.IsDefault, &profile.LogoContentType,
+			&profile.CreatedAt, &profile.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, &profile)
+	}
+	return profiles, rows.Err()
+}
+
+// UpdateBrandingProfile changes a branding profile owned by profile.UserID, leaving its logo alone. If it becomes
+// the default profile, it takes over from the user's previous default. It returns sql.ErrNoRows if there is no
+// such profile.
+func (r *InvoiceRepository) UpdateBrandingProfile(ctx context.Context, profile *models.BrandingProfile) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = clearDefaultBrandingProfile(ctx, tx, profile)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE branding_profiles
+		SET name = $1, accent_color = $2, footer = $3, payment_instructions = $4, default_note = $5, is_default = $6,
+			updated_at = NOW()
+		WHERE id = $7 AND user_id = $8
+		RETURNING logo_content_type, created_at, updated_at`
+	err = tx.QueryRowContext(ctx, query, profile.Name, profile.AccentColor, profile.Footer, profile.PaymentInstructions,
+		profile.DefaultNote, profile.IsDefault, profile.ID, profile.UserID).Scan(
+		&profile.LogoContentType, &profile.CreatedAt, &profile.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// SetBrandingProfileLogo replaces the logo of a branding profile owned by profile.UserID, or removes it if
+// profile.Logo is empty. It returns sql.ErrNoRows if there is no such profile.
+func (r *InvoiceRepository) SetBrandingProfileLogo(ctx context.Context, profile *models.BrandingProfile) error {
+	query := `
+		UPDATE branding_profiles
+		SET logo = $1, logo_content_type = $2, updated_at = NOW()
+		WHERE id = $3 AND user_id = $4
+		RETURNING ` + brandingProfileColumns
+	var logo []byte
+	if len(profile.Logo) > 0 {
+		logo = profile.Logo
+	}
+	return r.db.QueryRowContext(ctx, query, logo, profile.LogoContentType, profile.ID, profile.UserID).Scan(
+		&profile.ID, &profile.UserID, &profile.Name, &profile.AccentColor, &profile.Footer, &profile.PaymentInstructions,
+		&profile.DefaultNote, &profile.IsDefault, &profile.LogoContentType, &profile.CreatedAt, &profile.UpdatedAt)
+}
+
+// DeleteBrandingProfile deletes a branding profile owned by userID. Invoices that used it fall back to the
+// user's default profile. It returns sql.ErrNoRows if there is no such profile.
+func (r *InvoiceRepository) DeleteBrandingProfile(ctx context.Context, profileID, userID int64) error {
+	query := `DELETE FROM branding_profiles WHERE id = $1 AND user_id = $2`
+	result, err := r.db.ExecContext(ctx, query, profileID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// clearDefaultBrandingProfile unsets the user's current default profile when profile is to become the default,
+// since a user has at most one.
+func clearDefaultBrandingProfile(ctx context.Context, tx *sql.Tx, profile *models.BrandingProfile) error {
+	if !profile.IsDefault {
+		return nil
+	}
+	query := `
+		UPDATE branding_profiles
+		SET is_default = FALSE, updated_at = NOW()
+		WHERE user_id = $1 AND is_default AND id <> $2`
+	_, err := tx.ExecContext(ctx, query, profile.UserID, profile.ID)
+	return err
+}
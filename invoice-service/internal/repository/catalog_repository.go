@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+const catalogItemColumns = `id, user_id, sku, description, unit_price, unit, COALESCE(tax_rate_id, 0), created_at, updated_at`
+
+func (r *InvoiceRepository) CreateCatalogItem(ctx context.Context, item *models.CatalogItem) error {
+	query := `
+		INSERT INTO catalog_items (user_id, sku, description, unit_price, unit, tax_rate_id)
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6, 0))
+		RETURNING id, created_at, updated_at`
+	return r.db.QueryRowContext(ctx, query, item.UserID, item.SKU, item.Description, item.UnitPrice, item.Unit,
+		item.TaxRateID).Scan(&item.ID, &item.CreatedAt, &item.UpdatedAt)
+}
+
+// GetCatalogItem returns one of the user's catalog items. It returns sql.ErrNoRows if there is no such item.
+func (r *InvoiceRepository) GetCatalogItem(ctx context.Context, userID, itemID int64) (*models.CatalogItem, error) {
+	query := `
+		SELECT ` + catalogItemColumns + `
+		FROM catalog_items
+		WHERE user_id = $1 AND id = $2`
+	return scanCatalogItem(r.db.QueryRowContext(ctx, query, userID, itemID))
+}
+
+// GetCatalogItemBySKU returns the user's catalog item with a SKU. It returns sql.ErrNoRows if there is no such
+// item.
+func (r *InvoiceRepository) GetCatalogItemBySKU(ctx context.Context, userID int64, sku string) (*models.CatalogItem, error) {
+	query := `
+		SELECT ` + catalogItemColumns + `
+		FROM catalog_items
+		WHERE user_id = $1 AND sku = $2`
+	return scanCatalogItem(r.db.QueryRowContext(ctx, query, userID, sku))
+}
+
+// GetCatalogItemsByIDs returns the catalog items with the given IDs that belong to the user.
+func (r *InvoiceRepository) GetCatalogItemsByIDs(ctx context.Context, userID int64, itemIDs []int64) ([]*models.CatalogItem, error) {
+	query := `
+		SELECT ` + catalogItemColumns + `
+		FROM catalog_items
+		WHERE user_id = $1 AND id = ANY($2)`
+	rows, err := r.db.QueryContext(ctx, query, userID, itemIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanCatalogItems(rows)
+}
+
+// ListCatalogItemsByUserID returns the user's catalog items by SKU.
+func (r *InvoiceRepository) ListCatalogItemsByUserID(ctx context.Context, userID int64) ([]*models.CatalogItem, error) {
+	query := `
+		SELECT ` + catalogItemColumns + `
+		FROM catalog_items
+		WHERE user_id = $1
+		ORDER BY sku`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanCatalogItems(rows)
+}
+
+// UpdateCatalogItem updates a catalog item owned by item.UserID. It returns sql.ErrNoRows if there is no such
+// item.
+func (r *InvoiceRepository) UpdateCatalogItem(ctx context.Context, item *models.CatalogItem) error {
+	query := `
+		UPDATE catalog_items
+		SET sku = $1, description = $2, unit_price = $3, unit = $4, tax_rate_id = NULLIF($5, 0), updated_at = NOW()
+		WHERE id = $6 AND user_id = $7
+		RETURNING created_at, updated_at`
+	return r.db.QueryRowContext(ctx, query, item.SKU, item.Description, item.UnitPrice, item.Unit, item.TaxRateID, item.ID,
+		item.UserID).Scan(&item.CreatedAt, &item.UpdatedAt)
+}
+
+// DeleteCatalogItem deletes a catalog item owned by userID. Invoice items filled in from it keep their values.
+// It returns sql.ErrNoRows if there is no such item.
+func (r *InvoiceRepository) DeleteCatalogItem(ctx context.Context, itemID, userID int64) error {
+	query := `DELETE FROM catalog_items WHERE id = $1 AND user_id = $2`
+	result, err := r.db.ExecContext(ctx, query, itemID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func scanCatalogItems(rows *sql.Rows) ([]*models.CatalogItem, error) {
+	var items []*models.CatalogItem
+	for rows.Next() {
+		item, err := scanCatalogItem(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, rows.Err()
+}
+
+// scanCatalogItem scans a catalog item from a row of catalogItemColumns.
+func scanCatalogItem(row rowScanner) (*models.CatalogItem, error) {
+	var item models.CatalogItem
+	err := row.Scan(&item.ID, &item.UserID, &item.SKU, &item.Description, &item.UnitPrice, &item.Unit, &item.TaxRateID,
+		&item.CreatedAt, &item.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
@@ -0,0 +1,445 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+// GetCreditedQuantities returns the quantity of each line of an invoice that credit notes have already credited.
+func (r *InvoiceRepository) GetCreditedQuantities(ctx context.Context, invoiceID int64) (map[int64]int32, error) {
+	query := `
+		SELECT i.invoice_item_id, SUM(i.quantity)
+		FROM credit_note_items i
+		JOIN credit_notes c ON c.id = i.credit_note_id
+		WHERE c.invoice_id = $1
+		GROUP BY i.invoice_item_id`
+	rows, err := r.db.QueryContext(ctx, query, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	quantities := map[int64]int32{}
+	for rows.Next() {
+		var itemID int64
+		var quantity int32
+		err := rows.Scan(&itemID, &quantity)
+		if err != nil {
+			return nil, err
+		}
+		quantities[itemID] = quantity
+	}
+
+	return quantities, rows.Err()
+}
+
+// CreateCreditNote numbers and issues a credit note against an invoice and applies its allocations to the
+// invoice's balance and status in a single transaction. It returns sql.ErrNoRows if the invoice changed since it
+// was read.
+func (r *InvoiceRepository) CreateCreditNote(ctx context.Context, creditNote *models.CreditNote, invoice *models.Invoice, status string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = allocateCreditNoteNumber(ctx, tx, creditNote)
+	if err != nil {
+		return err
+	}
+
+	// Insert credit note details
+	query := `
+		INSERT INTO credit_notes (user_id, invoice_id, customer_id, credit_note_number, issue_date, currency, reason, 
+			discount_percentage, subtotal, discount_amount, tax_total, total, amount_applied)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+		RETURNING id, created_at, updated_at`
+	err = tx.QueryRowContext(ctx, query,
+		creditNote.UserID, creditNote.InvoiceID, creditNote.CustomerID, creditNote.CreditNoteNumber, creditNote.IssueDate,
+		creditNote.Currency, creditNote.Reason, creditNote.DiscountPercentage, creditNote.Subtotal, creditNote.DiscountAmount,
+		creditNote.TaxTotal, creditNote.Total, creditNote.AmountApplied).Scan(
+		&creditNote.ID, &creditNote.CreatedAt, &creditNote.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	// Insert credit note items and their tax snapshots
+	for _, item := range creditNote.Items {
+		itemQuery := `
+			INSERT INTO credit_note_items (credit_note_id, invoice_item_id, description, quantity, unit_price, discount_amount)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING id`
+		err := tx.QueryRowContext(ctx, itemQuery, creditNote.ID, item.InvoiceItemID, item.Description, item.Quantity,
+			item.UnitPrice, item.DiscountAmount).Scan(&item.ID)
+		if err != nil {
+			return err
+		}
+
+		for position, tax := range item.Taxes {
+			taxQuery := `
+				INSERT INTO credit_note_item_taxes (credit_note_item_id, tax_rate_id, position, name, rate, inclusive, compound)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)`
+			_, err := tx.ExecContext(ctx, taxQuery, item.ID, tax.ID, position, tax.Name, tax.Rate, tax.Inclusive, tax.Compound)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Insert the per-rate tax breakdown
+	for _, tax := range creditNote.Taxes {
+		taxQuery := `
+			INSERT INTO credit_note_taxes (credit_note_id, tax_rate_id, name, rate, inclusive, compound, taxable_amount, amount)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+		_, err := tx.ExecContext(ctx, taxQuery, creditNote.ID, tax.TaxRateID, tax.Name, tax.Rate, tax.Inclusive, tax.Compound,
+			tax.TaxableAmount, tax.Amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, allocation := range creditNote.Allocations {
+		allocation.CreditNoteID = creditNote.ID
+		err = insertCreditNoteAllocation(ctx, tx, allocation)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Apply the credit to the invoice
+	err = applyCredit(ctx, tx, invoice, creditNote.AmountApplied, creditNote.Total, status)
+	if err != nil {
+		return err
+	}
+
+	err = insertActivities(ctx, tx, invoice.ID, invoice.UserID, &invoice.PendingActivities)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// allocateCreditNoteNumber numbers a credit note from its user's credit note sequence. The counter row stays
+// locked until the transaction ends, so concurrent credit notes are numbered one after the other and a rolled back
+// transaction gives its number back.
+func allocateCreditNoteNumber(ctx context.Context, tx *sql.Tx, creditNote *models.CreditNote) error {
+	query := `
+		INSERT INTO credit_note_number_counters (user_id, current_value)
+		VALUES ($1, 1)
+		ON CONFLICT (user_id) DO UPDATE SET current_value = credit_note_number_counters.current_value + 1
+		RETURNING current_value`
+	var seq int64
+	err := tx.QueryRowContext(ctx, query, creditNote.UserID).Scan(&seq)
+	if err != nil {
+		return err
+	}
+	creditNote.CreditNoteNumber = models.FormatCreditNoteNumber(seq)
+	return nil
+}
+
+func (r *InvoiceRepository) GetCreditNoteByID(ctx context.Context, creditNoteID, userID int64) (*models.CreditNote, error) {
+	query := `
+		SELECT id, user_id, invoice_id, customer_id, credit_note_number, issue_date, currency, reason, discount_percentage, 
+			subtotal, discount_amount, tax_total, total, amount_applied, amount_refunded, created_at, updated_at
+		FROM credit_notes
+		WHERE id = $1 AND user_id = $2`
+	creditNote, err := scanCreditNote(r.db.QueryRowContext(ctx, query, creditNoteID, userID))
+	if err != nil {
+		return nil, err
+	}
+
+	// Fetch associated items, taxes and allocations
+	creditNote.Items, err = r.fetchCreditNoteItems(ctx, creditNote.ID)
+	if err != nil {
+		return nil, err
+	}
+	creditNote.Taxes, err = r.fetchCreditNoteTaxes(ctx, creditNote.ID)
+	if err != nil {
+		return nil, err
+	}
+	creditNote.Allocations, err = r.fetchCreditNoteAllocations(ctx, creditNote.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return creditNote, nil
+}
+
+// ListCreditNotesByUserID returns a user's credit notes without their lines, newest first. A non-zero invoiceID
+// limits the list to the credit notes issued against that invoice.
+func (r *InvoiceRepository) ListCreditNotesByUserID(ctx context.Context, userID, invoiceID int64) ([]*models.CreditNote, error) {
+	var creditNotes []*models.CreditNote
+	query := `
+		SELECT id, user_id, invoice_id, customer_id, credit_note_number, issue_date, currency, reason, discount_percentage, 
+			subtotal, discount_amount, tax_total, total, amount_applied, amount_refunded, created_at, updated_at
+		FROM credit_notes
+		WHERE user_id = $1 AND ($2 = 0 OR invoice_id = $2)
+		ORDER BY issue_date DESC, id DESC`
+	rows, err := r.db.QueryContext(ctx, query, userID, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		creditNote, err := scanCreditNote(rows)
+		if err != nil {
+			return nil, err
+		}
+		creditNotes = append(creditNotes, creditNote)
+	}
+
+	return creditNotes, rows.Err()
+}
+
+// ApplyCreditNote applies credit from a credit note to an invoice's balance and status in a single transaction.
+// It returns sql.ErrNoRows if the credit note or the invoice changed since they were read.
+func (r *InvoiceRepository) ApplyCreditNote(ctx context.Context, allocation *models.CreditNoteAllocation, creditNote *models.CreditNote, invoice *models.Invoice, status string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = insertCreditNoteAllocation(ctx, tx, allocation)
+	if err != nil {
+		return err
+	}
+
+	err = allocateCredit(ctx, tx, creditNote, allocation.Amount, 0)
+	if err != nil {
+		return err
+	}
+
+	err = applyCredit(ctx, tx, invoice, allocation.Amount, 0, status)
+	if err != nil {
+		return err
+	}
+
+	err = insertActivities(ctx, tx, invoice.ID, invoice.UserID, &invoice.PendingActivities)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// RefundCreditNote records a refund of credit note credit to the customer. It returns sql.ErrNoRows if the
+// credit note changed since it was read.
+func (r *InvoiceRepository) RefundCreditNote(ctx context.Context, allocation *models.CreditNoteAllocation, creditNote *models.CreditNote) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = insertCreditNoteAllocation(ctx, tx, allocation)
+	if err != nil {
+		return err
+	}
+
+	err = allocateCredit(ctx, tx, creditNote, 0, allocation.Amount)
+	if err != nil {
+		return err
+	}
+
+	err = insertActivities(ctx, tx, creditNote.InvoiceID, creditNote.UserID, &creditNote.PendingActivities)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *InvoiceRepository) fetchCreditNoteItems(ctx context.Context, creditNoteID int64) ([]*models.CreditNoteItem, error) {
+	var items []*models.CreditNoteItem
+	query := `
+		SELECT id, invoice_item_id, description, quantity, unit_price, discount_amount
+		FROM credit_note_items
+		WHERE credit_note_id = $1
+		ORDER BY id`
+	rows, err := r.db.QueryContext(ctx, query, creditNoteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	itemsByID := map[int64]*models.CreditNoteItem{}
+	for rows.Next() {
+		var item models.CreditNoteItem
+		err := rows.Scan(&item.ID, &item.InvoiceItemID, &item.Description, &item.Quantity, &item.UnitPrice, &item.DiscountAmount)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, &item)
+		itemsByID[item.ID] = &item
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Fetch the tax snapshots of every item in one query
+	taxQuery := `
+		SELECT t.credit_note_item_id, t.tax_rate_id, t.name, t.rate, t.inclusive, t.compound
+		FROM credit_note_item_taxes t
+		JOIN credit_note_items i ON i.id = t.credit_note_item_id
+		WHERE i.credit_note_id = $1
+		ORDER BY t.credit_note_item_id, t.position`
+	taxRows, err := r.db.QueryContext(ctx, taxQuery, creditNoteID)
+	if err != nil {
+		return nil, err
+	}
+	defer taxRows.Close()
+
+	for taxRows.Next() {
+		var itemID int64
+		var tax models.TaxRate
+		err := taxRows.Scan(&itemID, &tax.ID, &tax.Name, &tax.Rate, &tax.Inclusive, &tax.Compound)
+		if err != nil {
+			return nil, err
+		}
+		if item, ok := itemsByID[itemID]; ok {
+			item.Taxes = append(item.Taxes, &tax)
+		}
+	}
+
+	return items, taxRows.Err()
+}
+
+func (r *InvoiceRepository) fetchCreditNoteTaxes(ctx context.Context, creditNoteID int64) ([]*models.InvoiceTax, error) {
+	var taxes []*models.InvoiceTax
+	query := `
+		SELECT tax_rate_id, name, rate, inclusive, compound, taxable_amount, amount
+		FROM credit_note_taxes
+		WHERE credit_note_id = $1
+		ORDER BY id`
+	rows, err := r.db.QueryContext(ctx, query, creditNoteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var tax models.InvoiceTax
+		err := rows.Scan(&tax.TaxRateID, &tax.Name, &tax.Rate, &tax.Inclusive, &tax.Compound, &tax.TaxableAmount, &tax.Amount)
+		if err != nil {
+			return nil, err
+		}
+		taxes = append(taxes, &tax)
+	}
+
+	return taxes, rows.Err()
+}
+
+func (r *InvoiceRepository) fetchCreditNoteAllocations(ctx context.Context, creditNoteID int64) ([]*models.CreditNoteAllocation, error) {
+	var allocations []*models.CreditNoteAllocation
+	query := `
+		SELECT id, credit_note_id, kind, COALESCE(invoice_id, 0), amount, method, reference, created_at
+		FROM credit_note_allocations
+		WHERE credit_note_id = $1
+		ORDER BY id`
+	rows, err := r.db.QueryContext(ctx, query, creditNoteID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var allocation models.CreditNoteAllocation
+		err := rows.Scan(
+			&allocation.ID, &allocation.CreditNoteID, &allocation.Kind, &allocation.InvoiceID, &allocation.Amount,
+			&allocation.Method, &allocation.Reference, &allocation.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		allocations = append(allocations, &allocation)
+	}
+
+	return allocations, rows.Err()
+}
+
+func insertCreditNoteAllocation(ctx context.Context, tx *sql.Tx, allocation *models.CreditNoteAllocation) error {
+	query := `
+		INSERT INTO credit_note_allocations (credit_note_id, kind, invoice_id, amount, method, reference)
+		VALUES ($1, $2, NULLIF($3, 0), $4, $5, $6)
+		RETURNING id, created_at`
+	return tx.QueryRowContext(ctx, query,
+		allocation.CreditNoteID, allocation.Kind, allocation.InvoiceID, allocation.Amount, allocation.Method,
+		allocation.Reference).Scan(&allocation.ID, &allocation.CreatedAt)
+}
+
+// allocateCredit adds to the amounts applied and refunded from a credit note, provided neither has changed
+// since the credit note was read.
+func allocateCredit(ctx context.Context, tx *sql.Tx, creditNote *models.CreditNote, applied, refunded int64) error {
+	query := `
+		UPDATE credit_notes
+		SET amount_applied = amount_applied + $1, amount_refunded = amount_refunded + $2, updated_at = NOW()
+		WHERE id = $3 AND amount_applied = $4 AND amount_refunded = $5`
+	result, err := tx.ExecContext(ctx, query, applied, refunded, creditNote.ID, creditNote.AmountApplied, creditNote.AmountRefunded)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// applyCredit adds amount to the invoice's amount credited and creditNoteTotal to the total of its credit notes,
+// and sets its status, provided its status, balance and credit notes haven't changed since the invoice was read.
+func applyCredit(ctx context.Context, tx *sql.Tx, invoice *models.Invoice, amount, creditNoteTotal int64, status string) error {
+	query := `
+		UPDATE invoices
+		SET amount_credited = amount_credited + $1, credit_note_total = credit_note_total + $2, status = $3, version = version + 1,
+		updated_at = NOW()
+		WHERE id = $4 AND status = $5 AND amount_paid = $6 AND amount_credited = $7 AND credit_note_total = $8`
+	result, err := tx.ExecContext(ctx, query, amount, creditNoteTotal, status, invoice.ID, invoice.Status, invoice.AmountPaid,
+		invoice.AmountCredited, invoice.CreditNoteTotal)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func scanCreditNote(row rowScanner) (*models.CreditNote, error) {
+	var creditNote models.CreditNote
+	err := row.Scan(
+		&creditNote.ID, &creditNote.UserID, &creditNote.InvoiceID, &creditNote.CustomerID, &creditNote.CreditNoteNumber,
+		&creditNote.IssueDate, &creditNote.Currency, &creditNote.Reason, &creditNote.DiscountPercentage, &creditNote.Subtotal,
+		&creditNote.DiscountAmount, &creditNote.TaxTotal, &creditNote.Total, &creditNote.AmountApplied,
+		&creditNote.AmountRefunded, &creditNote.CreatedAt, &creditNote.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &creditNote, nil
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+// GetBaseCurrency returns the user's base currency. It returns sql.ErrNoRows if the user has not chosen one.
+func (r *InvoiceRepository) GetBaseCurrency(ctx context.Context, userID int64) (string, error) {
+	query := `SELECT base_currency FROM currency_settings WHERE user_id = $1`
+
+	var baseCurrency string
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&baseCurrency)
+	if err != nil {
+		return "", err
+	}
+	return baseCurrency, nil
+}
+
+func (r *InvoiceRepository) SetBaseCurrency(ctx context.Context, userID int64, baseCurrency string) error {
+	query := `
+		INSERT INTO currency_settings (user_id, base_currency)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO UPDATE SET base_currency = EXCLUDED.base_currency, updated_at = NOW()`
+	_, err := r.db.ExecContext(ctx, query, userID, baseCurrency)
+	return err
+}
+
+// CreateExchangeRates stores exchange rates in a single transaction. A rate for a currency and date that
+// already exists is replaced.
+func (r *InvoiceRepository) CreateExchangeRates(ctx context.Context, rates []*models.ExchangeRate) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, rate := range rates {
+		query := `
+			INSERT INTO exchange_rates (user_id, base_currency, currency, rate, effective_date)
+			VALUES ($1, $2, $3, $4, $5)
+			ON CONFLICT (user_id, base_currency, currency, effective_date) DO UPDATE SET rate = EXCLUDED.rate
+			RETURNING id, created_at`
+		err := tx.QueryRowContext(ctx, query, rate.UserID, rate.BaseCurrency, rate.Currency, rate.Rate, rate.EffectiveDate).Scan(
+			&rate.ID, &rate.CreatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *InvoiceRepository) ListExchangeRatesByUserID(ctx context.Context, userID int64) ([]*models.ExchangeRate, error) {
+	var rates []*models.ExchangeRate
+	query := `
+		SELECT id, user_id, base_currency, currency, rate, effective_date, created_at
+		FROM exchange_rates
+		WHERE user_id = $1
+		ORDER BY base_currency, currency, effective_date DESC`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var rate models.ExchangeRate
+		err := rows.Scan(&rate.ID, &rate.UserID, &rate.BaseCurrency, &rate.Currency, &rate.Rate, &rate.EffectiveDate, &rate.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, &rate)
+	}
+
+	return rates, rows.Err()
+}
+
+// GetExchangeRate returns the most recent rate from currency to baseCurrency that took effect on or before date.
+// It returns sql.ErrNoRows if there is none.
+func (r *InvoiceRepository) GetExchangeRate(ctx context.Context, userID int64, baseCurrency, currency string, date time.Time) (*models.ExchangeRate, error) {
+	query := `
+		SELECT id, user_id, base_currency, currency, rate, effective_date, created_at
+		FROM exchange_rates
+		WHERE user_id = $1 AND base_currency = $2 AND currency = $3 AND effective_date <= $4
+		ORDER BY effective_date DESC
+		LIMIT 1`
+
+	var rate models.ExchangeRate
+	err := r.db.QueryRowContext(ctx, query, userID, baseCurrency, currency, date).Scan(
+		&rate.ID, &rate.UserID, &rate.BaseCurrency, &rate.Currency, &rate.Rate, &rate.EffectiveDate, &rate.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &rate, nil
+}
+
+// DeleteExchangeRate deletes an exchange rate owned by userID. It returns sql.ErrNoRows if there is no such rate.
+func (r *InvoiceRepository) DeleteExchangeRate(ctx context.Context, exchangeRateID, userID int64) error {
+	query := `DELETE FROM exchange_rates WHERE id = $1 AND user_id = $2`
+	result, err := r.db.ExecContext(ctx, query, exchangeRateID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+// ReserveIdempotencyKey saves a new, in progress idempotency key, taking the place of an expired one with the
+// same key. It reports false if the key is already in use.
+func (r *InvoiceRepository) ReserveIdempotencyKey(ctx context.Context, key *models.IdempotencyKey, now time.Time) (bool, error) {
+	query := `
+		INSERT INTO idempotency_keys (user_id, key, fingerprint, created_at, expires_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id, key) DO UPDATE SET fingerprint = EXCLUDED.fingerprint, response = NULL,
+			created_at = EXCLUDED.created_at, expires_at = EXCLUDED.expires_at
+		WHERE idempotency_keys.expires_at <= $4`
+	result, err := r.db.ExecContext(ctx, query, key.UserID, key.Key, key.Fingerprint, now, key.ExpiresAt)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	key.CreatedAt = now
+	return rowsAffected == 1, nil
+}
+
+// GetIdempotencyKey returns a user's idempotency key, or sql.ErrNoRows if there is none.
+func (r *InvoiceRepository) GetIdempotencyKey(ctx context.Context, userID int64, key string) (*models.IdempotencyKey, error) {
+	var idempotencyKey models.IdempotencyKey
+	query := `
+		SELECT user_id, key, fingerprint, response, created_at, expires_at
+		FROM idempotency_keys
+		WHERE user_id = $1 AND key = $2`
+	err := r.db.QueryRowContext(ctx, query, userID, key).Scan(&idempotencyKey.UserID, &idempotencyKey.Key,
+		&idempotencyKey.Fingerprint, &idempotencyKey.Response, &idempotencyKey.CreatedAt, &idempotencyKey.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return &idempotencyKey, nil
+}
+
+// SaveIdempotencyResponse stores the response to the request an in progress idempotency key was reserved for.
+// It returns sql.ErrNoRows if the key is no longer reserved for that request.
+func (r *InvoiceRepository) SaveIdempotencyResponse(ctx context.Context, key *models.IdempotencyKey) error {
+	query := `
+		UPDATE idempotency_keys
+		SET response = $1
+		WHERE user_id = $2 AND key = $3 AND fingerprint = $4 AND response IS NULL`
+	result, err := r.db.ExecContext(ctx, query, key.Response, key.UserID, key.Key, key.Fingerprint)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// DeleteIdempotencyKey deletes an idempotency key that is still in progress for the same request, so the
+// request can be made again.
+func (r *InvoiceRepository) DeleteIdempotencyKey(ctx context.Context, key *models.IdempotencyKey) error {
+	query := `
+		DELETE FROM idempotency_keys
+		WHERE user_id = $1 AND key = $2 AND fingerprint = $3 AND response IS NULL`
+	_, err := r.db.ExecContext(ctx, query, key.UserID, key.Key, key.Fingerprint)
+	return err
+}
+
+// DeleteExpiredIdempotencyKeys deletes the idempotency keys that expired by now and returns how many were
+// deleted.
+func (r *InvoiceRepository) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error) {
+	query := `DELETE FROM idempotency_keys WHERE expires_at <= $1`
+	result, err := r.db.ExecContext(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -3,8 +3,9 @@ package repository
 import (
 	"context"
 	"database/sql"
-	"encoding/base64"
-	"strconv"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/emzola/numer/invoice-service/internal/models"
 )
@@ -17,69 +18,97 @@ func NewInvoiceRepository(db *sql.DB) *InvoiceRepository {
 	return &InvoiceRepository{db: db}
 }
 
-func (r *InvoiceRepository) IncrementInvoiceNumber(ctx context.Context) (int64, error) {
-	query := `
-		UPDATE invoice_number_sequence 
-		SET current_value = current_value + 1 
-		RETURNING current_value
-	`
-	var invoiceNumber int64
-	err := r.db.QueryRowContext(ctx, query).Scan(&invoiceNumber)
+func (r *InvoiceRepository) CreateInvoice(ctx context.Context, invoice *models.Invoice) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = insertInvoice(ctx, tx, invoice)
 	if err != nil {
-		return 0, err
+		return err
 	}
 
-	return invoiceNumber, nil
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
 }
 
-func (r *InvoiceRepository) CreateInvoice(ctx context.Context, invoice *models.Invoice) error {
+// CreateInvoices numbers and inserts a batch of invoices in one transaction, so either all of them are saved or
+// none are.
+func (r *InvoiceRepository) CreateInvoices(ctx context.Context, invoices []*models.Invoice) error {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
+	for _, invoice := range invoices {
+		err = insertInvoice(ctx, tx, invoice)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// insertInvoice numbers an invoice and inserts it with its items, taxes and recorded activities.
+func insertInvoice(ctx context.Context, tx *sql.Tx, invoice *models.Invoice) error {
+	err := allocateInvoiceNumber(ctx, tx, invoice)
+	if err != nil {
+		return err
+	}
+
 	// Insert invoice details
 	query := `
 		INSERT INTO invoices (user_id, customer_id, invoice_number, status,	issue_date, due_date, currency, subtotal, 
-			discount_percentage, discount_amount, total, account_name, account_number, bank_name, routing_number, note)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)
+			discount_percentage, discount_amount, tax_total, total, account_name, account_number, bank_name, routing_number, note,
+			recurring_invoice_id, quote_id, fixed_discount, charge_total, branding_profile_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, NULLIF($18, 0), NULLIF($19, 0), $20, $21,
+			NULLIF($22, 0))
 		RETURNING id, created_at, updated_at`
 
 	err = tx.QueryRowContext(ctx, query,
 		invoice.UserID, invoice.CustomerID, invoice.InvoiceNumber, invoice.Status, invoice.IssueDate, invoice.DueDate,
-		invoice.Currency, invoice.Subtotal, invoice.DiscountPercentage, invoice.DiscountAmount, invoice.Total,
-		invoice.AccountName, invoice.AccountNumber, invoice.BankName, invoice.RoutingNumber, invoice.Note).Scan(
+		invoice.Currency, invoice.Subtotal, invoice.DiscountPercentage, invoice.DiscountAmount, invoice.TaxTotal, invoice.Total,
+		invoice.AccountName, invoice.AccountNumber, invoice.BankName, invoice.RoutingNumber, invoice.Note,
+		invoice.RecurringInvoiceID, invoice.QuoteID, invoice.FixedDiscount, invoice.ChargeTotal, invoice.BrandingProfileID).Scan(
 		&invoice.ID, &invoice.CreatedAt, &invoice.UpdatedAt)
 	if err != nil {
 		return err
 	}
 
-	// Insert invoice items
-	for _, item := range invoice.Items {
-		itemQuery := `
-			INSERT INTO invoice_items (invoice_id, description, quantity, unit_price)
-			VALUES ($1, $2, $3, $4)
-			RETURNING id`
-		err := tx.QueryRowContext(ctx, itemQuery, invoice.ID, item.Description, item.Quantity, item.UnitPrice).Scan(&item.ID)
-		if err != nil {
-			return err
-		}
+	// Insert invoice items, charges and taxes
+	err = insertInvoiceItems(ctx, tx, invoice)
+	if err != nil {
+		return err
 	}
-
-	// Commit transaction
-	if err := tx.Commit(); err != nil {
+	err = insertInvoiceCharges(ctx, tx, invoice)
+	if err != nil {
 		return err
 	}
-	return nil
+	err = insertInvoiceTaxes(ctx, tx, invoice)
+	if err != nil {
+		return err
+	}
+	return insertActivities(ctx, tx, invoice.ID, invoice.UserID, &invoice.PendingActivities)
 }
 
 func (r *InvoiceRepository) GetInvoiceByID(ctx context.Context, invoiceID int64) (*models.Invoice, error) {
 	// Fetch invoice
 	query := `
 		SELECT id, user_id, customer_id, invoice_number, status, issue_date, due_date, currency, subtotal, 
-			discount_percentage, discount_amount, total, account_name, account_number, bank_name, routing_number, note, 
-			created_at, updated_at
+			discount_percentage, fixed_discount, discount_amount, charge_total, tax_total, total, amount_paid, amount_credited, 
+			credit_note_total, base_currency, exchange_rate, account_name, account_number, bank_name, routing_number, note, 
+			COALESCE(recurring_invoice_id, 0), COALESCE(quote_id, 0), COALESCE(branding_profile_id, 0), version, archived_at, created_at, updated_at, late_fee_total
 		FROM invoices
 		WHERE id = $1`
 
@@ -87,39 +116,28 @@ func (r *InvoiceRepository) GetInvoiceByID(ctx context.Context, invoiceID int64)
 
 	err := r.db.QueryRowContext(ctx, query, invoiceID).Scan(
 		&invoice.ID, &invoice.UserID, &invoice.CustomerID, &invoice.InvoiceNumber, &invoice.Status, &invoice.IssueDate,
-		&invoice.DueDate, &invoice.Currency, &invoice.Subtotal, &invoice.DiscountPercentage, &invoice.DiscountAmount, &invoice.Total,
-		&invoice.AccountName, &invoice.AccountNumber, &invoice.BankName, &invoice.RoutingNumber, &invoice.Note,
-		&invoice.CreatedAt, &invoice.UpdatedAt,
+		&invoice.DueDate, &invoice.Currency, &invoice.Subtotal, &invoice.DiscountPercentage, &invoice.FixedDiscount, &invoice.DiscountAmount,
+		&invoice.ChargeTotal, &invoice.TaxTotal, &invoice.Total, &invoice.AmountPaid, &invoice.AmountCredited, &invoice.CreditNoteTotal,
+		&invoice.BaseCurrency, &invoice.ExchangeRate, &invoice.AccountName, &invoice.AccountNumber, &invoice.BankName,
+		&invoice.RoutingNumber, &invoice.Note, &invoice.RecurringInvoiceID, &invoice.QuoteID, &invoice.BrandingProfileID, &invoice.Version, &invoice.ArchivedAt, &invoice.CreatedAt, &invoice.UpdatedAt,
+		&invoice.LateFeeTotal,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	// Fetch associated invoice items
-	itemQuery := `
-		SELECT id, description, quantity, unit_price
-		FROM invoice_items 
-		WHERE invoice_id = $1`
-	rows, err := r.db.QueryContext(ctx, itemQuery, invoice.ID)
+	// Fetch associated invoice items and taxes
+	err = r.fetchInvoiceDetails(ctx, []*models.Invoice{&invoice})
 	if err != nil {
 		return nil, err
 	}
-	defer rows.Close()
-
-	var items []*models.InvoiceItem
-	for rows.Next() {
-		var item models.InvoiceItem
-		if err := rows.Scan(&item.ID, &item.Description, &item.Quantity, &item.UnitPrice); err != nil {
-			return nil, err
-		}
-		items = append(items, &item)
-	}
-	invoice.Items = items
 
 	return &invoice, nil
 }
 
-func (r *InvoiceRepository) UpdateInvoice(ctx context.Context, invoice *models.Invoice) error {
+// UpdateInvoice saves an invoice read at invoice.Version and bumps its version. The items and taxes are
+// only rewritten if replaceItems is set. It returns sql.ErrNoRows if the invoice has changed since it was read.
+func (r *InvoiceRepository) UpdateInvoice(ctx context.Context, invoice *models.Invoice, replaceItems bool) error {
 	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
@@ -127,36 +145,256 @@ func (r *InvoiceRepository) UpdateInvoice(ctx context.Context, invoice *models.I
 	defer tx.Rollback()
 
 	// Update invoice details
+	// Status is left alone here; it only changes through UpdateInvoiceStatus
 	updateInvoiceQuery := `
 		UPDATE invoices 
-		SET status = $1, issue_date = $2, due_date = $3, currency = $4, discount_percentage = $5, account_name = $6, 
-		account_number = $7, bank_name = $8, routing_number = $9, note = $10, updated_at = NOW()
-		WHERE id = $11`
-	_, err = tx.ExecContext(ctx, updateInvoiceQuery,
-		invoice.Status, invoice.IssueDate, invoice.DueDate, invoice.Currency, invoice.DiscountPercentage,
-		invoice.AccountName, invoice.AccountNumber, invoice.BankName, invoice.RoutingNumber, invoice.Note, invoice.ID)
+		SET issue_date = $1, due_date = $2, currency = $3, subtotal = $4, discount_percentage = $5, discount_amount = $6,
+		tax_total = $7, total = $8, account_name = $9, account_number = $10, bank_name = $11, routing_number = $12, note = $13, 
+		fixed_discount = $14, charge_total = $15, branding_profile_id = NULLIF($16, 0), version = version + 1, updated_at = NOW()
+		WHERE id = $17 AND version = $18
+		RETURNING version, updated_at`
+	err = tx.QueryRowContext(ctx, updateInvoiceQuery,
+		invoice.IssueDate, invoice.DueDate, invoice.Currency, invoice.Subtotal, invoice.DiscountPercentage, invoice.DiscountAmount,
+		invoice.TaxTotal, invoice.Total, invoice.AccountName, invoice.AccountNumber, invoice.BankName, invoice.RoutingNumber,
+		invoice.Note, invoice.FixedDiscount, invoice.ChargeTotal, invoice.BrandingProfileID, invoice.ID, invoice.Version).Scan(
+		&invoice.Version, &invoice.UpdatedAt)
 	if err != nil {
 		return err
 	}
 
-	// Delete old invoice items
-	deleteItemsQuery := `DELETE FROM invoice_items WHERE invoice_id = $1`
-	_, err = tx.ExecContext(ctx, deleteItemsQuery, invoice.ID)
+	if replaceItems {
+		// Delete old invoice items, charges and taxes
+		deleteItemsQuery := `DELETE FROM invoice_items WHERE invoice_id = $1`
+		_, err = tx.ExecContext(ctx, deleteItemsQuery, invoice.ID)
+		if err != nil {
+			return err
+		}
+		deleteChargesQuery := `DELETE FROM invoice_charges WHERE invoice_id = $1`
+		_, err = tx.ExecContext(ctx, deleteChargesQuery, invoice.ID)
+		if err != nil {
+			return err
+		}
+		deleteTaxesQuery := `DELETE FROM invoice_taxes WHERE invoice_id = $1`
+		_, err = tx.ExecContext(ctx, deleteTaxesQuery, invoice.ID)
+		if err != nil {
+			return err
+		}
+
+		// Insert updated invoice items, charges and taxes
+		err = insertInvoiceItems(ctx, tx, invoice)
+		if err != nil {
+			return err
+		}
+		err = insertInvoiceCharges(ctx, tx, invoice)
+		if err != nil {
+			return err
+		}
+		err = insertInvoiceTaxes(ctx, tx, invoice)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// UpdateInvoiceStatus moves an invoice from one status to another. It returns sql.ErrNoRows if
+// the invoice is no longer in the expected status.
+func (r *InvoiceRepository) UpdateInvoiceStatus(ctx context.Context, invoice *models.Invoice, from, to string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
+	defer tx.Rollback()
 
-	// Insert updated invoice items
-	for _, item := range invoice.Items {
-		insertItemQuery := `
-			INSERT INTO invoice_items (invoice_id, description, quantity, unit_price)
-			VALUES ($1, $2, $3, $4)`
-		_, err := tx.ExecContext(ctx, insertItemQuery, invoice.ID, item.Description, item.Quantity, item.UnitPrice)
+	query := `
+		UPDATE invoices
+		SET status = $1, version = version + 1, updated_at = NOW()
+		WHERE id = $2 AND status = $3`
+	result, err := tx.ExecContext(ctx, query, to, invoice.ID, from)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	err = insertActivities(ctx, tx, invoice.ID, invoice.UserID, &invoice.PendingActivities)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IssueInvoice moves an invoice from status from to invoice.Status and records the base currency and exchange
+// rate in force at issue. It returns sql.ErrNoRows if the invoice is no longer in the expected status.
+func (r *InvoiceRepository) IssueInvoice(ctx context.Context, invoice *models.Invoice, from string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE invoices
+		SET status = $1, base_currency = $2, exchange_rate = $3, version = version + 1, updated_at = NOW()
+		WHERE id = $4 AND status = $5`
+	result, err := tx.ExecContext(ctx, query, invoice.Status, invoice.BaseCurrency, invoice.ExchangeRate, invoice.ID, from)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	err = insertActivities(ctx, tx, invoice.ID, invoice.UserID, &invoice.PendingActivities)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// MarkOverdueInvoices moves up to limit unpaid or partially paid invoices whose due date is before now to overdue and
+// returns them. Rows locked by a concurrent sweep are skipped. record is called on each invoice to record its
+// activities, which are written in the same transaction.
+func (r *InvoiceRepository) MarkOverdueInvoices(ctx context.Context, now time.Time, limit int, record func(*models.Invoice)) ([]*models.Invoice, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	var invoices []*models.Invoice
+	query := `
+		UPDATE invoices
+		SET status = 'overdue', version = version + 1, updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM invoices
+			WHERE status IN ('unpaid', 'partially_paid') AND due_date < $1
+			ORDER BY due_date
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, user_id, customer_id, invoice_number, status, due_date, total, amount_paid`
+	rows, err := tx.QueryContext(ctx, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var invoice models.Invoice
+		err := rows.Scan(
+			&invoice.ID, &invoice.UserID, &invoice.CustomerID, &invoice.InvoiceNumber, &invoice.Status, &invoice.DueDate,
+			&invoice.Total, &invoice.AmountPaid,
+		)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		invoices = append(invoices, &invoice)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	for _, invoice := range invoices {
+		record(invoice)
+		err = insertActivities(ctx, tx, invoice.ID, invoice.UserID, &invoice.PendingActivities)
+		if err != nil {
+			return nil, err
 		}
 	}
 
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return invoices, nil
+}
+
+// DeleteInvoice deletes a draft invoice together with its items and taxes. It returns sql.ErrNoRows if the
+// invoice is no longer a draft.
+func (r *InvoiceRepository) DeleteInvoice(ctx context.Context, invoice *models.Invoice) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `DELETE FROM invoices WHERE id = $1 AND status = 'draft'`
+	result, err := tx.ExecContext(ctx, query, invoice.ID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	err = insertActivities(ctx, tx, invoice.ID, invoice.UserID, &invoice.PendingActivities)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ArchiveInvoice archives an invoice, or unarchives it if invoice.ArchivedAt is nil. It returns sql.ErrNoRows if
+// the invoice is already in that state.
+func (r *InvoiceRepository) ArchiveInvoice(ctx context.Context, invoice *models.Invoice) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE invoices
+		SET archived_at = $1, version = version + 1, updated_at = NOW()
+		WHERE id = $2 AND (archived_at IS NULL) = ($1::timestamptz IS NOT NULL)
+		RETURNING version, updated_at`
+	err = tx.QueryRowContext(ctx, query, invoice.ArchivedAt, invoice.ID).Scan(&invoice.Version, &invoice.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	err = insertActivities(ctx, tx, invoice.ID, invoice.UserID, &invoice.PendingActivities)
+	if err != nil {
+		return err
+	}
+
 	// Commit transaction
 	if err := tx.Commit(); err != nil {
 		return err
@@ -164,26 +402,97 @@ func (r *InvoiceRepository) UpdateInvoice(ctx context.Context, invoice *models.I
 	return nil
 }
 
-func (r *InvoiceRepository) ListInvoicesByUserID(ctx context.Context, userID int64, pageSize int, pageToken string) ([]*models.Invoice, string, error) {
+// invoiceSortColumns maps the fields an invoice list can be sorted by to their columns.
+var invoiceSortColumns = map[string]string{
+	models.SortIssueDate:     "issue_date",
+	models.SortDueDate:       "due_date",
+	models.SortCreatedAt:     "created_at",
+	models.SortTotal:         "total",
+	models.SortInvoiceNumber: "invoice_number",
+}
+
+// ListInvoices returns the invoices matching the filter in its sort order, with the ID breaking ties. Pages
+// continue from a keyset cursor rather than an offset, so inserts don't shift rows between pages.
+func (r *InvoiceRepository) ListInvoices(ctx context.Context, filter models.InvoiceFilter) ([]*models.Invoice, error) {
 	var invoices []*models.Invoice
-	var offset int
 
-	// Decode the pageToken into an offset (cursor-based pagination)
-	if pageToken != "" {
-		offset = decodePageToken(pageToken)
+	column, ok := invoiceSortColumns[filter.SortBy]
+	if !ok {
+		return nil, fmt.Errorf("unknown sort field %q", filter.SortBy)
 	}
 
-	query := `
-		SELECT id, user_id, customer_id, invoice_number, status, issue_date, due_date, currency, subtotal, 
-			discount_percentage, discount_amount, total, account_name, account_number, bank_name, routing_number, note, 
-			created_at, updated_at
-	    FROM invoices 
-		WHERE user_id = $1 
-		ORDER BY issue_date DESC LIMIT $2 OFFSET $3`
+	conditions := []string{"user_id = $1"}
+	args := []any{filter.UserID}
+	where := func(condition string, values ...any) {
+		placeholders := make([]any, len(values))
+		for i, value := range values {
+			args = append(args, value)
+			placeholders[i] = len(args)
+		}
+		conditions = append(conditions, fmt.Sprintf(condition, placeholders...))
+	}
+
+	if !filter.IncludeArchived {
+		conditions = append(conditions, "archived_at IS NULL")
+	}
+	if len(filter.Statuses) > 0 {
+		where("status = ANY($%d)", filter.Statuses)
+	}
+	if filter.CustomerID != 0 {
+		where("customer_id = $%d", filter.CustomerID)
+	}
+	if filter.Currency != "" {
+		where("currency = $%d", filter.Currency)
+	}
+	if !filter.IssueDateFrom.IsZero() {
+		where("issue_date >= $%d", filter.IssueDateFrom)
+	}
+	if !filter.IssueDateTo.IsZero() {
+		where("issue_date < $%d", filter.IssueDateTo.AddDate(0, 0, 1))
+	}
+	if !filter.DueDateFrom.IsZero() {
+		where("due_date >= $%d", filter.DueDateFrom)
+	}
+	if !filter.DueDateTo.IsZero() {
+		where("due_date < $%d", filter.DueDateTo.AddDate(0, 0, 1))
+	}
+	if filter.MinTotal != 0 {
+		where("total >= $%d", filter.MinTotal)
+	}
+	if filter.MaxTotal != 0 {
+		where("total <= $%d", filter.MaxTotal)
+	}
+
+	direction, comparison := "ASC", ">"
+	if filter.Descending {
+		direction, comparison = "DESC", "<"
+	}
+	if cursor := filter.After; cursor != nil {
+		var value any
+		switch filter.SortBy {
+		case models.SortTotal:
+			value = cursor.Total
+		case models.SortInvoiceNumber:
+			value = cursor.InvoiceNumber
+		default:
+			value = cursor.Time
+		}
+		where("("+column+", id) "+comparison+" ($%d, $%d)", value, cursor.ID)
+	}
 
-	rows, err := r.db.QueryContext(ctx, query, userID, pageSize, offset)
+	query := fmt.Sprintf(`
+		SELECT id, user_id, customer_id, invoice_number, status, issue_date, due_date, currency, subtotal, 
+			discount_percentage, fixed_discount, discount_amount, charge_total, tax_total, total, amount_paid, amount_credited, 
+			credit_note_total, base_currency, exchange_rate, account_name, account_number, bank_name, routing_number, note, 
+			COALESCE(recurring_invoice_id, 0), COALESCE(quote_id, 0), COALESCE(branding_profile_id, 0), version, archived_at, created_at, updated_at, late_fee_total
+		FROM invoices 
+		WHERE %s
+		ORDER BY %s %s, id %s
+		LIMIT %d`, strings.Join(conditions, " AND "), column, direction, direction, filter.Limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, "", err
+		return nil, err
 	}
 	defer rows.Close()
 
@@ -191,78 +500,256 @@ func (r *InvoiceRepository) ListInvoicesByUserID(ctx context.Context, userID int
 		var invoice models.Invoice
 		err := rows.Scan(
 			&invoice.ID, &invoice.UserID, &invoice.CustomerID, &invoice.InvoiceNumber, &invoice.Status, &invoice.IssueDate,
-			&invoice.DueDate, &invoice.Currency, &invoice.Subtotal, &invoice.DiscountPercentage, &invoice.DiscountAmount, &invoice.Total,
-			&invoice.AccountName, &invoice.AccountNumber, &invoice.BankName, &invoice.RoutingNumber, &invoice.Note, &invoice.CreatedAt,
-			&invoice.UpdatedAt,
+			&invoice.DueDate, &invoice.Currency, &invoice.Subtotal, &invoice.DiscountPercentage, &invoice.FixedDiscount, &invoice.DiscountAmount,
+			&invoice.ChargeTotal, &invoice.TaxTotal, &invoice.Total, &invoice.AmountPaid, &invoice.AmountCredited, &invoice.CreditNoteTotal,
+			&invoice.BaseCurrency, &invoice.ExchangeRate, &invoice.AccountName, &invoice.AccountNumber, &invoice.BankName,
+			&invoice.RoutingNumber, &invoice.Note, &invoice.RecurringInvoiceID, &invoice.QuoteID, &invoice.BrandingProfileID, &invoice.Version, &invoice.ArchivedAt, &invoice.CreatedAt, &invoice.UpdatedAt,
+			&invoice.LateFeeTotal,
 		)
 		if err != nil {
-			return nil, "", err
+			return nil, err
 		}
+		invoices = append(invoices, &invoice)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
 
-		// Fetch invoice items for each invoice
-		invoice.Items, err = r.fetchInvoiceItems(ctx, invoice.ID)
+	// Fetch the items and taxes of the whole page at once
+	if !filter.WithoutItems {
+		err = r.fetchInvoiceDetails(ctx, invoices)
 		if err != nil {
-			return nil, "", err
+			return nil, err
 		}
+	}
 
-		invoices = append(invoices, &invoice)
+	return invoices, nil
+}
+
+// fetchInvoiceDetails loads the items, item tax snapshots, charges, tax totals and late fees of a set of
+// invoices. It runs the same five queries however many invoices there are.
+func (r *InvoiceRepository) fetchInvoiceDetails(ctx context.Context, invoices []*models.Invoice) error {
+	if len(invoices) == 0 {
+		return nil
 	}
 
-	// Generate nextPageToken if there are more rows
-	var nextPageToken string
-	if len(invoices) == pageSize {
-		nextPageToken = encodePageToken(offset + pageSize)
+	ids := make([]int64, len(invoices))
+	byID := make(map[int64]*models.Invoice, len(invoices))
+	for i, invoice := range invoices {
+		ids[i] = invoice.ID
+		byID[invoice.ID] = invoice
 	}
 
-	return invoices, nextPageToken, nil
+	err := r.fetchInvoiceItems(ctx, ids, byID)
+	if err != nil {
+		return err
+	}
+	err = r.fetchInvoiceCharges(ctx, ids, byID)
+	if err != nil {
+		return err
+	}
+	err = r.fetchInvoiceTaxes(ctx, ids, byID)
+	if err != nil {
+		return err
+	}
+	return r.fetchInvoiceLateFees(ctx, ids, byID)
 }
 
-func (r *InvoiceRepository) fetchInvoiceItems(ctx context.Context, invoiceID int64) ([]*models.InvoiceItem, error) {
-	var items []*models.InvoiceItem
+func (r *InvoiceRepository) fetchInvoiceItems(ctx context.Context, ids []int64, byID map[int64]*models.Invoice) error {
 	query := `
-		SELECT id, description, quantity, unit_price 
+		SELECT invoice_id, id, COALESCE(catalog_item_id, 0), sku, description, quantity, unit, unit_price,
+			discount_percentage, fixed_discount
 		FROM invoice_items 
-		WHERE invoice_id = $1`
-	rows, err := r.db.QueryContext(ctx, query, invoiceID)
+		WHERE invoice_id = ANY($1)
+		ORDER BY invoice_id, id`
+	rows, err := r.db.QueryContext(ctx, query, ids)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer rows.Close()
 
+	itemsByID := map[int64]*models.InvoiceItem{}
 	for rows.Next() {
+		var invoiceID int64
 		var item models.InvoiceItem
-		err := rows.Scan(&item.ID, &item.Description, &item.Quantity, &item.UnitPrice)
+		err := rows.Scan(&invoiceID, &item.ID, &item.CatalogItemID, &item.SKU, &item.Description, &item.Quantity, &item.Unit,
+			&item.UnitPrice, &item.DiscountPercentage, &item.FixedDiscount)
 		if err != nil {
-			return nil, err
+			return err
 		}
-		items = append(items, &item)
+		invoice := byID[invoiceID]
+		invoice.Items = append(invoice.Items, &item)
+		itemsByID[item.ID] = &item
+	}
+	if err := rows.Err(); err != nil {
+		return err
 	}
 
-	return items, nil
-}
+	// Fetch the tax snapshots of every item in one query
+	taxQuery := `
+		SELECT t.invoice_item_id, t.tax_rate_id, t.name, t.rate, t.inclusive, t.compound
+		FROM invoice_item_taxes t
+		JOIN invoice_items i ON i.id = t.invoice_item_id
+		WHERE i.invoice_id = ANY($1)
+		ORDER BY t.invoice_item_id, t.position`
+	taxRows, err := r.db.QueryContext(ctx, taxQuery, ids)
+	if err != nil {
+		return err
+	}
+	defer taxRows.Close()
 
-// encodePageToken encodes the integer offset as a base64 pageToken.
-func encodePageToken(offset int) string {
-	// Convert the integer offset to a string
-	offsetStr := strconv.Itoa(offset)
+	for taxRows.Next() {
+		var itemID int64
+		var tax models.TaxRate
+		err := taxRows.Scan(&itemID, &tax.ID, &tax.Name, &tax.Rate, &tax.Inclusive, &tax.Compound)
+		if err != nil {
+			return err
+		}
+		if item, ok := itemsByID[itemID]; ok {
+			item.TaxRateIDs = append(item.TaxRateIDs, tax.ID)
+			item.Taxes = append(item.Taxes, &tax)
+		}
+	}
 
-	// Encode the string in base64
-	return base64.URLEncoding.EncodeToString([]byte(offsetStr))
+	return taxRows.Err()
 }
 
-// decodePageToken decodes the base64 pageToken to an integer offset.
-func decodePageToken(pageToken string) int {
-	// Decode the base64-encoded string
-	offsetBytes, err := base64.URLEncoding.DecodeString(pageToken)
+// fetchInvoiceCharges loads the charges of a set of invoices together with their tax snapshots.
+func (r *InvoiceRepository) fetchInvoiceCharges(ctx context.Context, ids []int64, byID map[int64]*models.Invoice) error {
+	query := `
+		SELECT c.invoice_id, c.id, c.description, c.amount, t.tax_rate_id, t.name, t.rate, t.inclusive, t.compound
+		FROM invoice_charges c
+		LEFT JOIN invoice_charge_taxes t ON t.invoice_charge_id = c.id
+		WHERE c.invoice_id = ANY($1)
+		ORDER BY c.invoice_id, c.id, t.position`
+	rows, err := r.db.QueryContext(ctx, query, ids)
 	if err != nil {
-		return 0
+		return err
+	}
+	defer rows.Close()
+
+	var charge *models.InvoiceCharge
+	for rows.Next() {
+		var invoiceID, chargeID, amount int64
+		var description string
+		var taxRateID, rate sql.NullInt64
+		var name sql.NullString
+		var inclusive, compound sql.NullBool
+		err := rows.Scan(&invoiceID, &chargeID, &description, &amount, &taxRateID, &name, &rate, &inclusive, &compound)
+		if err != nil {
+			return err
+		}
+
+		// Rows of the same charge arrive together, one per tax rate
+		if charge == nil || charge.ID != chargeID {
+			charge = &models.InvoiceCharge{ID: chargeID, Description: description, Amount: amount}
+			invoice := byID[invoiceID]
+			invoice.Charges = append(invoice.Charges, charge)
+		}
+		if taxRateID.Valid {
+			charge.TaxRateIDs = append(charge.TaxRateIDs, taxRateID.Int64)
+			charge.Taxes = append(charge.Taxes, &models.TaxRate{
+				ID:        taxRateID.Int64,
+				Name:      name.String,
+				Rate:      rate.Int64,
+				Inclusive: inclusive.Bool,
+				Compound:  compound.Bool,
+			})
+		}
 	}
 
-	// Convert the decoded bytes to an integer
-	offset, err := strconv.Atoi(string(offsetBytes))
+	return rows.Err()
+}
+
+func (r *InvoiceRepository) fetchInvoiceTaxes(ctx context.Context, ids []int64, byID map[int64]*models.Invoice) error {
+	query := `
+		SELECT invoice_id, tax_rate_id, name, rate, inclusive, compound, taxable_amount, amount
+		FROM invoice_taxes
+		WHERE invoice_id = ANY($1)
+		ORDER BY invoice_id, id`
+	rows, err := r.db.QueryContext(ctx, query, ids)
 	if err != nil {
-		return 0
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var invoiceID int64
+		var tax models.InvoiceTax
+		err := rows.Scan(&invoiceID, &tax.TaxRateID, &tax.Name, &tax.Rate, &tax.Inclusive, &tax.Compound, &tax.TaxableAmount, &tax.Amount)
+		if err != nil {
+			return err
+		}
+		invoice := byID[invoiceID]
+		invoice.Taxes = append(invoice.Taxes, &tax)
 	}
 
-	return offset
+	return rows.Err()
+}
+
+// insertInvoiceItems inserts the items of an invoice together with their tax snapshots.
+func insertInvoiceItems(ctx context.Context, tx *sql.Tx, invoice *models.Invoice) error {
+	for _, item := range invoice.Items {
+		itemQuery := `
+			INSERT INTO invoice_items (invoice_id, catalog_item_id, sku, description, quantity, unit, unit_price,
+				discount_percentage, fixed_discount)
+			VALUES ($1, NULLIF($2, 0), $3, $4, $5, $6, $7, $8, $9)
+			RETURNING id`
+		err := tx.QueryRowContext(ctx, itemQuery, invoice.ID, item.CatalogItemID, item.SKU, item.Description, item.Quantity,
+			item.Unit, item.UnitPrice, item.DiscountPercentage, item.FixedDiscount).Scan(&item.ID)
+		if err != nil {
+			return err
+		}
+
+		for position, tax := range item.Taxes {
+			taxQuery := `
+				INSERT INTO invoice_item_taxes (invoice_item_id, tax_rate_id, position, name, rate, inclusive, compound)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)`
+			_, err := tx.ExecContext(ctx, taxQuery, item.ID, tax.ID, position, tax.Name, tax.Rate, tax.Inclusive, tax.Compound)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// insertInvoiceCharges inserts the charges of an invoice together with their tax snapshots.
+func insertInvoiceCharges(ctx context.Context, tx *sql.Tx, invoice *models.Invoice) error {
+	for _, charge := range invoice.Charges {
+		chargeQuery := `
+			INSERT INTO invoice_charges (invoice_id, description, amount)
+			VALUES ($1, $2, $3)
+			RETURNING id`
+		err := tx.QueryRowContext(ctx, chargeQuery, invoice.ID, charge.Description, charge.Amount).Scan(&charge.ID)
+		if err != nil {
+			return err
+		}
+
+		for position, tax := range charge.Taxes {
+			taxQuery := `
+				INSERT INTO invoice_charge_taxes (invoice_charge_id, tax_rate_id, position, name, rate, inclusive, compound)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)`
+			_, err := tx.ExecContext(ctx, taxQuery, charge.ID, tax.ID, position, tax.Name, tax.Rate, tax.Inclusive, tax.Compound)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// insertInvoiceTaxes inserts the per-rate tax breakdown of an invoice.
+func insertInvoiceTaxes(ctx context.Context, tx *sql.Tx, invoice *models.Invoice) error {
+	for _, tax := range invoice.Taxes {
+		query := `
+			INSERT INTO invoice_taxes (invoice_id, tax_rate_id, name, rate, inclusive, compound, taxable_amount, amount)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+		_, err := tx.ExecContext(ctx, query, invoice.ID, tax.TaxRateID, tax.Name, tax.Rate, tax.Inclusive, tax.Compound,
+			tax.TaxableAmount, tax.Amount)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
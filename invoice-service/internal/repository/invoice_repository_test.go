@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+// roundTrip is the latency the benchmark database adds to every query, standing in for the network
+// round trip to Postgres.
+const roundTrip = 100 * time.Microsecond
+
+// BenchmarkListInvoices lists a page of invoices with three items each. The number of queries per
+// list stays the same whatever the page size.
+func BenchmarkListInvoices(b *testing.B) {
+	for _, pageSize := range []int{10, 100} {
+		for _, withoutItems := range []bool{false, true} {
+			name := fmt.Sprintf("page=%d/items", pageSize)
+			if withoutItems {
+				name = fmt.Sprintf("page=%d/headers", pageSize)
+			}
+			b.Run(name, func(b *testing.B) {
+				db, queries := openBenchmarkDB(b, pageSize, 3)
+				repo := NewInvoiceRepository(db)
+				filter := models.InvoiceFilter{UserID: 1, SortBy: models.SortIssueDate, Limit: pageSize, WithoutItems: withoutItems}
+
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					invoices, err := repo.ListInvoices(context.Background(), filter)
+					if err != nil {
+						b.Fatal(err)
+					}
+					if len(invoices) != pageSize {
+						b.Fatalf("got %d invoices, want %d", len(invoices), pageSize)
+					}
+				}
+				b.ReportMetric(float64(queries.Load())/float64(b.N), "queries/op")
+			})
+		}
+	}
+}
+
+func TestListInvoices_LoadsDetailsInBatches(t *testing.T) {
+	db, queries := openBenchmarkDB(t, 5, 2)
+	repo := NewInvoiceRepository(db)
+
+	invoices, err := repo.ListInvoices(context.Background(), models.InvoiceFilter{UserID: 1, SortBy: models.SortIssueDate, Limit: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// One query for the invoices, then one each for items, item taxes, charges, invoice taxes and late fees
+	if got := queries.Load(); got != 6 {
+		t.Errorf("ran %d queries, want 6", got)
+	}
+	for _, invoice := range invoices {
+		if len(invoice.Items) != 2 || len(invoice.Items[0].Taxes) != 1 || len(invoice.Taxes) != 1 {
+			t.Errorf("invoice %d has %d items and %d taxes, want 2 items with a tax each and 1 tax",
+				invoice.ID, len(invoice.Items), len(invoice.Taxes))
+		}
+	}
+}
+
+// openBenchmarkDB opens a database of invoices invoices with itemsPerInvoice items each, every item and
+// invoice carrying one tax. It counts the queries run against it.
+func openBenchmarkDB(tb testing.TB, invoices, itemsPerInvoice int) (*sql.DB, *atomic.Int64) {
+	tb.Helper()
+	fake := &fakeDB{invoices: invoices, itemsPerInvoice: itemsPerInvoice}
+	db := sql.OpenDB(fake)
+	tb.Cleanup(func() { db.Close() })
+	return db, &fake.queries
+}
+
+// fakeDB is a database/sql driver that answers the invoice list queries with generated rows.
+type fakeDB struct {
+	invoices        int
+	itemsPerInvoice int
+	queries         atomic.Int64
+}
+
+func (d *fakeDB) Connect(context.Context) (driver.Conn, error) { return &fakeConn{db: d}, nil }
+func (d *fakeDB) Driver() driver.Driver                        { return nil }
+
+type fakeConn struct {
+	db *fakeDB
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, fmt.Errorf("prepare is not supported")
+}
+func (c *fakeConn) Close() error { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("transactions are not supported")
+}
+
+// CheckNamedValue accepts any argument, such as the ID slices passed to ANY($1).
+func (c *fakeConn) CheckNamedValue(*driver.NamedValue) error { return nil }
+
+func (c *fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	c.db.queries.Add(1)
+	time.Sleep(roundTrip)
+
+	issueDate := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	rows := &fakeRows{}
+	switch {
+	case strings.Contains(query, "FROM invoice_item_taxes"):
+		rows.columns = []string{"invoice_item_id", "tax_rate_id", "name", "rate", "inclusive", "compound"}
+		for item := 1; item <= c.db.invoices*c.db.itemsPerInvoice; item++ {
+			rows.values = append(rows.values, []driver.Value{int64(item), int64(1), "VAT", int64(750), false, false})
+		}
+	case strings.Contains(query, "FROM invoice_items"):
+		rows.columns = []string{"invoice_id", "id", "catalog_item_id", "sku", "description", "quantity", "unit", "unit_price",
+			"discount_percentage", "fixed_discount"}
+		for item := 1; item <= c.db.invoices*c.db.itemsPerInvoice; item++ {
+			invoiceID := int64((item-1)/c.db.itemsPerInvoice + 1)
+			rows.values = append(rows.values, []driver.Value{invoiceID, int64(item), int64(0), "", "Consulting", int64(2), "", int64(12500), int64(0), int64(0)})
+		}
+	case strings.Contains(query, "FROM invoice_charges"):
+		rows.columns = []string{"invoice_id", "id", "description", "amount", "tax_rate_id", "name", "rate", "inclusive", "compound"}
+	case strings.Contains(query, "FROM invoice_taxes"):
+		rows.columns = []string{"invoice_id", "tax_rate_id", "name", "rate", "inclusive", "compound", "taxable_amount", "amount"}
+		for id := 1; id <= c.db.invoices; id++ {
+			rows.values = append(rows.values, []driver.Value{int64(id), int64(1), "VAT", int64(750), false, false, int64(75000), int64(5625)})
+		}
+	case strings.Contains(query, "FROM invoice_late_fees"):
+		rows.columns = []string{"id", "invoice_id", "user_id", "kind", "description", "amount", "period_start", "period_end", "reversed_at", "created_at"}
+	case strings.Contains(query, "FROM invoices"):
+		rows.columns = make([]string, 33)
+		for id := 1; id <= c.db.invoices; id++ {
+			rows.values = append(rows.values, []driver.Value{
+				int64(id), int64(1), int64(2), fmt.Sprintf("%06d", id), models.StatusUnpaid, issueDate, issueDate.AddDate(0, 0, 30),
+				"USD", int64(75000), int64(0), int64(0), int64(0), int64(0), int64(5625), int64(80625), int64(0), int64(0), int64(0),
+				"USD", "1", "Acme", "0123456789", "First Bank", "021000021", "", int64(0), int64(0), int64(0), int64(1), nil, issueDate, issueDate,
+				int64(0),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("unexpected query %q", query)
+	}
+	return rows, nil
+}
+
+type fakeRows struct {
+	columns []string
+	values  [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
@@ -0,0 +1,213 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+// GetLateFeePolicy returns the user's late fee policy. It returns sql.ErrNoRows if the user has not set one.
+func (r *InvoiceRepository) GetLateFeePolicy(ctx context.Context, userID int64) (*models.LateFeePolicy, error) {
+	query := `
+		SELECT user_id, kind, amount, rate, grace_days, cap, updated_at
+		FROM late_fee_policies
+		WHERE user_id = $1`
+
+	var policy models.LateFeePolicy
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&policy.UserID, &policy.Kind, &policy.Amount, &policy.Rate,
+		&policy.GraceDays, &policy.Cap, &policy.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &policy, nil
+}
+
+func (r *InvoiceRepository) SetLateFeePolicy(ctx context.Context, policy *models.LateFeePolicy) error {
+	query := `
+		INSERT INTO late_fee_policies (user_id, kind, amount, rate, grace_days, cap)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id) DO UPDATE SET kind = EXCLUDED.kind, amount = EXCLUDED.amount, rate = EXCLUDED.rate,
+			grace_days = EXCLUDED.grace_days, cap = EXCLUDED.cap, updated_at = NOW()
+		RETURNING updated_at`
+	return r.db.QueryRowContext(ctx, query, policy.UserID, policy.Kind, policy.Amount, policy.Rate, policy.GraceDays,
+		policy.Cap).Scan(&policy.UpdatedAt)
+}
+
+// DeleteLateFeePolicy removes the user's late fee policy. It returns sql.ErrNoRows if the user has none.
+func (r *InvoiceRepository) DeleteLateFeePolicy(ctx context.Context, userID int64) error {
+	query := `DELETE FROM late_fee_policies WHERE user_id = $1`
+	result, err := r.db.ExecContext(ctx, query, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// ListInvoicesDueLateFees returns up to limit unpaid, partially paid or overdue invoices whose owner has a late
+// fee policy, whose grace period ended before today and which have not been assessed today. Only the ID, user and number are set.
+func (r *InvoiceRepository) ListInvoicesDueLateFees(ctx context.Context, today time.Time, limit int) ([]*models.Invoice, error) {
+	var invoices []*models.Invoice
+	query := `
+		SELECT i.id, i.user_id, i.invoice_number
+		FROM invoices i
+		JOIN late_fee_policies p ON p.user_id = i.user_id
+		WHERE i.status IN ('unpaid', 'partially_paid', 'overdue') AND i.due_date + make_interval(days => p.grace_days) < $1
+			AND (i.late_fees_assessed_on IS NULL OR i.late_fees_assessed_on < $2)
+		ORDER BY i.due_date, i.id
+		LIMIT $3`
+	rows, err := r.db.QueryContext(ctx, query, today, today, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var invoice models.Invoice
+		err := rows.Scan(&invoice.ID, &invoice.UserID, &invoice.InvoiceNumber)
+		if err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, &invoice)
+	}
+	return invoices, rows.Err()
+}
+
+func (r *InvoiceRepository) GetLateFeeByID(ctx context.Context, lateFeeID int64) (*models.LateFee, error) {
+	query := `
+		SELECT id, invoice_id, user_id, kind, description, amount, period_start, period_end, reversed_at, created_at
+		FROM invoice_late_fees
+		WHERE id = $1`
+
+	var fee models.LateFee
+	err := r.db.QueryRowContext(ctx, query, lateFeeID).Scan(&fee.ID, &fee.InvoiceID, &fee.UserID, &fee.Kind,
+		&fee.Description, &fee.Amount, &fee.PeriodStart, &fee.PeriodEnd, &fee.ReversedAt, &fee.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &fee, nil
+}
+
+// ApplyLateFee records that an invoice read at invoice.Version was assessed for late fees on assessedOn, adding
+// fee to it unless fee is nil. It returns sql.ErrNoRows if the invoice changed since it was read or no longer
+// has a balance owing.
+func (r *InvoiceRepository) ApplyLateFee(ctx context.Context, invoice *models.Invoice, fee *models.LateFee, assessedOn time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var amount int64
+	if fee != nil {
+		query := `
+			INSERT INTO invoice_late_fees (invoice_id, user_id, kind, description, amount, period_start, period_end)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)
+			RETURNING id, created_at`
+		err = tx.QueryRowContext(ctx, query, fee.InvoiceID, fee.UserID, fee.Kind, fee.Description, fee.Amount,
+			fee.PeriodStart, fee.PeriodEnd).Scan(&fee.ID, &fee.CreatedAt)
+		if err != nil {
+			return err
+		}
+		amount = fee.Amount
+	}
+
+	// Only a new fee changes the invoice's version
+	query := `
+		UPDATE invoices
+		SET late_fee_total = late_fee_total + $1, late_fees_assessed_on = $2,
+			version = version + CASE WHEN $1 > 0 THEN 1 ELSE 0 END, updated_at = NOW()
+		WHERE id = $3 AND version = $4 AND status IN ('unpaid', 'partially_paid', 'overdue')
+		RETURNING version`
+	err = tx.QueryRowContext(ctx, query, amount, assessedOn, invoice.ID, invoice.Version).Scan(&invoice.Version)
+	if err != nil {
+		return err
+	}
+
+	err = insertActivities(ctx, tx, invoice.ID, invoice.UserID, &invoice.PendingActivities)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ReverseLateFee reverses a late fee, taking it back off the invoice's balance and moving the invoice to status.
+// It returns sql.ErrNoRows if the fee was already reversed or the invoice changed since it was read.
+func (r *InvoiceRepository) ReverseLateFee(ctx context.Context, fee *models.LateFee, invoice *models.Invoice, status string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE invoice_late_fees
+		SET reversed_at = NOW()
+		WHERE id = $1 AND reversed_at IS NULL
+		RETURNING reversed_at`
+	err = tx.QueryRowContext(ctx, query, fee.ID).Scan(&fee.ReversedAt)
+	if err != nil {
+		return err
+	}
+
+	query = `
+		UPDATE invoices
+		SET late_fee_total = late_fee_total - $1, status = $2, version = version + 1, updated_at = NOW()
+		WHERE id = $3 AND version = $4
+		RETURNING version`
+	err = tx.QueryRowContext(ctx, query, fee.Amount, status, invoice.ID, invoice.Version).Scan(&invoice.Version)
+	if err != nil {
+		return err
+	}
+
+	err = insertActivities(ctx, tx, invoice.ID, invoice.UserID, &invoice.PendingActivities)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// fetchInvoiceLateFees loads the late fees of a set of invoices, reversed ones included.
+func (r *InvoiceRepository) fetchInvoiceLateFees(ctx context.Context, ids []int64, byID map[int64]*models.Invoice) error {
+	query := `
+		SELECT id, invoice_id, user_id, kind, description, amount, period_start, period_end, reversed_at, created_at
+		FROM invoice_late_fees
+		WHERE invoice_id = ANY($1)
+		ORDER BY invoice_id, id`
+	rows, err := r.db.QueryContext(ctx, query, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var fee models.LateFee
+		err := rows.Scan(&fee.ID, &fee.InvoiceID, &fee.UserID, &fee.Kind, &fee.Description, &fee.Amount,
+			&fee.PeriodStart, &fee.PeriodEnd, &fee.ReversedAt, &fee.CreatedAt)
+		if err != nil {
+			return err
+		}
+		invoice := byID[fee.InvoiceID]
+		invoice.LateFees = append(invoice.LateFees, &fee)
+	}
+	return rows.Err()
+}
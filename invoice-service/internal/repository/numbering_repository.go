@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+// GetNumberingScheme returns the user's numbering scheme. It returns sql.ErrNoRows if the user has not chosen one.
+func (r *InvoiceRepository) GetNumberingScheme(ctx context.Context, userID int64) (*models.NumberingScheme, error) {
+	query := `SELECT user_id, format, yearly_reset, updated_at FROM invoice_numbering_schemes WHERE user_id = $1`
+
+	var scheme models.NumberingScheme
+	err := r.db.QueryRowContext(ctx, query, userID).Scan(&scheme.UserID, &scheme.Format, &scheme.YearlyReset, &scheme.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &scheme, nil
+}
+
+func (r *InvoiceRepository) SetNumberingScheme(ctx context.Context, scheme *models.NumberingScheme) error {
+	query := `
+		INSERT INTO invoice_numbering_schemes (user_id, format, yearly_reset)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO UPDATE SET format = EXCLUDED.format, yearly_reset = EXCLUDED.yearly_reset, updated_at = NOW()
+		RETURNING updated_at`
+	return r.db.QueryRowContext(ctx, query, scheme.UserID, scheme.Format, scheme.YearlyReset).Scan(&scheme.UpdatedAt)
+}
+
+// GetInvoiceNumberCounter returns the last sequence number allocated to the user in a year's sequence, or 0
+// if none has been.
+func (r *InvoiceRepository) GetInvoiceNumberCounter(ctx context.Context, userID int64, year int) (int64, error) {
+	query := `SELECT current_value FROM invoice_number_counters WHERE user_id = $1 AND year = $2`
+
+	var seq int64
+	err := r.db.QueryRowContext(ctx, query, userID, year).Scan(&seq)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return seq, nil
+}
+
+// allocateInvoiceNumber numbers an invoice from its user's sequence. The counter row stays locked until
+// the transaction ends, so concurrent invoices are numbered one after the other and a rolled back
+// transaction gives its number back.
+func allocateInvoiceNumber(ctx context.Context, tx *sql.Tx, invoice *models.Invoice) error {
+	scheme := models.NumberingScheme{UserID: invoice.UserID, Format: models.DefaultInvoiceNumberFormat}
+	query := `SELECT format, yearly_reset FROM invoice_numbering_schemes WHERE user_id = $1`
+	err := tx.QueryRowContext(ctx, query, invoice.UserID).Scan(&scheme.Format, &scheme.YearlyReset)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	date := invoice.IssueDate
+	if date.IsZero() {
+		date = time.Now()
+	}
+
+	for {
+		query := `
+			INSERT INTO invoice_number_counters (user_id, year, current_value)
+			VALUES ($1, $2, 1)
+			ON CONFLICT (user_id, year) DO UPDATE SET current_value = invoice_number_counters.current_value + 1
+			RETURNING current_value`
+		var seq int64
+		err := tx.QueryRowContext(ctx, query, invoice.UserID, scheme.SequenceYear(date)).Scan(&seq)
+		if err != nil {
+			return err
+		}
+		number := scheme.FormatNumber(seq, date)
+
+		// A number can already be taken after the user changes their format, so skip past it
+		var taken bool
+		query = `SELECT EXISTS (SELECT 1 FROM invoices WHERE user_id = $1 AND invoice_number = $2)`
+		err = tx.QueryRowContext(ctx, query, invoice.UserID, number).Scan(&taken)
+		if err != nil {
+			return err
+		}
+		if !taken {
+			invoice.InvoiceNumber = number
+			return nil
+		}
+	}
+}
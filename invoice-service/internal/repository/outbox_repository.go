@@ -0,0 +1,189 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+// insertActivities writes the activities recorded on an invoice or credit note to the outbox as part of tx, so
+// they are published if and only if the change they describe is committed.
+func insertActivities(ctx context.Context, tx *sql.Tx, invoiceID, userID int64, pending *models.PendingActivities) error {
+	query := `
+		INSERT INTO outbox_events (aggregate_type, aggregate_id, event_type, payload)
+		VALUES ($1, $2, $3, $4)`
+	for _, activity := range pending.TakeActivities(invoiceID, userID) {
+		payload, err := json.Marshal(activity)
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, query, models.AggregateInvoice, invoiceID, activity.Action, payload)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CreateActivities writes the activities recorded on an invoice to the outbox, for events that don't change
+// the invoice itself, like sending it.
+func (r *InvoiceRepository) CreateActivities(ctx context.Context, invoice *models.Invoice) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = insertActivities(ctx, tx, invoice.ID, invoice.UserID, &invoice.PendingActivities)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListDueOutboxEvents returns up to limit pending events whose next attempt is due by now, oldest first. An
+// event is held back while an earlier event of the same aggregate is undelivered and not due, so every
+// aggregate's events are published in the order they were written.
+func (r *InvoiceRepository) ListDueOutboxEvents(ctx context.Context, now time.Time, limit int) ([]*models.OutboxEvent, error) {
+	var events []*models.OutboxEvent
+	query := `
+		SELECT e.id, e.aggregate_type, e.aggregate_id, e.event_type, e.payload, e.status, e.attempts, e.last_error,
+			e.next_attempt_at, e.created_at, e.published_at
+		FROM outbox_events e
+		WHERE e.status = 'pending' AND e.next_attempt_at <= $1
+			AND NOT EXISTS (
+				SELECT 1 FROM outbox_events earlier
+				WHERE earlier.aggregate_type = e.aggregate_type AND earlier.aggregate_id = e.aggregate_id
+					AND earlier.id < e.id AND earlier.status <> 'published'
+					AND (earlier.status = 'failed' OR earlier.next_attempt_at > $1)
+			)
+		ORDER BY e.id
+		LIMIT $2`
+	rows, err := r.db.QueryContext(ctx, query, now, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		event, err := scanOutboxEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+// ListOutboxEvents returns up to limit events in a status, newest first, or of every status if status is empty.
+func (r *InvoiceRepository) ListOutboxEvents(ctx context.Context, status string, limit int) ([]*models.OutboxEvent, error) {
+	var events []*models.OutboxEvent
+	query := `
+		SELECT id, aggregate_type, aggregate_id, event_type, payload, status, attempts, last_error,
+			next_attempt_at, created_at, published_at
+		FROM outbox_events
+		WHERE $1 = '' OR status = $1
+		ORDER BY id DESC
+		LIMIT $2`
+	rows, err := r.db.QueryContext(ctx, query, status, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		event, err := scanOutboxEvent(rows)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, rows.Err()
+}
+
+// GetOutboxStats counts the outbox events in each status.
+func (r *InvoiceRepository) GetOutboxStats(ctx context.Context) (*models.OutboxStats, error) {
+	var stats models.OutboxStats
+	query := `
+		SELECT COUNT(*) FILTER (WHERE status = 'pending'), COUNT(*) FILTER (WHERE status = 'published'),
+			COUNT(*) FILTER (WHERE status = 'failed'), MIN(created_at) FILTER (WHERE status = 'pending')
+		FROM outbox_events`
+	err := r.db.QueryRowContext(ctx, query).Scan(&stats.Pending, &stats.Published, &stats.Failed, &stats.OldestPendingAt)
+	if err != nil {
+		return nil, err
+	}
+	return &stats, nil
+}
+
+// MarkOutboxEventPublished records that the broker confirmed an event.
+func (r *InvoiceRepository) MarkOutboxEventPublished(ctx context.Context, event *models.OutboxEvent) error {
+	query := `
+		UPDATE outbox_events
+		SET status = 'published', attempts = attempts + 1, last_error = '', published_at = NOW()
+		WHERE id = $1
+		RETURNING status, attempts, published_at`
+	return r.db.QueryRowContext(ctx, query, event.ID).Scan(&event.Status, &event.Attempts, &event.PublishedAt)
+}
+
+// MarkOutboxEventFailed records a failed attempt to publish an event, with the event's new status, error and
+// next attempt time already set on it.
+func (r *InvoiceRepository) MarkOutboxEventFailed(ctx context.Context, event *models.OutboxEvent) error {
+	query := `
+		UPDATE outbox_events
+		SET status = $1, attempts = $2, last_error = $3, next_attempt_at = $4
+		WHERE id = $5 AND status = 'pending'`
+	result, err := r.db.ExecContext(ctx, query, event.Status, event.Attempts, event.LastError, event.NextAttemptAt, event.ID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// RetryOutboxEvent moves a failed event back to pending, due now, with its attempts reset. It returns
+// sql.ErrNoRows if there is no failed event with that ID.
+func (r *InvoiceRepository) RetryOutboxEvent(ctx context.Context, eventID int64) (*models.OutboxEvent, error) {
+	query := `
+		UPDATE outbox_events
+		SET status = 'pending', attempts = 0, next_attempt_at = NOW()
+		WHERE id = $1 AND status = 'failed'
+		RETURNING id, aggregate_type, aggregate_id, event_type, payload, status, attempts, last_error,
+			next_attempt_at, created_at, published_at`
+	return scanOutboxEvent(r.db.QueryRowContext(ctx, query, eventID))
+}
+
+// DeletePublishedOutboxEvents deletes events published before a cutoff and returns how many were deleted.
+func (r *InvoiceRepository) DeletePublishedOutboxEvents(ctx context.Context, before time.Time) (int64, error) {
+	query := `DELETE FROM outbox_events WHERE status = 'published' AND published_at < $1`
+	result, err := r.db.ExecContext(ctx, query, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// scanOutboxEvent scans an outbox event from a row of the columns the queries above select, in order.
+func scanOutboxEvent(row rowScanner) (*models.OutboxEvent, error) {
+	var event models.OutboxEvent
+	err := row.Scan(&event.ID, &event.AggregateType, &event.AggregateID, &event.EventType, &event.Payload,
+		&event.Status, &event.Attempts, &event.LastError, &event.NextAttemptAt, &event.CreatedAt, &event.PublishedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &event, nil
+}
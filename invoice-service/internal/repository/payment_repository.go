@@ -0,0 +1,152 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+// CreatePayment records a payment against an invoice and applies it to the invoice's amount paid and status
+// in a single transaction. It returns sql.ErrNoRows if the invoice changed since it was read.
+func (r *InvoiceRepository) CreatePayment(ctx context.Context, payment *models.Payment, invoice *models.Invoice, status string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Insert payment details
+	query := `
+		INSERT INTO payments (invoice_id, amount, method, reference, payment_date)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at`
+	err = tx.QueryRowContext(ctx, query,
+		payment.InvoiceID, payment.Amount, payment.Method, payment.Reference, payment.PaymentDate).Scan(
+		&payment.ID, &payment.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	// Apply the payment to the invoice
+	err = applyPayment(ctx, tx, invoice, payment.Amount, status)
+	if err != nil {
+		return err
+	}
+
+	err = insertActivities(ctx, tx, invoice.ID, invoice.UserID, &invoice.PendingActivities)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *InvoiceRepository) GetPaymentByID(ctx context.Context, paymentID int64) (*models.Payment, error) {
+	query := `
+		SELECT id, invoice_id, amount, method, reference, payment_date, refunded_at, created_at
+		FROM payments
+		WHERE id = $1`
+
+	var payment models.Payment
+	err := r.db.QueryRowContext(ctx, query, paymentID).Scan(
+		&payment.ID, &payment.InvoiceID, &payment.Amount, &payment.Method, &payment.Reference, &payment.PaymentDate,
+		&payment.RefundedAt, &payment.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &payment, nil
+}
+
+func (r *InvoiceRepository) ListPaymentsByInvoiceID(ctx context.Context, invoiceID int64) ([]*models.Payment, error) {
+	var payments []*models.Payment
+	query := `
+		SELECT id, invoice_id, amount, method, reference, payment_date, refunded_at, created_at
+		FROM payments
+		WHERE invoice_id = $1
+		ORDER BY payment_date, id`
+	rows, err := r.db.QueryContext(ctx, query, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var payment models.Payment
+		err := rows.Scan(
+			&payment.ID, &payment.InvoiceID, &payment.Amount, &payment.Method, &payment.Reference, &payment.PaymentDate,
+			&payment.RefundedAt, &payment.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, &payment)
+	}
+
+	return payments, rows.Err()
+}
+
+// RefundPayment marks a payment as refunded and removes it from the invoice's amount paid in a single
+// transaction. It returns sql.ErrNoRows if the payment was already refunded or the invoice changed since it was read.
+func (r *InvoiceRepository) RefundPayment(ctx context.Context, payment *models.Payment, invoice *models.Invoice, status string) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE payments
+		SET refunded_at = NOW()
+		WHERE id = $1 AND refunded_at IS NULL
+		RETURNING refunded_at`
+	err = tx.QueryRowContext(ctx, query, payment.ID).Scan(&payment.RefundedAt)
+	if err != nil {
+		return err
+	}
+
+	// Take the payment back off the invoice
+	err = applyPayment(ctx, tx, invoice, -payment.Amount, status)
+	if err != nil {
+		return err
+	}
+
+	err = insertActivities(ctx, tx, invoice.ID, invoice.UserID, &invoice.PendingActivities)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// applyPayment adds amount to the invoice's amount paid and sets its status, provided its status and
+// balance haven't changed since the invoice was read.
+func applyPayment(ctx context.Context, tx *sql.Tx, invoice *models.Invoice, amount int64, status string) error {
+	query := `
+		UPDATE invoices
+		SET amount_paid = amount_paid + $1, status = $2, version = version + 1, updated_at = NOW()
+		WHERE id = $3 AND status = $4 AND amount_paid = $5 AND amount_credited = $6`
+	result, err := tx.ExecContext(ctx, query, amount, status, invoice.ID, invoice.Status, invoice.AmountPaid, invoice.AmountCredited)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
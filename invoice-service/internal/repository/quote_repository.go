@@ -0,0 +1,333 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+const quoteColumns = `
+	id, user_id, customer_id, quote_number, status, issue_date, expiry_date, currency, discount_percentage, subtotal,
+	discount_amount, tax_total, total, payment_terms_days, note,
+	COALESCE((SELECT i.id FROM invoices i WHERE i.quote_id = quotes.id), 0), created_at, updated_at`
+
+// CreateQuote numbers a quote and inserts it with its items and taxes.
+func (r *InvoiceRepository) CreateQuote(ctx context.Context, quote *models.Quote) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	err = allocateQuoteNumber(ctx, tx, quote)
+	if err != nil {
+		return err
+	}
+
+	// Insert quote details
+	query := `
+		INSERT INTO quotes (user_id, customer_id, quote_number, status, issue_date, expiry_date, currency,
+			discount_percentage, subtotal, discount_amount, tax_total, total, payment_terms_days, note)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+		RETURNING id, created_at, updated_at`
+	err = tx.QueryRowContext(ctx, query,
+		quote.UserID, quote.CustomerID, quote.QuoteNumber, quote.Status, quote.IssueDate, quote.ExpiryDate, quote.Currency,
+		quote.DiscountPercentage, quote.Subtotal, quote.DiscountAmount, quote.TaxTotal, quote.Total, quote.PaymentTermsDays,
+		quote.Note).Scan(
+		&quote.ID, &quote.CreatedAt, &quote.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	// Insert quote items and taxes
+	for _, item := range quote.Items {
+		itemQuery := `
+			INSERT INTO quote_items (quote_id, description, quantity, unit_price, discount_percentage, fixed_discount)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING id`
+		err := tx.QueryRowContext(ctx, itemQuery, quote.ID, item.Description, item.Quantity, item.UnitPrice,
+			item.DiscountPercentage, item.FixedDiscount).Scan(&item.ID)
+		if err != nil {
+			return err
+		}
+
+		for position, tax := range item.Taxes {
+			taxQuery := `
+				INSERT INTO quote_item_taxes (quote_item_id, tax_rate_id, position, name, rate, inclusive, compound)
+				VALUES ($1, $2, $3, $4, $5, $6, $7)`
+			_, err := tx.ExecContext(ctx, taxQuery, item.ID, tax.ID, position, tax.Name, tax.Rate, tax.Inclusive, tax.Compound)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for _, tax := range quote.Taxes {
+		taxQuery := `
+			INSERT INTO quote_taxes (quote_id, tax_rate_id, name, rate, inclusive, compound, taxable_amount, amount)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`
+		_, err := tx.ExecContext(ctx, taxQuery, quote.ID, tax.TaxRateID, tax.Name, tax.Rate, tax.Inclusive, tax.Compound,
+			tax.TaxableAmount, tax.Amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// allocateQuoteNumber numbers a quote from its user's quote sequence. The counter row stays locked until the
+// transaction ends, so concurrent quotes are numbered one after the other.
+func allocateQuoteNumber(ctx context.Context, tx *sql.Tx, quote *models.Quote) error {
+	query := `
+		INSERT INTO quote_number_counters (user_id, current_value)
+		VALUES ($1, 1)
+		ON CONFLICT (user_id) DO UPDATE SET current_value = quote_number_counters.current_value + 1
+		RETURNING current_value`
+	var seq int64
+	err := tx.QueryRowContext(ctx, query, quote.UserID).Scan(&seq)
+	if err != nil {
+		return err
+	}
+	quote.QuoteNumber = models.FormatQuoteNumber(seq)
+	return nil
+}
+
+// GetQuoteByID returns a quote owned by userID. It returns sql.ErrNoRows if there is none.
+func (r *InvoiceRepository) GetQuoteByID(ctx context.Context, quoteID, userID int64) (*models.Quote, error) {
+	query := `SELECT ` + quoteColumns + ` FROM quotes WHERE id = $1 AND user_id = $2`
+	quote, err := scanQuote(r.db.QueryRowContext(ctx, query, quoteID, userID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.fetchQuoteDetails(ctx, []*models.Quote{quote})
+	if err != nil {
+		return nil, err
+	}
+	return quote, nil
+}
+
+// ListQuotesByUserID returns the quotes of a user, newest first, optionally only those in the given status.
+func (r *InvoiceRepository) ListQuotesByUserID(ctx context.Context, userID int64, status string) ([]*models.Quote, error) {
+	query := `
+		SELECT ` + quoteColumns + `
+		FROM quotes
+		WHERE user_id = $1 AND ($2 = '' OR status = $2)
+		ORDER BY id DESC`
+	rows, err := r.db.QueryContext(ctx, query, userID, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var quotes []*models.Quote
+	for rows.Next() {
+		quote, err := scanQuote(rows)
+		if err != nil {
+			return nil, err
+		}
+		quotes = append(quotes, quote)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	err = r.fetchQuoteDetails(ctx, quotes)
+	if err != nil {
+		return nil, err
+	}
+	return quotes, nil
+}
+
+// UpdateQuoteStatus moves a quote from one status to another. It returns sql.ErrNoRows if the quote is no
+// longer in the expected status.
+func (r *InvoiceRepository) UpdateQuoteStatus(ctx context.Context, quote *models.Quote, from string) error {
+	query := `
+		UPDATE quotes
+		SET status = $1, updated_at = NOW()
+		WHERE id = $2 AND status = $3
+		RETURNING updated_at`
+	return r.db.QueryRowContext(ctx, query, quote.Status, quote.ID, from).Scan(&quote.UpdatedAt)
+}
+
+// ExpireQuotes moves up to limit draft and sent quotes whose expiry date is before the given date to expired
+// and returns them. Rows locked by a concurrent sweep are skipped.
+func (r *InvoiceRepository) ExpireQuotes(ctx context.Context, before time.Time, limit int) ([]*models.Quote, error) {
+	var quotes []*models.Quote
+	query := `
+		UPDATE quotes
+		SET status = 'expired', updated_at = NOW()
+		WHERE id IN (
+			SELECT id FROM quotes
+			WHERE status IN ('draft', 'sent') AND expiry_date < $1
+			ORDER BY expiry_date
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, user_id, customer_id, quote_number, status, expiry_date`
+	rows, err := r.db.QueryContext(ctx, query, before, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var quote models.Quote
+		err := rows.Scan(&quote.ID, &quote.UserID, &quote.CustomerID, &quote.QuoteNumber, &quote.Status, &quote.ExpiryDate)
+		if err != nil {
+			return nil, err
+		}
+		quotes = append(quotes, &quote)
+	}
+
+	return quotes, rows.Err()
+}
+
+// CreateQuoteInvoice inserts the invoice an accepted quote converts into. The quote row is locked while the
+// invoice is inserted, so a quote is converted once at most. It returns sql.ErrNoRows if the quote is no longer
+// accepted or has already been converted.
+func (r *InvoiceRepository) CreateQuoteInvoice(ctx context.Context, quote *models.Quote, invoice *models.Invoice) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Lock the quote
+	query := `
+		SELECT id FROM quotes
+		WHERE id = $1 AND status = 'accepted' AND NOT EXISTS (SELECT 1 FROM invoices WHERE quote_id = $1)
+		FOR UPDATE`
+	var quoteID int64
+	err = tx.QueryRowContext(ctx, query, quote.ID).Scan(&quoteID)
+	if err != nil {
+		return err
+	}
+
+	invoice.QuoteID = quote.ID
+	err = insertInvoice(ctx, tx, invoice)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	quote.InvoiceID = invoice.ID
+	return nil
+}
+
+// fetchQuoteDetails loads the items, item tax snapshots and tax breakdowns of a set of quotes.
+func (r *InvoiceRepository) fetchQuoteDetails(ctx context.Context, quotes []*models.Quote) error {
+	if len(quotes) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(quotes))
+	byID := make(map[int64]*models.Quote, len(quotes))
+	for i, quote := range quotes {
+		ids[i] = quote.ID
+		byID[quote.ID] = quote
+	}
+
+	query := `
+		SELECT quote_id, id, description, quantity, unit_price, discount_percentage, fixed_discount
+		FROM quote_items
+		WHERE quote_id = ANY($1)
+		ORDER BY quote_id, id`
+	rows, err := r.db.QueryContext(ctx, query, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	itemsByID := map[int64]*models.InvoiceItem{}
+	for rows.Next() {
+		var quoteID int64
+		var item models.InvoiceItem
+		err := rows.Scan(&quoteID, &item.ID, &item.Description, &item.Quantity, &item.UnitPrice, &item.DiscountPercentage,
+			&item.FixedDiscount)
+		if err != nil {
+			return err
+		}
+		quote := byID[quoteID]
+		quote.Items = append(quote.Items, &item)
+		itemsByID[item.ID] = &item
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	// Fetch the tax snapshots of every item in one query
+	itemTaxQuery := `
+		SELECT t.quote_item_id, t.tax_rate_id, t.name, t.rate, t.inclusive, t.compound
+		FROM quote_item_taxes t
+		JOIN quote_items i ON i.id = t.quote_item_id
+		WHERE i.quote_id = ANY($1)
+		ORDER BY t.quote_item_id, t.position`
+	itemTaxRows, err := r.db.QueryContext(ctx, itemTaxQuery, ids)
+	if err != nil {
+		return err
+	}
+	defer itemTaxRows.Close()
+
+	for itemTaxRows.Next() {
+		var itemID int64
+		var tax models.TaxRate
+		err := itemTaxRows.Scan(&itemID, &tax.ID, &tax.Name, &tax.Rate, &tax.Inclusive, &tax.Compound)
+		if err != nil {
+			return err
+		}
+		if item, ok := itemsByID[itemID]; ok {
+			item.TaxRateIDs = append(item.TaxRateIDs, tax.ID)
+			item.Taxes = append(item.Taxes, &tax)
+		}
+	}
+	if err := itemTaxRows.Err(); err != nil {
+		return err
+	}
+
+	taxQuery := `
+		SELECT quote_id, tax_rate_id, name, rate, inclusive, compound, taxable_amount, amount
+		FROM quote_taxes
+		WHERE quote_id = ANY($1)
+		ORDER BY quote_id, id`
+	taxRows, err := r.db.QueryContext(ctx, taxQuery, ids)
+	if err != nil {
+		return err
+	}
+	defer taxRows.Close()
+
+	for taxRows.Next() {
+		var quoteID int64
+		var tax models.InvoiceTax
+		err := taxRows.Scan(&quoteID, &tax.TaxRateID, &tax.Name, &tax.Rate, &tax.Inclusive, &tax.Compound, &tax.TaxableAmount, &tax.Amount)
+		if err != nil {
+			return err
+		}
+		quote := byID[quoteID]
+		quote.Taxes = append(quote.Taxes, &tax)
+	}
+
+	return taxRows.Err()
+}
+
+func scanQuote(row rowScanner) (*models.Quote, error) {
+	var quote models.Quote
+	err := row.Scan(
+		&quote.ID, &quote.UserID, &quote.CustomerID, &quote.QuoteNumber, &quote.Status, &quote.IssueDate, &quote.ExpiryDate,
+		&quote.Currency, &quote.DiscountPercentage, &quote.Subtotal, &quote.DiscountAmount, &quote.TaxTotal, &quote.Total,
+		&quote.PaymentTermsDays, &quote.Note, &quote.InvoiceID, &quote.CreatedAt, &quote.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &quote, nil
+}
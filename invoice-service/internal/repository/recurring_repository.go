@@ -0,0 +1,286 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+const recurringInvoiceColumns = `
+	id, user_id, customer_id, customer_email, currency, discount_percentage, account_name, account_number, bank_name, 
+	routing_number, note, payment_terms_days, frequency, interval_days, start_date, end_date, max_occurrences, occurrences, 
+	next_run_date, auto_send, status, created_at, updated_at`
+
+func (r *InvoiceRepository) CreateRecurringInvoice(ctx context.Context, recurring *models.RecurringInvoice) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Insert recurring invoice details
+	query := `
+		INSERT INTO recurring_invoices (user_id, customer_id, customer_email, currency, discount_percentage, account_name, 
+			account_number, bank_name, routing_number, note, payment_terms_days, frequency, interval_days, start_date, end_date, 
+			max_occurrences, next_run_date, auto_send, status)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
+		RETURNING id, created_at, updated_at`
+	err = tx.QueryRowContext(ctx, query,
+		recurring.UserID, recurring.CustomerID, recurring.CustomerEmail, recurring.Currency, recurring.DiscountPercentage,
+		recurring.AccountName, recurring.AccountNumber, recurring.BankName, recurring.RoutingNumber, recurring.Note,
+		recurring.PaymentTermsDays, recurring.Frequency, recurring.IntervalDays, recurring.StartDate, recurring.EndDate,
+		recurring.MaxOccurrences, recurring.NextRunDate, recurring.AutoSend, recurring.Status).Scan(
+		&recurring.ID, &recurring.CreatedAt, &recurring.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	// Insert template items and the tax rates they reference
+	for _, item := range recurring.Items {
+		itemQuery := `
+			INSERT INTO recurring_invoice_items (recurring_invoice_id, description, quantity, unit_price, discount_percentage,
+				fixed_discount)
+			VALUES ($1, $2, $3, $4, $5, $6)
+			RETURNING id`
+		err := tx.QueryRowContext(ctx, itemQuery, recurring.ID, item.Description, item.Quantity, item.UnitPrice,
+			item.DiscountPercentage, item.FixedDiscount).Scan(&item.ID)
+		if err != nil {
+			return err
+		}
+
+		for position, taxRateID := range item.TaxRateIDs {
+			taxQuery := `
+				INSERT INTO recurring_invoice_item_taxes (recurring_invoice_item_id, tax_rate_id, position)
+				VALUES ($1, $2, $3)`
+			_, err := tx.ExecContext(ctx, taxQuery, item.ID, taxRateID, position)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetRecurringInvoiceByID returns a recurring invoice owned by userID. It returns sql.ErrNoRows if there is none.
+func (r *InvoiceRepository) GetRecurringInvoiceByID(ctx context.Context, recurringInvoiceID, userID int64) (*models.RecurringInvoice, error) {
+	query := `SELECT ` + recurringInvoiceColumns + ` FROM recurring_invoices WHERE id = $1 AND user_id = $2`
+	recurring, err := scanRecurringInvoice(r.db.QueryRowContext(ctx, query, recurringInvoiceID, userID))
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.fetchRecurringInvoiceItems(ctx, []*models.RecurringInvoice{recurring})
+	if err != nil {
+		return nil, err
+	}
+	return recurring, nil
+}
+
+func (r *InvoiceRepository) ListRecurringInvoicesByUserID(ctx context.Context, userID int64) ([]*models.RecurringInvoice, error) {
+	query := `SELECT ` + recurringInvoiceColumns + ` FROM recurring_invoices WHERE user_id = $1 ORDER BY id DESC`
+	return r.listRecurringInvoices(ctx, query, userID)
+}
+
+// ListDueRecurringInvoices returns up to limit active recurring invoices whose next run date is on or before now.
+func (r *InvoiceRepository) ListDueRecurringInvoices(ctx context.Context, now time.Time, limit int) ([]*models.RecurringInvoice, error) {
+	query := `
+		SELECT ` + recurringInvoiceColumns + ` 
+		FROM recurring_invoices 
+		WHERE status = 'active' AND next_run_date <= $1 
+		ORDER BY next_run_date, id 
+		LIMIT $2`
+	return r.listRecurringInvoices(ctx, query, now, limit)
+}
+
+// UpdateRecurringInvoiceSchedule saves the status and next run date of a recurring invoice, provided its status is
+// still from. It returns sql.ErrNoRows otherwise.
+func (r *InvoiceRepository) UpdateRecurringInvoiceSchedule(ctx context.Context, recurring *models.RecurringInvoice, from string) error {
+	query := `
+		UPDATE recurring_invoices
+		SET status = $1, next_run_date = $2, updated_at = NOW()
+		WHERE id = $3 AND user_id = $4 AND status = $5
+		RETURNING updated_at`
+	return r.db.QueryRowContext(ctx, query, recurring.Status, recurring.NextRunDate, recurring.ID, recurring.UserID, from).Scan(
+		&recurring.UpdatedAt)
+}
+
+// CreateRecurringInvoiceOccurrence inserts an invoice generated by a recurring invoice and advances the recurring
+// invoice's schedule in a single transaction. It returns sql.ErrNoRows if the recurring invoice is no longer active
+// or has already run for previousRunDate.
+func (r *InvoiceRepository) CreateRecurringInvoiceOccurrence(ctx context.Context, recurring *models.RecurringInvoice, invoice *models.Invoice, previousRunDate time.Time) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// Advance the schedule
+	query := `
+		UPDATE recurring_invoices
+		SET occurrences = $1, next_run_date = $2, status = $3, updated_at = NOW()
+		WHERE id = $4 AND status = 'active' AND next_run_date = $5
+		RETURNING updated_at`
+	err = tx.QueryRowContext(ctx, query, recurring.Occurrences, recurring.NextRunDate, recurring.Status, recurring.ID,
+		previousRunDate).Scan(&recurring.UpdatedAt)
+	if err != nil {
+		return err
+	}
+
+	err = insertInvoice(ctx, tx, invoice)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListInvoicesByRecurringInvoiceID returns the invoices generated by a recurring invoice, newest first.
+func (r *InvoiceRepository) ListInvoicesByRecurringInvoiceID(ctx context.Context, recurringInvoiceID int64) ([]*models.Invoice, error) {
+	var invoices []*models.Invoice
+	query := `
+		SELECT id, user_id, customer_id, invoice_number, status, issue_date, due_date, currency, subtotal, 
+			discount_percentage, fixed_discount, discount_amount, charge_total, tax_total, total, amount_paid, amount_credited, 
+			credit_note_total, base_currency, exchange_rate, account_name, account_number, bank_name, routing_number, note, 
+			COALESCE(recurring_invoice_id, 0), COALESCE(quote_id, 0), COALESCE(branding_profile_id, 0), version, archived_at, created_at, updated_at, late_fee_total
+		FROM invoices
+		WHERE recurring_invoice_id = $1
+		ORDER BY issue_date DESC, id DESC`
+	rows, err := r.db.QueryContext(ctx, query, recurringInvoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var invoice models.Invoice
+		err := rows.Scan(
+			&invoice.ID, &invoice.UserID, &invoice.CustomerID, &invoice.InvoiceNumber, &invoice.Status, &invoice.IssueDate,
+			&invoice.DueDate, &invoice.Currency, &invoice.Subtotal, &invoice.DiscountPercentage, &invoice.FixedDiscount, &invoice.DiscountAmount,
+			&invoice.ChargeTotal, &invoice.TaxTotal, &invoice.Total, &invoice.AmountPaid, &invoice.AmountCredited, &invoice.CreditNoteTotal,
+			&invoice.BaseCurrency, &invoice.ExchangeRate, &invoice.AccountName, &invoice.AccountNumber, &invoice.BankName,
+			&invoice.RoutingNumber, &invoice.Note, &invoice.RecurringInvoiceID, &invoice.QuoteID, &invoice.BrandingProfileID, &invoice.Version, &invoice.ArchivedAt, &invoice.CreatedAt, &invoice.UpdatedAt,
+			&invoice.LateFeeTotal,
+		)
+		if err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, &invoice)
+	}
+
+	return invoices, rows.Err()
+}
+
+func (r *InvoiceRepository) listRecurringInvoices(ctx context.Context, query string, args ...any) ([]*models.RecurringInvoice, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var recurringInvoices []*models.RecurringInvoice
+	for rows.Next() {
+		recurring, err := scanRecurringInvoice(rows)
+		if err != nil {
+			return nil, err
+		}
+		recurringInvoices = append(recurringInvoices, recurring)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	err = r.fetchRecurringInvoiceItems(ctx, recurringInvoices)
+	if err != nil {
+		return nil, err
+	}
+	return recurringInvoices, nil
+}
+
+// fetchRecurringInvoiceItems loads the items of the given recurring invoices, with their tax rate IDs in order.
+func (r *InvoiceRepository) fetchRecurringInvoiceItems(ctx context.Context, recurringInvoices []*models.RecurringInvoice) error {
+	if len(recurringInvoices) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, len(recurringInvoices))
+	byID := make(map[int64]*models.RecurringInvoice, len(recurringInvoices))
+	for i, recurring := range recurringInvoices {
+		ids[i] = recurring.ID
+		byID[recurring.ID] = recurring
+	}
+
+	query := `
+		SELECT i.recurring_invoice_id, i.id, i.description, i.quantity, i.unit_price, i.discount_percentage, i.fixed_discount,
+			t.tax_rate_id
+		FROM recurring_invoice_items i
+		LEFT JOIN recurring_invoice_item_taxes t ON t.recurring_invoice_item_id = i.id
+		WHERE i.recurring_invoice_id = ANY($1)
+		ORDER BY i.recurring_invoice_id, i.id, t.position`
+	rows, err := r.db.QueryContext(ctx, query, ids)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	var item *models.InvoiceItem
+	for rows.Next() {
+		var recurringInvoiceID, itemID int64
+		var description string
+		var quantity int32
+		var unitPrice, discountPercentage, fixedDiscount int64
+		var taxRateID sql.NullInt64
+		err := rows.Scan(&recurringInvoiceID, &itemID, &description, &quantity, &unitPrice, &discountPercentage, &fixedDiscount, &taxRateID)
+		if err != nil {
+			return err
+		}
+
+		// Rows of the same item arrive together, one per tax rate
+		if item == nil || item.ID != itemID {
+			item = &models.InvoiceItem{
+				ID:                 itemID,
+				Description:        description,
+				Quantity:           quantity,
+				UnitPrice:          unitPrice,
+				DiscountPercentage: discountPercentage,
+				FixedDiscount:      fixedDiscount,
+			}
+			recurring := byID[recurringInvoiceID]
+			recurring.Items = append(recurring.Items, item)
+		}
+		if taxRateID.Valid {
+			item.TaxRateIDs = append(item.TaxRateIDs, taxRateID.Int64)
+		}
+	}
+
+	return rows.Err()
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanRecurringInvoice(row rowScanner) (*models.RecurringInvoice, error) {
+	var recurring models.RecurringInvoice
+	err := row.Scan(
+		&recurring.ID, &recurring.UserID, &recurring.CustomerID, &recurring.CustomerEmail, &recurring.Currency,
+		&recurring.DiscountPercentage, &recurring.AccountName, &recurring.AccountNumber, &recurring.BankName,
+		&recurring.RoutingNumber, &recurring.Note, &recurring.PaymentTermsDays, &recurring.Frequency, &recurring.IntervalDays,
+		&recurring.StartDate, &recurring.EndDate, &recurring.MaxOccurrences, &recurring.Occurrences, &recurring.NextRunDate,
+		&recurring.AutoSend, &recurring.Status, &recurring.CreatedAt, &recurring.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &recurring, nil
+}
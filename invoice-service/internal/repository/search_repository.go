@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+// Options for the highlights of search results. Invoice numbers are short enough to show whole; notes and
+// item descriptions are cut down to the fragments around the matches.
+var (
+	invoiceNumberHeadline = fmt.Sprintf("StartSel=%s, StopSel=%s, HighlightAll=true", models.HighlightStart, models.HighlightStop)
+	invoiceTextHeadline   = fmt.Sprintf("StartSel=%s, StopSel=%s, MaxWords=20, MinWords=5, MaxFragments=2, FragmentDelimiter=\" … \"",
+		models.HighlightStart, models.HighlightStop)
+)
+
+// SearchInvoices returns a page of the invoices matching search, best matches first. An invoice's rank is how well
+// its number, items and note match the query, plus the rank of its customer if that matched too.
+func (r *InvoiceRepository) SearchInvoices(ctx context.Context, search models.InvoiceSearch) ([]*models.InvoiceSearchResult, error) {
+	var results []*models.InvoiceSearchResult
+
+	customerIDs := make([]int64, len(search.Customers))
+	customerRanks := make([]float32, len(search.Customers))
+	for i, customer := range search.Customers {
+		customerIDs[i] = customer.CustomerID
+		customerRanks[i] = customer.Rank
+	}
+
+	conditions := []string{"i.user_id = $1", "(i.search_vector @@ q.query OR c.customer_id IS NOT NULL)"}
+	if !search.IncludeArchived {
+		conditions = append(conditions, "i.archived_at IS NULL")
+	}
+
+	// Rank and page the matches first, so only the invoices on the page are highlighted
+	query := fmt.Sprintf(`
+		WITH page AS (
+			SELECT i.id AS invoice_id, ts_rank(i.search_vector, q.query) + COALESCE(c.rank, 0) AS rank
+			FROM invoices i
+			CROSS JOIN to_tsquery('simple', $2) AS q(query)
+			LEFT JOIN unnest($3::bigint[], $4::real[]) AS c(customer_id, rank) ON c.customer_id = i.customer_id
+			WHERE %s
+			ORDER BY rank DESC, i.id DESC
+			LIMIT $5 OFFSET $6
+		)
+		SELECT id, user_id, customer_id, invoice_number, status, issue_date, due_date, currency, subtotal, 
+			discount_percentage, fixed_discount, discount_amount, charge_total, tax_total, total, amount_paid, amount_credited, 
+			credit_note_total, base_currency, exchange_rate, account_name, account_number, bank_name, routing_number, note, 
+			COALESCE(recurring_invoice_id, 0), COALESCE(quote_id, 0), COALESCE(branding_profile_id, 0), version, archived_at, created_at, updated_at, late_fee_total,
+			page.rank,
+			ts_headline('simple', invoice_number, q.query, $7),
+			ts_headline('simple', note, q.query, $8),
+			ts_headline('simple', COALESCE((
+				SELECT string_agg(description, ' · ' ORDER BY invoice_items.id) FROM invoice_items WHERE invoice_items.invoice_id = invoices.id
+			), ''), q.query, $8)
+		FROM page
+		JOIN invoices ON invoices.id = page.invoice_id
+		CROSS JOIN to_tsquery('simple', $2) AS q(query)
+		ORDER BY page.rank DESC, invoices.id DESC`, strings.Join(conditions, " AND "))
+
+	rows, err := r.db.QueryContext(ctx, query, search.UserID, search.Query, customerIDs, customerRanks,
+		search.Limit, search.Offset, invoiceNumberHeadline, invoiceTextHeadline)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var invoices []*models.Invoice
+	for rows.Next() {
+		var invoice models.Invoice
+		var result models.InvoiceSearchResult
+		err := rows.Scan(
+			&invoice.ID, &invoice.UserID, &invoice.CustomerID, &invoice.InvoiceNumber, &invoice.Status, &invoice.IssueDate,
+			&invoice.DueDate, &invoice.Currency, &invoice.Subtotal, &invoice.DiscountPercentage, &invoice.FixedDiscount, &invoice.DiscountAmount,
+			&invoice.ChargeTotal, &invoice.TaxTotal, &invoice.Total, &invoice.AmountPaid, &invoice.AmountCredited, &invoice.CreditNoteTotal,
+			&invoice.BaseCurrency, &invoice.ExchangeRate, &invoice.AccountName, &invoice.AccountNumber, &invoice.BankName,
+			&invoice.RoutingNumber, &invoice.Note, &invoice.RecurringInvoiceID, &invoice.QuoteID, &invoice.BrandingProfileID, &invoice.Version, &invoice.ArchivedAt, &invoice.CreatedAt, &invoice.UpdatedAt,
+			&invoice.LateFeeTotal,
+			&result.Rank, &result.Highlights.InvoiceNumber, &result.Highlights.Note, &result.Highlights.Items,
+		)
+		if err != nil {
+			return nil, err
+		}
+		result.Invoice = &invoice
+		invoices = append(invoices, &invoice)
+		results = append(results, &result)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	// Fetch the items and taxes of the whole page at once
+	err = r.fetchInvoiceDetails(ctx, invoices)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
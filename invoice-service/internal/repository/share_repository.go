@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+func (r *InvoiceRepository) CreateInvoiceShare(ctx context.Context, share *models.InvoiceShare) error {
+	query := `
+		INSERT INTO invoice_shares (invoice_id, user_id, expires_at)
+		VALUES ($1, $2, $3)
+		RETURNING id, created_at`
+	return r.db.QueryRowContext(ctx, query, share.InvoiceID, share.UserID, share.ExpiresAt).Scan(&share.ID, &share.CreatedAt)
+}
+
+func (r *InvoiceRepository) GetInvoiceShareByID(ctx context.Context, shareID int64) (*models.InvoiceShare, error) {
+	var share models.InvoiceShare
+	query := `
+		SELECT id, invoice_id, user_id, expires_at, revoked_at, view_count, last_viewed_at, created_at
+		FROM invoice_shares
+		WHERE id = $1`
+	err := r.db.QueryRowContext(ctx, query, shareID).Scan(&share.ID, &share.InvoiceID, &share.UserID, &share.ExpiresAt,
+		&share.RevokedAt, &share.ViewCount, &share.LastViewedAt, &share.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &share, nil
+}
+
+// ListInvoiceSharesByInvoiceID returns the share links of an invoice, newest first.
+func (r *InvoiceRepository) ListInvoiceSharesByInvoiceID(ctx context.Context, invoiceID int64) ([]*models.InvoiceShare, error) {
+	var shares []*models.InvoiceShare
+	query := `
+		SELECT id, invoice_id, user_id, expires_at, revoked_at, view_count, last_viewed_at, created_at
+		FROM invoice_shares
+		WHERE invoice_id = $1
+		ORDER BY id DESC`
+	rows, err := r.db.QueryContext(ctx, query, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var share models.InvoiceShare
+		err := rows.Scan(&share.ID, &share.InvoiceID, &share.UserID, &share.ExpiresAt, &share.RevokedAt,
+			&share.ViewCount, &share.LastViewedAt, &share.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, &share)
+	}
+	return shares, rows.Err()
+}
+
+// RevokeInvoiceShare marks a share link revoked. It returns sql.ErrNoRows if the link was already revoked.
+func (r *InvoiceRepository) RevokeInvoiceShare(ctx context.Context, share *models.InvoiceShare) error {
+	query := `
+		UPDATE invoice_shares
+		SET revoked_at = NOW()
+		WHERE id = $1 AND revoked_at IS NULL
+		RETURNING revoked_at`
+	return r.db.QueryRowContext(ctx, query, share.ID).Scan(&share.RevokedAt)
+}
+
+// RecordInvoiceShareView counts a view of a share link of an invoice.
+func (r *InvoiceRepository) RecordInvoiceShareView(ctx context.Context, share *models.InvoiceShare, invoice *models.Invoice) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE invoice_shares
+		SET view_count = view_count + 1, last_viewed_at = NOW()
+		WHERE id = $1
+		RETURNING view_count, last_viewed_at`
+	err = tx.QueryRowContext(ctx, query, share.ID).Scan(&share.ViewCount, &share.LastViewedAt)
+	if err != nil {
+		return err
+	}
+
+	err = insertActivities(ctx, tx, invoice.ID, invoice.UserID, &invoice.PendingActivities)
+	if err != nil {
+		return err
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return err
+	}
+	return nil
+}
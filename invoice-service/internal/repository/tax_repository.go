@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+func (r *InvoiceRepository) CreateTaxRate(ctx context.Context, taxRate *models.TaxRate) error {
+	query := `
+		INSERT INTO tax_rates (user_id, name, rate, inclusive, compound)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at, updated_at`
+	return r.db.QueryRowContext(ctx, query, taxRate.UserID, taxRate.Name, taxRate.Rate, taxRate.Inclusive, taxRate.Compound).Scan(
+		&taxRate.ID, &taxRate.CreatedAt, &taxRate.UpdatedAt)
+}
+
+// GetTaxRatesByIDs returns the tax rates with the given IDs that belong to the user.
+func (r *InvoiceRepository) GetTaxRatesByIDs(ctx context.Context, userID int64, taxRateIDs []int64) ([]*models.TaxRate, error) {
+	query := `
+		SELECT id, user_id, name, rate, inclusive, compound, created_at, updated_at
+		FROM tax_rates
+		WHERE user_id = $1 AND id = ANY($2)`
+	rows, err := r.db.QueryContext(ctx, query, userID, taxRateIDs)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanTaxRates(rows)
+}
+
+func (r *InvoiceRepository) ListTaxRatesByUserID(ctx context.Context, userID int64) ([]*models.TaxRate, error) {
+	query := `
+		SELECT id, user_id, name, rate, inclusive, compound, created_at, updated_at
+		FROM tax_rates
+		WHERE user_id = $1
+		ORDER BY name, id`
+	rows, err := r.db.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	return scanTaxRates(rows)
+}
+
+// UpdateTaxRate updates a tax rate owned by taxRate.UserID. It returns sql.ErrNoRows if there is no such tax rate.
+func (r *InvoiceRepository) UpdateTaxRate(ctx context.Context, taxRate *models.TaxRate) error {
+	query := `
+		UPDATE tax_rates
+		SET name = $1, rate = $2, inclusive = $3, compound = $4, updated_at = NOW()
+		WHERE id = $5 AND user_id = $6
+		RETURNING created_at, updated_at`
+	return r.db.QueryRowContext(ctx, query, taxRate.Name, taxRate.Rate, taxRate.Inclusive, taxRate.Compound, taxRate.ID,
+		taxRate.UserID).Scan(&taxRate.CreatedAt, &taxRate.UpdatedAt)
+}
+
+// DeleteTaxRate deletes a tax rate owned by userID. It returns sql.ErrNoRows if there is no such tax rate.
+func (r *InvoiceRepository) DeleteTaxRate(ctx context.Context, taxRateID, userID int64) error {
+	query := `DELETE FROM tax_rates WHERE id = $1 AND user_id = $2`
+	result, err := r.db.ExecContext(ctx, query, taxRateID, userID)
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func scanTaxRates(rows *sql.Rows) ([]*models.TaxRate, error) {
+	var taxRates []*models.TaxRate
+	for rows.Next() {
+		var taxRate models.TaxRate
+		err := rows.Scan(&taxRate.ID, &taxRate.UserID, &taxRate.Name, &taxRate.Rate, &taxRate.Inclusive, &taxRate.Compound,
+			&taxRate.CreatedAt, &taxRate.UpdatedAt)
+		if err != nil {
+			return nil, err
+		}
+		taxRates = append(taxRates, &taxRate)
+	}
+	return taxRates, rows.Err()
+}
@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+const (
+	// MaxAttachmentSize is the largest file that can be attached to an invoice, in bytes.
+	MaxAttachmentSize = 5 << 20
+	// MaxInvoiceAttachments is how many files can be attached to one invoice.
+	MaxInvoiceAttachments = 10
+	// MaxInvoiceAttachmentsSize is the combined size of an invoice's attachments, in bytes, kept small enough for
+	// them all to go out with the invoice email.
+	MaxInvoiceAttachmentsSize = 10 << 20
+)
+
+// attachmentTypes maps the content types an attachment may have to the type its contents must be detected as,
+// so a file can't be uploaded under a type it isn't. Office documents are detected as the zip archives they are.
+var attachmentTypes = map[string]string{
+	"application/pdf": "application/pdf",
+	"image/png":       "image/png",
+	"image/jpeg":      "image/jpeg",
+	"text/plain":      "text/plain",
+	"text/csv":        "text/plain",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document": "application/zip",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet":       "application/zip",
+}
+
+var errBlobStoreMissing = errors.New("no attachment store is configured")
+
+// AddAttachment attaches a file to one of the user's invoices. The contents go to the blob store and their
+// metadata and SHA-256 checksum to the repository.
+func (s *InvoiceService) AddAttachment(ctx context.Context, invoiceID, userID int64, filename, contentType string, content []byte) (*models.Attachment, error) {
+	if s.blobs == nil {
+		return nil, errBlobStoreMissing
+	}
+
+	invoice, err := s.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.UserID != userID {
+		return nil, ErrNotFound
+	}
+
+	filename, err = cleanAttachmentFilename(filename)
+	if err != nil {
+		return nil, err
+	}
+	contentType, err = checkAttachmentContent(contentType, content)
+	if err != nil {
+		return nil, err
+	}
+
+	key := make([]byte, 16)
+	_, err = rand.Read(key)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum := sha256.Sum256(content)
+	attachment := &models.Attachment{
+		InvoiceID:   invoiceID,
+		UserID:      userID,
+		Filename:    filename,
+		ContentType: contentType,
+		Size:        int64(len(content)),
+		Checksum:    hex.EncodeToString(checksum[:]),
+		StorageKey:  fmt.Sprintf("invoices/%d/%s", invoiceID, hex.EncodeToString(key)),
+	}
+
+	// Store the contents first, so a saved attachment always has them
+	err = s.blobs.Put(ctx, attachment.StorageKey, content)
+	if err != nil {
+		return nil, err
+	}
+	err = s.repo.CreateAttachment(ctx, attachment, MaxInvoiceAttachments, MaxInvoiceAttachmentsSize)
+	if err != nil {
+		_ = s.blobs.Delete(ctx, attachment.StorageKey)
+		// The invoice has no room for the attachment
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: an invoice can have up to %d attachments of %d MB in total",
+				ErrInvalidRequest, MaxInvoiceAttachments, MaxInvoiceAttachmentsSize>>20)
+		}
+		return nil, err
+	}
+	return attachment, nil
+}
+
+// ListAttachments returns the attachments of one of the user's invoices, without their contents.
+func (s *InvoiceService) ListAttachments(ctx context.Context, invoiceID, userID int64) ([]*models.Attachment, error) {
+	invoice, err := s.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.UserID != userID {
+		return nil, ErrNotFound
+	}
+	return s.repo.ListAttachmentsByInvoiceID(ctx, invoiceID)
+}
+
+// GetAttachment returns one of the user's attachments with its contents.
+func (s *InvoiceService) GetAttachment(ctx context.Context, attachmentID, userID int64) (*models.Attachment, error) {
+	attachment, err := s.getAttachment(ctx, attachmentID, userID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.loadAttachmentContent(ctx, attachment)
+	if err != nil {
+		return nil, err
+	}
+	return attachment, nil
+}
+
+// DeleteAttachment removes one of the user's attachments and its contents.
+func (s *InvoiceService) DeleteAttachment(ctx context.Context, attachmentID, userID int64) (*models.Attachment, error) {
+	attachment, err := s.getAttachment(ctx, attachmentID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.DeleteAttachment(ctx, attachmentID)
+	if err != nil {
+		// Another request deleted it first
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	// The attachment is gone once its row is, so contents left behind by a failed delete are only wasted space
+	if s.blobs != nil {
+		_ = s.blobs.Delete(ctx, attachment.StorageKey)
+	}
+	return attachment, nil
+}
+
+// LoadInvoiceAttachments returns the attachments of an invoice with their contents, to send with the invoice.
+func (s *InvoiceService) LoadInvoiceAttachments(ctx context.Context, invoiceID int64) ([]*models.Attachment, error) {
+	attachments, err := s.repo.ListAttachmentsByInvoiceID(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, attachment := range attachments {
+		err = s.loadAttachmentContent(ctx, attachment)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return attachments, nil
+}
+
+func (s *InvoiceService) getAttachment(ctx context.Context, attachmentID, userID int64) (*models.Attachment, error) {
+	attachment, err := s.repo.GetAttachmentByID(ctx, attachmentID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if attachment.UserID != userID {
+		return nil, ErrNotFound
+	}
+	return attachment, nil
+}
+
+// loadAttachmentContent reads the contents of an attachment from the blob store and checks them against the
+// stored checksum.
+func (s *InvoiceService) loadAttachmentContent(ctx context.Context, attachment *models.Attachment) error {
+	if s.blobs == nil {
+		return errBlobStoreMissing
+	}
+	content, err := s.blobs.Get(ctx, attachment.StorageKey)
+	if err != nil {
+		return fmt.Errorf("failed to read attachment %d: %w", attachment.ID, err)
+	}
+	checksum := sha256.Sum256(content)
+	if hex.EncodeToString(checksum[:]) != attachment.Checksum {
+		return fmt.Errorf("attachment %d does not match its checksum", attachment.ID)
+	}
+	attachment.Content = content
+	return nil
+}
+
+// cleanAttachmentFilename strips any directories from an uploaded file name and checks what is left is usable.
+func cleanAttachmentFilename(filename string) (string, error) {
+	if i := strings.LastIndexAny(filename, `/\`); i >= 0 {
+		filename = filename[i+1:]
+	}
+	filename = strings.TrimSpace(filename)
+
+	switch {
+	case filename == "", filename == ".", filename == "..":
+		return "", fmt.Errorf("%w: the attachment needs a file name", ErrInvalidRequest)
+	case len(filename) > 255:
+		return "", fmt.Errorf("%w: the file name is longer than 255 bytes", ErrInvalidRequest)
+	case strings.IndexFunc(filename, unicode.IsControl) >= 0:
+		return "", fmt.Errorf("%w: the file name contains control characters", ErrInvalidRequest)
+	}
+	return filename, nil
+}
+
+// checkAttachmentContent checks the size of an attachment and that its contents match an allowed content type.
+// It returns the content type without parameters.
+func checkAttachmentContent(contentType string, content []byte) (string, error) {
+	switch {
+	case len(content) == 0:
+		return "", fmt.Errorf("%w: the attachment is empty", ErrInvalidRequest)
+	case len(content) > MaxAttachmentSize:
+		return "", fmt.Errorf("%w: attachments can be up to %d MB", ErrInvalidRequest, MaxAttachmentSize>>20)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid content type %q", ErrInvalidRequest, contentType)
+	}
+	want, ok := attachmentTypes[mediaType]
+	if !ok {
+		return "", fmt.Errorf("%w: %s files cannot be attached", ErrInvalidRequest, mediaType)
+	}
+	detected, _, _ := mime.ParseMediaType(http.DetectContentType(content))
+	if detected != want {
+		return "", fmt.Errorf("%w: the contents are not %s", ErrInvalidRequest, mediaType)
+	}
+	return mediaType, nil
+}
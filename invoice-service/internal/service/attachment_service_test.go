@@ -0,0 +1,197 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/emzola/numer/invoice-service/internal/service/blob"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var pdfContent = []byte("%PDF-1.4\n%test attachment\n")
+
+func newAttachmentService(t *testing.T) (*service.InvoiceService, *MockInvoiceRepository, *blob.LocalStore) {
+	t.Helper()
+
+	store, err := blob.NewLocalStore(t.TempDir())
+	assert.NoError(t, err)
+
+	mockRepo := new(MockInvoiceRepository)
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(&models.Invoice{ID: 1, UserID: 1, Status: models.StatusDraft}, nil).Maybe()
+	return service.NewInvoiceService(mockRepo, service.WithBlobStore(store)), mockRepo, store
+}
+
+func TestAddAttachment(t *testing.T) {
+	svc, mockRepo, store := newAttachmentService(t)
+
+	mockRepo.On("CreateAttachment", mock.Anything, mock.Anything, service.MaxInvoiceAttachments, int64(service.MaxInvoiceAttachmentsSize)).Run(func(args mock.Arguments) {
+		args.Get(1).(*models.Attachment).ID = 3
+	}).Return(nil)
+
+	attachment, err := svc.AddAttachment(context.Background(), 1, 1, `C:\Users\me\receipt.pdf`, "application/pdf; name=receipt.pdf", pdfContent)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), attachment.ID)
+	assert.Equal(t, "receipt.pdf", attachment.Filename)
+	assert.Equal(t, "application/pdf", attachment.ContentType)
+	assert.Equal(t, int64(len(pdfContent)), attachment.Size)
+	assert.Len(t, attachment.Checksum, 64)
+
+	// The contents are in the store under the attachment's key
+	stored, err := store.Get(context.Background(), attachment.StorageKey)
+	assert.NoError(t, err)
+	assert.Equal(t, pdfContent, stored)
+
+	// and come back with the attachment
+	mockRepo.On("GetAttachmentByID", mock.Anything, int64(3)).Return(attachment, nil)
+
+	got, err := svc.GetAttachment(context.Background(), 3, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, pdfContent, got.Content)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestAddAttachmentInvalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		userID      int64
+		filename    string
+		contentType string
+		content     []byte
+		wantErr     error
+	}{
+		{
+			name:        "other user's invoice",
+			userID:      2,
+			filename:    "receipt.pdf",
+			contentType: "application/pdf",
+			content:     pdfContent,
+			wantErr:     service.ErrNotFound,
+		},
+		{
+			name:        "empty file",
+			filename:    "receipt.pdf",
+			contentType: "application/pdf",
+			wantErr:     service.ErrInvalidRequest,
+		},
+		{
+			name:        "too large",
+			filename:    "receipt.pdf",
+			contentType: "application/pdf",
+			content:     append(append([]byte{}, pdfContent...), bytes.Repeat([]byte{0}, service.MaxAttachmentSize)...),
+			wantErr:     service.ErrInvalidRequest,
+		},
+		{
+			name:        "disallowed type",
+			filename:    "script.html",
+			contentType: "text/html",
+			content:     []byte("<html></html>"),
+			wantErr:     service.ErrInvalidRequest,
+		},
+		{
+			name:        "contents don't match the type",
+			filename:    "receipt.pdf",
+			contentType: "application/pdf",
+			content:     []byte("<html><script></script></html>"),
+			wantErr:     service.ErrInvalidRequest,
+		},
+		{
+			name:        "no file name",
+			filename:    "uploads/",
+			contentType: "application/pdf",
+			content:     pdfContent,
+			wantErr:     service.ErrInvalidRequest,
+		},
+		{
+			name:        "control characters in file name",
+			filename:    "receipt\r\n.pdf",
+			contentType: "application/pdf",
+			content:     pdfContent,
+			wantErr:     service.ErrInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, mockRepo, _ := newAttachmentService(t)
+
+			userID := tt.userID
+			if userID == 0 {
+				userID = 1
+			}
+			_, err := svc.AddAttachment(context.Background(), 1, userID, tt.filename, tt.contentType, tt.content)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			mockRepo.AssertNotCalled(t, "CreateAttachment", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestAddAttachmentOverLimit(t *testing.T) {
+	svc, mockRepo, store := newAttachmentService(t)
+
+	var key string
+	mockRepo.On("CreateAttachment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		key = args.Get(1).(*models.Attachment).StorageKey
+	}).Return(sql.ErrNoRows)
+
+	_, err := svc.AddAttachment(context.Background(), 1, 1, "receipt.pdf", "application/pdf", pdfContent)
+
+	assert.ErrorIs(t, err, service.ErrInvalidRequest)
+
+	// The stored contents are cleaned up
+	_, err = store.Get(context.Background(), key)
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+}
+
+func TestGetAttachmentChecksumMismatch(t *testing.T) {
+	svc, mockRepo, store := newAttachmentService(t)
+
+	attachment := &models.Attachment{ID: 3, InvoiceID: 1, UserID: 1, Checksum: "0000", StorageKey: "invoices/1/key"}
+	assert.NoError(t, store.Put(context.Background(), attachment.StorageKey, pdfContent))
+	mockRepo.On("GetAttachmentByID", mock.Anything, int64(3)).Return(attachment, nil)
+
+	_, err := svc.GetAttachment(context.Background(), 3, 1)
+	assert.Error(t, err)
+
+	// Another user's attachment is not found
+	_, err = svc.GetAttachment(context.Background(), 3, 2)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+}
+
+func TestDeleteInvoiceDeletesAttachments(t *testing.T) {
+	svc, mockRepo, store := newAttachmentService(t)
+
+	attachment := &models.Attachment{ID: 3, InvoiceID: 1, UserID: 1, StorageKey: "invoices/1/key"}
+	assert.NoError(t, store.Put(context.Background(), attachment.StorageKey, pdfContent))
+	mockRepo.On("ListAttachmentsByInvoiceID", mock.Anything, int64(1)).Return([]*models.Attachment{attachment}, nil)
+	mockRepo.On("DeleteInvoice", mock.Anything, mock.AnythingOfType("*models.Invoice")).Return(nil)
+
+	_, err := svc.DeleteInvoice(context.Background(), 1, 1)
+
+	assert.NoError(t, err)
+	_, err = store.Get(context.Background(), attachment.StorageKey)
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDeleteAttachment(t *testing.T) {
+	svc, mockRepo, store := newAttachmentService(t)
+
+	attachment := &models.Attachment{ID: 3, InvoiceID: 1, UserID: 1, StorageKey: "invoices/1/key"}
+	assert.NoError(t, store.Put(context.Background(), attachment.StorageKey, pdfContent))
+	mockRepo.On("GetAttachmentByID", mock.Anything, int64(3)).Return(attachment, nil)
+	mockRepo.On("DeleteAttachment", mock.Anything, int64(3)).Return(nil)
+
+	_, err := svc.DeleteAttachment(context.Background(), 3, 1)
+
+	assert.NoError(t, err)
+	_, err = store.Get(context.Background(), attachment.StorageKey)
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,39 @@
+package blob_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/emzola/numer/invoice-service/internal/service/blob"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	store, err := blob.NewLocalStore(t.TempDir())
+	assert.NoError(t, err)
+
+	err = store.Put(ctx, "invoices/1/a", []byte("timesheet"))
+	assert.NoError(t, err)
+
+	data, err := store.Get(ctx, "invoices/1/a")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("timesheet"), data)
+
+	err = store.Delete(ctx, "invoices/1/a")
+	assert.NoError(t, err)
+	_, err = store.Get(ctx, "invoices/1/a")
+	assert.ErrorIs(t, err, blob.ErrNotFound)
+
+	// Deleting again is not an error
+	assert.NoError(t, store.Delete(ctx, "invoices/1/a"))
+}
+
+func TestLocalStoreRejectsKeysOutsideRoot(t *testing.T) {
+	store, err := blob.NewLocalStore(t.TempDir())
+	assert.NoError(t, err)
+
+	for _, key := range []string{"../secret", "/etc/passwd", "invoices/../../secret", ""} {
+		assert.Error(t, store.Put(context.Background(), key, []byte("x")), key)
+	}
+}
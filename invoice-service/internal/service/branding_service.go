@@ -0,0 +1,213 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/jpeg" // Register the logo formats with image.DecodeConfig
+	_ "image/png"
+	"mime"
+	"regexp"
+	"strings"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+const (
+	// MaxBrandingLogoSize is the largest logo a branding profile can have, in bytes. Logos are embedded in every
+	// PDF, shared invoice page and email, so they are kept small.
+	MaxBrandingLogoSize = 256 << 10
+	// maxBrandingLogoDimension is the widest and tallest a logo can be, in pixels.
+	maxBrandingLogoDimension = 2000
+
+	maxBrandingNameLength        = 100
+	maxBrandingFooterLength      = 200
+	maxPaymentInstructionsLength = 1000
+	maxBrandingDefaultNoteLength = 2000
+)
+
+// brandingLogoFormats maps the content types a logo may have to the format image.DecodeConfig must detect.
+var brandingLogoFormats = map[string]string{
+	"image/png":  "png",
+	"image/jpeg": "jpeg",
+}
+
+var accentColorPattern = regexp.MustCompile(`^#[0-9a-f]{6}$`)
+
+func (s *InvoiceService) CreateBrandingProfile(ctx context.Context, profile *models.BrandingProfile) (*models.BrandingProfile, error) {
+	err := validateBrandingProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.CreateBrandingProfile(ctx, profile)
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// GetBrandingProfile returns one of the user's branding profiles with its logo.
+func (s *InvoiceService) GetBrandingProfile(ctx context.Context, profileID, userID int64) (*models.BrandingProfile, error) {
+	if profileID == 0 {
+		return nil, ErrNotFound
+	}
+	profile, err := s.repo.GetBrandingProfile(ctx, userID, profileID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return profile, nil
+}
+
+// ListBrandingProfiles returns the user's branding profiles without their logos.
+func (s *InvoiceService) ListBrandingProfiles(ctx context.Context, userID int64) ([]*models.BrandingProfile, error) {
+	return s.repo.ListBrandingProfilesByUserID(ctx, userID)
+}
+
+// UpdateBrandingProfile changes a branding profile, keeping its logo. Invoices are rendered with their profile
+// as it is at the time, so the change shows on every invoice using it.
+func (s *InvoiceService) UpdateBrandingProfile(ctx context.Context, profile *models.BrandingProfile) (*models.BrandingProfile, error) {
+	err := validateBrandingProfile(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.UpdateBrandingProfile(ctx, profile)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return profile, nil
+}
+
+// DeleteBrandingProfile deletes one of the user's branding profiles and returns it. Invoices that used it fall
+// back to the user's default profile.
+func (s *InvoiceService) DeleteBrandingProfile(ctx context.Context, profileID, userID int64) (*models.BrandingProfile, error) {
+	profile, err := s.GetBrandingProfile(ctx, profileID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.DeleteBrandingProfile(ctx, profileID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return profile, nil
+}
+
+// SetBrandingProfileLogo replaces the logo of one of the user's branding profiles with a PNG or JPEG image, or
+// removes it if logo is empty.
+func (s *InvoiceService) SetBrandingProfileLogo(ctx context.Context, profileID, userID int64, contentType string, logo []byte) (*models.BrandingProfile, error) {
+	profile := &models.BrandingProfile{ID: profileID, UserID: userID}
+	if len(logo) > 0 {
+		mediaType, err := checkBrandingLogo(contentType, logo)
+		if err != nil {
+			return nil, err
+		}
+		profile.LogoContentType = mediaType
+		profile.Logo = logo
+	}
+
+	err := s.repo.SetBrandingProfileLogo(ctx, profile)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return profile, nil
+}
+
+// InvoiceBranding returns the branding profile an invoice is rendered with, along with its logo: the profile the
+// invoice picked, or else the user's default profile. It returns nil if the invoice has no branding.
+func (s *InvoiceService) InvoiceBranding(ctx context.Context, invoice *models.Invoice) (*models.BrandingProfile, error) {
+	profile, err := s.repo.GetBrandingProfile(ctx, invoice.UserID, invoice.BrandingProfileID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return profile, nil
+}
+
+// applyBrandingProfile checks that a new invoice picks one of its user's branding profiles, if it picks one,
+// and gives it the profile's default note if it has none. Invoices without a profile use the user's default.
+func (s *InvoiceService) applyBrandingProfile(ctx context.Context, invoice *models.Invoice) error {
+	if invoice.BrandingProfileID == 0 && strings.TrimSpace(invoice.Note) != "" {
+		return nil
+	}
+
+	profile, err := s.repo.GetBrandingProfile(ctx, invoice.UserID, invoice.BrandingProfileID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			if invoice.BrandingProfileID == 0 {
+				return nil
+			}
+			return fmt.Errorf("%w: unknown branding profile %d", ErrInvalidRequest, invoice.BrandingProfileID)
+		}
+		return err
+	}
+	if strings.TrimSpace(invoice.Note) == "" {
+		invoice.Note = profile.DefaultNote
+	}
+	return nil
+}
+
+func validateBrandingProfile(profile *models.BrandingProfile) error {
+	profile.Name = strings.TrimSpace(profile.Name)
+	profile.AccentColor = strings.ToLower(strings.TrimSpace(profile.AccentColor))
+	profile.Footer = strings.TrimSpace(profile.Footer)
+	profile.PaymentInstructions = strings.TrimSpace(profile.PaymentInstructions)
+	switch {
+	case profile.Name == "":
+		return fmt.Errorf("%w: branding profile name is required", ErrInvalidRequest)
+	case len(profile.Name) > maxBrandingNameLength:
+		return fmt.Errorf("%w: branding profile names can be up to %d characters", ErrInvalidRequest, maxBrandingNameLength)
+	case profile.AccentColor != "" && !accentColorPattern.MatchString(profile.AccentColor):
+		return fmt.Errorf("%w: accent colour must be a hex colour such as #1a73e8", ErrInvalidRequest)
+	case len(profile.Footer) > maxBrandingFooterLength:
+		return fmt.Errorf("%w: footers can be up to %d characters", ErrInvalidRequest, maxBrandingFooterLength)
+	case len(profile.PaymentInstructions) > maxPaymentInstructionsLength:
+		return fmt.Errorf("%w: payment instructions can be up to %d characters", ErrInvalidRequest, maxPaymentInstructionsLength)
+	case len(profile.DefaultNote) > maxBrandingDefaultNoteLength:
+		return fmt.Errorf("%w: default notes can be up to %d characters", ErrInvalidRequest, maxBrandingDefaultNoteLength)
+	}
+	return nil
+}
+
+// checkBrandingLogo checks that a logo is a PNG or JPEG image of a sensible size whose contents match its
+// content type, and returns the content type without parameters.
+func checkBrandingLogo(contentType string, logo []byte) (string, error) {
+	if len(logo) > MaxBrandingLogoSize {
+		return "", fmt.Errorf("%w: logos can be up to %d KB", ErrInvalidRequest, MaxBrandingLogoSize>>10)
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid content type %q", ErrInvalidRequest, contentType)
+	}
+	want, ok := brandingLogoFormats[mediaType]
+	if !ok {
+		return "", fmt.Errorf("%w: logos must be PNG or JPEG images", ErrInvalidRequest)
+	}
+	config, format, err := image.DecodeConfig(bytes.NewReader(logo))
+	if err != nil || format != want {
+		return "", fmt.Errorf("%w: the contents are not %s", ErrInvalidRequest, mediaType)
+	}
+	if config.Width > maxBrandingLogoDimension || config.Height > maxBrandingLogoDimension {
+		return "", fmt.Errorf("%w: logos can be up to %d by %d pixels", ErrInvalidRequest, maxBrandingLogoDimension, maxBrandingLogoDimension)
+	}
+	return mediaType, nil
+}
@@ -0,0 +1,205 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"image"
+	"image/png"
+	"strings"
+	"testing"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateBrandingProfile(t *testing.T) {
+	tests := []struct {
+		name       string
+		profile    *models.BrandingProfile
+		wantAccent string
+		wantErr    error
+	}{
+		{"valid", &models.BrandingProfile{UserID: 1, Name: "Studio", AccentColor: " #1A73E8 "}, "#1a73e8", nil},
+		{"no accent colour", &models.BrandingProfile{UserID: 1, Name: "Studio"}, "", nil},
+		{"missing name", &models.BrandingProfile{UserID: 1, Name: " "}, "", service.ErrInvalidRequest},
+		{"long name", &models.BrandingProfile{UserID: 1, Name: strings.Repeat("a", 101)}, "", service.ErrInvalidRequest},
+		{"named colour", &models.BrandingProfile{UserID: 1, Name: "Studio", AccentColor: "blue"}, "", service.ErrInvalidRequest},
+		{"short colour", &models.BrandingProfile{UserID: 1, Name: "Studio", AccentColor: "#fff"}, "", service.ErrInvalidRequest},
+		{"long footer", &models.BrandingProfile{UserID: 1, Name: "Studio", Footer: strings.Repeat("a", 201)}, "", service.ErrInvalidRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+			if tt.wantErr == nil {
+				mockRepo.On("CreateBrandingProfile", mock.Anything, tt.profile).Return(nil)
+			}
+
+			profile, err := svc.CreateBrandingProfile(context.Background(), tt.profile)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantAccent, profile.AccentColor)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGetBrandingProfileNotFound(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	mockRepo.On("GetBrandingProfile", mock.Anything, int64(1), int64(2)).Return(nil, sql.ErrNoRows)
+
+	_, err := svc.GetBrandingProfile(context.Background(), 2, 1)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+
+	// Zero means the default profile to the repository, but not to callers
+	_, err = svc.GetBrandingProfile(context.Background(), 0, 1)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSetBrandingProfileLogo(t *testing.T) {
+	logo := encodePNG(t, 40, 20)
+
+	tests := []struct {
+		name            string
+		contentType     string
+		logo            []byte
+		wantContentType string
+		wantErr         error
+	}{
+		{"png", "image/png", logo, "image/png", nil},
+		{"content type with parameters", "image/png; name=logo.png", logo, "image/png", nil},
+		{"remove", "", nil, "", nil},
+		{"wrong content type", "image/jpeg", logo, "", service.ErrInvalidRequest},
+		{"unsupported type", "image/gif", []byte("GIF89a"), "", service.ErrInvalidRequest},
+		{"not an image", "image/png", []byte("not a png"), "", service.ErrInvalidRequest},
+		{"too large", "image/png", make([]byte, service.MaxBrandingLogoSize+1), "", service.ErrInvalidRequest},
+		{"too many pixels", "image/png", encodePNG(t, 2001, 1), "", service.ErrInvalidRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+			if tt.wantErr == nil {
+				mockRepo.On("SetBrandingProfileLogo", mock.Anything, mock.MatchedBy(func(profile *models.BrandingProfile) bool {
+					return profile.ID == 2 && profile.UserID == 1 && profile.LogoContentType == tt.wantContentType && bytes.Equal(profile.Logo, tt.logo)
+				})).Return(nil)
+			}
+
+			_, err := svc.SetBrandingProfileLogo(context.Background(), 2, 1, tt.contentType, tt.logo)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateInvoiceBranding(t *testing.T) {
+	studio := &models.BrandingProfile{ID: 3, UserID: 1, Name: "Studio", DefaultNote: "Thanks for your business"}
+
+	tests := []struct {
+		name     string
+		invoice  *models.Invoice
+		setup    func(*MockInvoiceRepository)
+		wantNote string
+		wantErr  error
+	}{
+		{
+			name:    "default profile note",
+			invoice: &models.Invoice{UserID: 1, Currency: "USD"},
+			setup: func(m *MockInvoiceRepository) {
+				m.On("GetBrandingProfile", mock.Anything, int64(1), int64(0)).Return(studio, nil)
+			},
+			wantNote: "Thanks for your business",
+		},
+		{
+			name:     "own note",
+			invoice:  &models.Invoice{UserID: 1, Currency: "USD", Note: "Net 30"},
+			wantNote: "Net 30",
+		},
+		{
+			name:    "picked profile",
+			invoice: &models.Invoice{UserID: 1, Currency: "USD", BrandingProfileID: 3, Note: "Net 30"},
+			setup: func(m *MockInvoiceRepository) {
+				m.On("GetBrandingProfile", mock.Anything, int64(1), int64(3)).Return(studio, nil)
+			},
+			wantNote: "Net 30",
+		},
+		{
+			name:    "no profiles",
+			invoice: &models.Invoice{UserID: 1, Currency: "USD"},
+			setup: func(m *MockInvoiceRepository) {
+				m.On("GetBrandingProfile", mock.Anything, int64(1), int64(0)).Return(nil, sql.ErrNoRows)
+			},
+		},
+		{
+			name:    "unknown profile",
+			invoice: &models.Invoice{UserID: 1, Currency: "USD", BrandingProfileID: 9},
+			setup: func(m *MockInvoiceRepository) {
+				m.On("GetBrandingProfile", mock.Anything, int64(1), int64(9)).Return(nil, sql.ErrNoRows)
+			},
+			wantErr: service.ErrInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+			if tt.setup != nil {
+				tt.setup(mockRepo)
+			}
+			if tt.wantErr == nil {
+				mockRepo.On("CreateInvoice", mock.Anything, mock.Anything).Return(nil)
+			}
+
+			invoice, err := svc.CreateInvoice(context.Background(), tt.invoice)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantNote, invoice.Note)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateInvoiceBrandingProfile(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	current := &models.Invoice{ID: 1, UserID: 1, Status: models.StatusDraft, Currency: "USD", Version: 1}
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(current, nil)
+	mockRepo.On("GetBrandingProfile", mock.Anything, int64(1), int64(9)).Return(nil, sql.ErrNoRows)
+
+	_, err := svc.UpdateInvoice(context.Background(), &models.Invoice{ID: 1, BrandingProfileID: 9}, []string{"branding_profile_id"})
+
+	assert.ErrorIs(t, err, service.ErrInvalidRequest)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "UpdateInvoice", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func encodePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
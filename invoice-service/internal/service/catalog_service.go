@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+const (
+	maxSKULength  = 64
+	maxUnitLength = 20
+)
+
+func (s *InvoiceService) CreateCatalogItem(ctx context.Context, item *models.CatalogItem) (*models.CatalogItem, error) {
+	err := s.validateCatalogItem(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.CreateCatalogItem(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+func (s *InvoiceService) GetCatalogItem(ctx context.Context, itemID, userID int64) (*models.CatalogItem, error) {
+	item, err := s.repo.GetCatalogItem(ctx, userID, itemID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return item, nil
+}
+
+func (s *InvoiceService) ListCatalogItems(ctx context.Context, userID int64) ([]*models.CatalogItem, error) {
+	return s.repo.ListCatalogItemsByUserID(ctx, userID)
+}
+
+// UpdateCatalogItem changes a catalog item. Invoice items keep the values they were filled in with, so only
+// items added afterwards pick up the change.
+func (s *InvoiceService) UpdateCatalogItem(ctx context.Context, item *models.CatalogItem) (*models.CatalogItem, error) {
+	err := s.validateCatalogItem(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.UpdateCatalogItem(ctx, item)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return item, nil
+}
+
+// DeleteCatalogItem deletes one of the user's catalog items and returns it. Invoice items filled in from it keep
+// their values.
+func (s *InvoiceService) DeleteCatalogItem(ctx context.Context, itemID, userID int64) (*models.CatalogItem, error) {
+	item, err := s.GetCatalogItem(ctx, itemID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.DeleteCatalogItem(ctx, itemID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return item, nil
+}
+
+// resolveCatalogItems fills in the items that reference a catalog item from it. The description, unit and SKU an
+// item leaves empty and the unit price and tax rates it doesn't give are copied from the catalog item, and the
+// rest are overrides. A zero price or an empty list of tax rates that was given is an override too.
+func (s *InvoiceService) resolveCatalogItems(ctx context.Context, userID int64, items []*models.InvoiceItem) error {
+	var ids []int64
+	for _, item := range items {
+		if item.CatalogItemID != 0 {
+			ids = append(ids, item.CatalogItemID)
+		}
+	}
+
+	byID := map[int64]*models.CatalogItem{}
+	if len(ids) > 0 {
+		catalogItems, err := s.repo.GetCatalogItemsByIDs(ctx, userID, ids)
+		if err != nil {
+			return err
+		}
+		for _, catalogItem := range catalogItems {
+			byID[catalogItem.ID] = catalogItem
+		}
+	}
+
+	for _, item := range items {
+		if item.CatalogItemID != 0 {
+			catalogItem, ok := byID[item.CatalogItemID]
+			if !ok {
+				return fmt.Errorf("%w: unknown catalog item %d", ErrInvalidRequest, item.CatalogItemID)
+			}
+			if strings.TrimSpace(item.Description) == "" {
+				item.Description = catalogItem.Description
+			}
+			if !item.UnitPriceSet {
+				item.UnitPrice = catalogItem.UnitPrice
+			}
+			if item.Unit == "" {
+				item.Unit = catalogItem.Unit
+			}
+			if item.SKU == "" {
+				item.SKU = catalogItem.SKU
+			}
+			if !item.TaxRateIDsSet && catalogItem.TaxRateID != 0 {
+				item.TaxRateIDs = []int64{catalogItem.TaxRateID}
+			}
+		}
+
+		item.SKU = strings.TrimSpace(item.SKU)
+		item.Unit = strings.TrimSpace(item.Unit)
+		if len(item.SKU) > maxSKULength {
+			return fmt.Errorf("%w: SKUs can be up to %d characters", ErrInvalidRequest, maxSKULength)
+		}
+		if len(item.Unit) > maxUnitLength {
+			return fmt.Errorf("%w: units can be up to %d characters", ErrInvalidRequest, maxUnitLength)
+		}
+	}
+	return nil
+}
+
+// validateCatalogItem checks a catalog item, including that its SKU is not used by another of the user's items
+// and that its tax rate is one of the user's.
+func (s *InvoiceService) validateCatalogItem(ctx context.Context, item *models.CatalogItem) error {
+	item.SKU = strings.TrimSpace(item.SKU)
+	item.Description = strings.TrimSpace(item.Description)
+	item.Unit = strings.TrimSpace(item.Unit)
+	switch {
+	case item.SKU == "":
+		return fmt.Errorf("%w: SKU is required", ErrInvalidRequest)
+	case len(item.SKU) > maxSKULength:
+		return fmt.Errorf("%w: SKUs can be up to %d characters", ErrInvalidRequest, maxSKULength)
+	case item.Description == "":
+		return fmt.Errorf("%w: catalog item description is required", ErrInvalidRequest)
+	case item.UnitPrice < 0:
+		return fmt.Errorf("%w: unit price cannot be negative", ErrInvalidRequest)
+	case len(item.Unit) > maxUnitLength:
+		return fmt.Errorf("%w: units can be up to %d characters", ErrInvalidRequest, maxUnitLength)
+	}
+
+	existing, err := s.repo.GetCatalogItemBySKU(ctx, item.UserID, item.SKU)
+	if err == nil && existing.ID != item.ID {
+		return fmt.Errorf("%w: SKU %q is already in the catalog", ErrInvalidRequest, item.SKU)
+	}
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	if item.TaxRateID != 0 {
+		taxRates, err := s.repo.GetTaxRatesByIDs(ctx, item.UserID, []int64{item.TaxRateID})
+		if err != nil {
+			return err
+		}
+		if len(taxRates) == 0 {
+			return fmt.Errorf("%w: unknown tax rate %d", ErrInvalidRequest, item.TaxRateID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,216 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+var consulting = &models.CatalogItem{ID: 7, UserID: 1, SKU: "CONS-1", Description: "Consulting", UnitPrice: 12500, Unit: "hour", TaxRateID: 1}
+
+func TestCreateCatalogItem(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     *models.CatalogItem
+		existing *models.CatalogItem
+		taxRates []*models.TaxRate
+		wantErr  error
+	}{
+		{name: "valid", item: &models.CatalogItem{UserID: 1, SKU: " CONS-2 ", Description: "Design", UnitPrice: 9000, Unit: "hour"}},
+		{name: "with tax rate", item: &models.CatalogItem{UserID: 1, SKU: "CONS-2", Description: "Design", TaxRateID: 1}, taxRates: []*models.TaxRate{vat}},
+		{name: "missing SKU", item: &models.CatalogItem{UserID: 1, SKU: " ", Description: "Design"}, wantErr: service.ErrInvalidRequest},
+		{name: "long SKU", item: &models.CatalogItem{UserID: 1, SKU: strings.Repeat("a", 65), Description: "Design"}, wantErr: service.ErrInvalidRequest},
+		{name: "missing description", item: &models.CatalogItem{UserID: 1, SKU: "CONS-2"}, wantErr: service.ErrInvalidRequest},
+		{name: "negative price", item: &models.CatalogItem{UserID: 1, SKU: "CONS-2", Description: "Design", UnitPrice: -1}, wantErr: service.ErrInvalidRequest},
+		{name: "long unit", item: &models.CatalogItem{UserID: 1, SKU: "CONS-2", Description: "Design", Unit: strings.Repeat("a", 21)}, wantErr: service.ErrInvalidRequest},
+		{name: "duplicate SKU", item: &models.CatalogItem{UserID: 1, SKU: "CONS-1", Description: "Design"}, existing: consulting, wantErr: service.ErrInvalidRequest},
+		{name: "unknown tax rate", item: &models.CatalogItem{UserID: 1, SKU: "CONS-2", Description: "Design", TaxRateID: 5}, taxRates: []*models.TaxRate{}, wantErr: service.ErrInvalidRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			if tt.existing != nil {
+				mockRepo.On("GetCatalogItemBySKU", mock.Anything, int64(1), mock.Anything).Return(tt.existing, nil)
+			} else {
+				mockRepo.On("GetCatalogItemBySKU", mock.Anything, int64(1), mock.Anything).Return(nil, sql.ErrNoRows).Maybe()
+			}
+			if tt.taxRates != nil {
+				mockRepo.On("GetTaxRatesByIDs", mock.Anything, int64(1), []int64{tt.item.TaxRateID}).Return(tt.taxRates, nil)
+			}
+			if tt.wantErr == nil {
+				mockRepo.On("CreateCatalogItem", mock.Anything, tt.item).Return(nil)
+			}
+
+			item, err := svc.CreateCatalogItem(context.Background(), tt.item)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockRepo.AssertNotCalled(t, "CreateCatalogItem", mock.Anything, mock.Anything)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "CONS-2", item.SKU)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateCatalogItemKeepsSKU(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	// The item's own SKU is not a duplicate
+	item := &models.CatalogItem{ID: 7, UserID: 1, SKU: "CONS-1", Description: "Senior consulting", UnitPrice: 15000}
+	mockRepo.On("GetCatalogItemBySKU", mock.Anything, int64(1), "CONS-1").Return(consulting, nil)
+	mockRepo.On("UpdateCatalogItem", mock.Anything, item).Return(nil)
+
+	updated, err := svc.UpdateCatalogItem(context.Background(), item)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(15000), updated.UnitPrice)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDeleteCatalogItemNotFound(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	mockRepo.On("GetCatalogItem", mock.Anything, int64(1), int64(7)).Return(nil, sql.ErrNoRows)
+
+	_, err := svc.DeleteCatalogItem(context.Background(), 7, 1)
+
+	assert.ErrorIs(t, err, service.ErrNotFound)
+	mockRepo.AssertNotCalled(t, "DeleteCatalogItem", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateInvoiceFromCatalog(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	mockRepo.On("GetBrandingProfile", mock.Anything, mock.Anything, int64(0)).Return(nil, sql.ErrNoRows).Maybe()
+	mockRepo.On("GetCatalogItemsByIDs", mock.Anything, int64(1), []int64{7, 7}).Return([]*models.CatalogItem{consulting}, nil)
+	mockRepo.On("GetTaxRatesByIDs", mock.Anything, int64(1), mock.Anything).Return([]*models.TaxRate{vat}, nil)
+	mockRepo.On("CreateInvoice", mock.Anything, mock.Anything).Return(nil)
+
+	invoice, err := svc.CreateInvoice(context.Background(), &models.Invoice{
+		UserID:   1,
+		Currency: "USD",
+		Items: []*models.InvoiceItem{
+			{CatalogItemID: 7, Quantity: 2},
+			{CatalogItemID: 7, Quantity: 1, Description: "Consulting, weekend rate", UnitPrice: 20000, UnitPriceSet: true},
+			{Description: "Travel", Quantity: 1, UnitPrice: 5000},
+		},
+	})
+	require.NoError(t, err)
+
+	// The first item takes every default from the catalog
+	assert.Equal(t, &models.InvoiceItem{
+		CatalogItemID: 7, SKU: "CONS-1", Description: "Consulting", Quantity: 2, Unit: "hour", UnitPrice: 12500,
+		TaxRateIDs: []int64{1}, Taxes: []*models.TaxRate{vat},
+	}, invoice.Items[0])
+
+	// The second overrides the description and price but keeps the catalog's SKU, unit and tax rate
+	assert.Equal(t, "Consulting, weekend rate", invoice.Items[1].Description)
+	assert.Equal(t, int64(20000), invoice.Items[1].UnitPrice)
+	assert.Equal(t, "CONS-1", invoice.Items[1].SKU)
+	assert.Equal(t, "hour", invoice.Items[1].Unit)
+	assert.Equal(t, []int64{1}, invoice.Items[1].TaxRateIDs)
+
+	// Items that don't reference the catalog are left alone
+	assert.Equal(t, int64(0), invoice.Items[2].CatalogItemID)
+	assert.Equal(t, "", invoice.Items[2].SKU)
+	assert.Nil(t, invoice.Items[2].TaxRateIDs)
+
+	assert.Equal(t, int64(50000), invoice.Subtotal)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateInvoiceFromCatalogOverrides(t *testing.T) {
+	tests := []struct {
+		name           string
+		item           *models.InvoiceItem
+		wantUnitPrice  int64
+		wantTaxRateIDs []int64
+	}{
+		{"zero price", &models.InvoiceItem{CatalogItemID: 7, Quantity: 1, UnitPriceSet: true}, 0, []int64{1}},
+		{"no tax", &models.InvoiceItem{CatalogItemID: 7, Quantity: 1, TaxRateIDsSet: true}, 12500, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			mockRepo.On("GetBrandingProfile", mock.Anything, mock.Anything, int64(0)).Return(nil, sql.ErrNoRows).Maybe()
+			mockRepo.On("GetCatalogItemsByIDs", mock.Anything, int64(1), []int64{7}).Return([]*models.CatalogItem{consulting}, nil)
+			mockRepo.On("GetTaxRatesByIDs", mock.Anything, int64(1), mock.Anything).Return([]*models.TaxRate{vat}, nil).Maybe()
+			mockRepo.On("CreateInvoice", mock.Anything, mock.Anything).Return(nil)
+
+			invoice, err := svc.CreateInvoice(context.Background(), &models.Invoice{
+				UserID:   1,
+				Currency: "USD",
+				Items:    []*models.InvoiceItem{tt.item},
+			})
+			require.NoError(t, err)
+
+			// A zero price or no tax rates given on the item override the catalog item's
+			assert.Equal(t, tt.wantUnitPrice, invoice.Items[0].UnitPrice)
+			assert.Equal(t, tt.wantTaxRateIDs, invoice.Items[0].TaxRateIDs)
+			assert.Equal(t, "Consulting", invoice.Items[0].Description)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateInvoiceUnknownCatalogItem(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	mockRepo.On("GetBrandingProfile", mock.Anything, mock.Anything, int64(0)).Return(nil, sql.ErrNoRows).Maybe()
+	mockRepo.On("GetCatalogItemsByIDs", mock.Anything, int64(1), []int64{8}).Return([]*models.CatalogItem{}, nil)
+
+	_, err := svc.CreateInvoice(context.Background(), &models.Invoice{
+		UserID:   1,
+		Currency: "USD",
+		Items:    []*models.InvoiceItem{{CatalogItemID: 8, Quantity: 1}},
+	})
+
+	assert.ErrorIs(t, err, service.ErrInvalidRequest)
+	mockRepo.AssertNotCalled(t, "CreateInvoice", mock.Anything, mock.Anything)
+}
+
+func TestUpdateInvoiceKeepsCatalogSnapshot(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	// The catalog price has gone up since the invoice was created, but the invoice keeps its own
+	current := &models.Invoice{
+		ID:       1,
+		UserID:   1,
+		Status:   models.StatusDraft,
+		Currency: "USD",
+		Items:    []*models.InvoiceItem{{CatalogItemID: 7, SKU: "CONS-1", Description: "Consulting", Quantity: 2, Unit: "hour", UnitPrice: 10000}},
+		Subtotal: 20000,
+		Total:    20000,
+		Version:  1,
+	}
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(current, nil)
+	mockRepo.On("UpdateInvoice", mock.Anything, mock.Anything, false).Return(nil)
+
+	updated, err := svc.UpdateInvoice(context.Background(), &models.Invoice{ID: 1, Note: "Thanks"}, []string{"note"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(10000), updated.Items[0].UnitPrice)
+	mockRepo.AssertNotCalled(t, "GetCatalogItemsByIDs", mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/shopspring/decimal"
+)
+
+// CreateCreditNote issues a credit note for some or all of the lines of an issued invoice. The credit is applied
+// to the invoice's balance due straight away; any credit beyond the balance stays on the credit note to be
+// applied to another invoice or refunded.
+func (s *InvoiceService) CreateCreditNote(ctx context.Context, creditNote *models.CreditNote) (*models.CreditNote, *models.Invoice, error) {
+	invoice, err := s.GetInvoice(ctx, creditNote.InvoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if invoice.UserID != creditNote.UserID {
+		return nil, nil, ErrNotFound
+	}
+
+	// Drafts can still be edited, and void invoices are owed nothing
+	switch invoice.Status {
+	case models.StatusUnpaid, models.StatusPartiallyPaid, models.StatusOverdue, models.StatusPaid:
+	default:
+		return nil, nil, ErrInvalidTransition
+	}
+
+	if len(creditNote.Items) == 0 {
+		return nil, nil, fmt.Errorf("%w: a credit note needs at least one item", ErrInvalidRequest)
+	}
+
+	credited, err := s.repo.GetCreditedQuantities(ctx, invoice.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = creditInvoiceItems(creditNote, invoice, credited)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Credit the lines at the prices, discounts and taxes they were invoiced at. The credited lines take the share
+	// of the invoice's fixed discount their discounted amounts bear to the whole invoice's; charges are only
+	// credited once every line is.
+	lines := make([]*models.InvoiceItem, len(creditNote.Items))
+	var creditedNet int64
+	for i, item := range creditNote.Items {
+		lines[i] = &models.InvoiceItem{Quantity: item.Quantity, UnitPrice: item.UnitPrice, FixedDiscount: item.DiscountAmount, Taxes: item.Taxes}
+		creditedNet += lines[i].NetAmount()
+	}
+	adjustments := invoiceAdjustments{discountPercentage: invoice.DiscountPercentage}
+	if invoiceNet := invoiceNetAmount(invoice); invoice.FixedDiscount != 0 && invoiceNet != 0 {
+		adjustments.fixedDiscount = roundToCents(decimal.NewFromInt(invoice.FixedDiscount).Mul(decimal.NewFromInt(creditedNet)).
+			Div(decimal.NewFromInt(invoiceNet)))
+	}
+	amounts := calculateInvoiceAmounts(lines, adjustments)
+
+	// Rounding must never let credit notes add up to more than the invoice, and crediting every line
+	// credits the invoice exactly
+	remaining := invoice.Total - invoice.CreditNoteTotal
+	if amounts.total > remaining || isFullyCredited(invoice, credited) {
+		amounts.total = remaining
+	}
+	if amounts.total <= 0 {
+		return nil, nil, fmt.Errorf("%w: the credit note total must be positive", ErrInvalidRequest)
+	}
+
+	creditNote.CustomerID = invoice.CustomerID
+	creditNote.Currency = invoice.Currency
+	creditNote.Reason = strings.TrimSpace(creditNote.Reason)
+	creditNote.DiscountPercentage = invoice.DiscountPercentage
+	creditNote.Subtotal = amounts.subtotal
+	creditNote.DiscountAmount = amounts.discount
+	creditNote.Taxes = amounts.taxes
+	creditNote.TaxTotal = amounts.taxTotal
+	creditNote.Total = amounts.total
+	if creditNote.IssueDate.IsZero() {
+		creditNote.IssueDate = time.Now()
+	}
+
+	// Apply as much of the credit as the invoice still owes
+	status := invoice.Status
+	applied := min(creditNote.Total, invoice.BalanceDue())
+	creditNote.AmountApplied = applied
+	creditNote.Allocations = nil
+	if applied > 0 {
+		status = statusForBalance(invoice.BalanceDue() - applied)
+		creditNote.Allocations = []*models.CreditNoteAllocation{{
+			Kind:      models.AllocationKindInvoice,
+			InvoiceID: invoice.ID,
+			Amount:    applied,
+		}}
+	}
+
+	invoice.RecordActivityFunc(models.ActivityCreditNoteIssued, func() string {
+		return fmt.Sprintf("Issued credit note %s of %d against invoice %s", creditNote.CreditNoteNumber, creditNote.Total,
+			invoice.InvoiceNumber)
+	})
+	if applied > 0 && status == models.StatusPaid {
+		invoice.RecordActivity(models.ActivityInvoicePaid, fmt.Sprintf("Invoice %s paid in full", invoice.InvoiceNumber))
+	}
+
+	// The repository numbers the credit note in the same transaction as it is inserted
+	err = s.repo.CreateCreditNote(ctx, creditNote, invoice, status)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrConflict
+		}
+		return nil, nil, err
+	}
+	invoice.AmountCredited += applied
+	invoice.CreditNoteTotal += creditNote.Total
+	invoice.Status = status
+	invoice.Version++
+
+	return creditNote, invoice, nil
+}
+
+func (s *InvoiceService) GetCreditNote(ctx context.Context, creditNoteID, userID int64) (*models.CreditNote, error) {
+	creditNote, err := s.repo.GetCreditNoteByID(ctx, creditNoteID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return creditNote, nil
+}
+
+// ListCreditNotes returns a user's credit notes, or only those issued against invoiceID if it is non-zero.
+func (s *InvoiceService) ListCreditNotes(ctx context.Context, userID, invoiceID int64) ([]*models.CreditNote, error) {
+	return s.repo.ListCreditNotesByUserID(ctx, userID, invoiceID)
+}
+
+// ApplyCreditNote applies the remaining credit of a credit note to another issued invoice of the same customer.
+// A zero amount applies as much as both the credit note and the invoice allow.
+func (s *InvoiceService) ApplyCreditNote(ctx context.Context, creditNoteID, userID, invoiceID, amount int64) (*models.CreditNote, *models.Invoice, error) {
+	creditNote, err := s.GetCreditNote(ctx, creditNoteID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invoice, err := s.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if invoice.UserID != userID {
+		return nil, nil, ErrNotFound
+	}
+
+	switch invoice.Status {
+	case models.StatusUnpaid, models.StatusPartiallyPaid, models.StatusOverdue:
+	default:
+		return nil, nil, ErrInvalidTransition
+	}
+
+	switch {
+	case invoice.CustomerID != creditNote.CustomerID:
+		return nil, nil, fmt.Errorf("%w: the invoice belongs to a different customer", ErrInvalidRequest)
+	case invoice.Currency != creditNote.Currency:
+		return nil, nil, fmt.Errorf("%w: the invoice is in %s but the credit note is in %s", ErrInvalidRequest,
+			invoice.Currency, creditNote.Currency)
+	}
+
+	if amount == 0 {
+		amount = min(creditNote.RemainingCredit(), invoice.BalanceDue())
+	}
+	switch {
+	case amount <= 0:
+		return nil, nil, fmt.Errorf("%w: the amount to apply must be positive", ErrInvalidRequest)
+	case amount > creditNote.RemainingCredit():
+		return nil, nil, fmt.Errorf("%w: the amount exceeds the remaining credit", ErrInvalidRequest)
+	case amount > invoice.BalanceDue():
+		return nil, nil, fmt.Errorf("%w: the amount exceeds the balance due", ErrInvalidRequest)
+	}
+
+	status := statusForBalance(invoice.BalanceDue() - amount)
+	allocation := &models.CreditNoteAllocation{
+		CreditNoteID: creditNote.ID,
+		Kind:         models.AllocationKindInvoice,
+		InvoiceID:    invoice.ID,
+		Amount:       amount,
+	}
+
+	invoice.RecordActivity(models.ActivityCreditNoteApplied, fmt.Sprintf("Applied %d from credit note %s to invoice %s",
+		amount, creditNote.CreditNoteNumber, invoice.InvoiceNumber))
+	if status == models.StatusPaid {
+		invoice.RecordActivity(models.ActivityInvoicePaid, fmt.Sprintf("Invoice %s paid in full", invoice.InvoiceNumber))
+	}
+	err = s.repo.ApplyCreditNote(ctx, allocation, creditNote, invoice, status)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrConflict
+		}
+		return nil, nil, err
+	}
+	creditNote.AmountApplied += amount
+	creditNote.Allocations = append(creditNote.Allocations, allocation)
+	invoice.AmountCredited += amount
+	invoice.Status = status
+	invoice.Version++
+
+	return creditNote, invoice, nil
+}
+
+// RefundCreditNote refunds remaining credit to the customer. A zero amount refunds all of the remaining credit.
+func (s *InvoiceService) RefundCreditNote(ctx context.Context, creditNoteID, userID, amount int64, method, reference string) (*models.CreditNote, error) {
+	creditNote, err := s.GetCreditNote(ctx, creditNoteID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if amount == 0 {
+		amount = creditNote.RemainingCredit()
+	}
+	switch {
+	case amount <= 0:
+		return nil, fmt.Errorf("%w: the amount to refund must be positive", ErrInvalidRequest)
+	case amount > creditNote.RemainingCredit():
+		return nil, fmt.Errorf("%w: the amount exceeds the remaining credit", ErrInvalidRequest)
+	case !paymentMethods[method]:
+		return nil, fmt.Errorf("%w: unknown payment method %q", ErrInvalidRequest, method)
+	}
+
+	allocation := &models.CreditNoteAllocation{
+		CreditNoteID: creditNote.ID,
+		Kind:         models.AllocationKindRefund,
+		Amount:       amount,
+		Method:       method,
+		Reference:    reference,
+	}
+
+	// The refund is logged against the credited invoice
+	creditNote.RecordActivity(models.ActivityCreditNoteRefunded,
+		fmt.Sprintf("Refunded %d from credit note %s", amount, creditNote.CreditNoteNumber))
+	err = s.repo.RefundCreditNote(ctx, allocation, creditNote)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrConflict
+		}
+		return nil, err
+	}
+	creditNote.AmountRefunded += amount
+	creditNote.Allocations = append(creditNote.Allocations, allocation)
+
+	return creditNote, nil
+}
+
+// creditInvoiceItems fills in each credit note item from the invoice line it credits, making sure no line is
+// credited for more than its uncredited quantity. credited is updated with the quantities of the credit note.
+func creditInvoiceItems(creditNote *models.CreditNote, invoice *models.Invoice, credited map[int64]int32) error {
+	invoiceItems := make(map[int64]*models.InvoiceItem, len(invoice.Items))
+	for _, item := range invoice.Items {
+		invoiceItems[item.ID] = item
+	}
+
+	for _, item := range creditNote.Items {
+		invoiceItem, ok := invoiceItems[item.InvoiceItemID]
+		if !ok {
+			return fmt.Errorf("%w: item %d is not on invoice %s", ErrInvalidRequest, item.InvoiceItemID, invoice.InvoiceNumber)
+		}
+		if item.Quantity <= 0 {
+			return fmt.Errorf("%w: credited quantities must be positive", ErrInvalidRequest)
+		}
+		if credited[invoiceItem.ID]+item.Quantity > invoiceItem.Quantity {
+			return fmt.Errorf("%w: item %d has only %d left to credit", ErrInvalidRequest, invoiceItem.ID,
+				invoiceItem.Quantity-credited[invoiceItem.ID])
+		}
+		credited[invoiceItem.ID] += item.Quantity
+
+		item.Description = strings.TrimSpace(item.Description)
+		if item.Description == "" {
+			item.Description = invoiceItem.Description
+		}
+		item.UnitPrice = invoiceItem.UnitPrice
+		item.DiscountAmount = roundToCents(decimal.NewFromInt(invoiceItem.DiscountAmount()).Mul(decimal.NewFromInt(int64(item.Quantity))).
+			Div(decimal.NewFromInt(int64(invoiceItem.Quantity))))
+		item.Taxes = invoiceItem.Taxes
+	}
+	return nil
+}
+
+// invoiceNetAmount returns the sum of an invoice's line amounts after line discounts in cents.
+func invoiceNetAmount(invoice *models.Invoice) int64 {
+	var net int64
+	for _, item := range invoice.Items {
+		net += item.NetAmount()
+	}
+	return net
+}
+
+// isFullyCredited reports whether every line of an invoice has been credited in full.
+func isFullyCredited(invoice *models.Invoice, credited map[int64]int32) bool {
+	for _, item := range invoice.Items {
+		if credited[item.ID] < item.Quantity {
+			return false
+		}
+	}
+	return true
+}
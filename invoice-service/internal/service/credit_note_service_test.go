@@ -0,0 +1,248 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// issuedInvoice returns an unpaid invoice of 3 x 33.33 with a 10% discount, totalling 89.99.
+func issuedInvoice() *models.Invoice {
+	return &models.Invoice{
+		ID:                 1,
+		UserID:             1,
+		CustomerID:         2,
+		InvoiceNumber:      "000001",
+		Status:             models.StatusUnpaid,
+		Currency:           "USD",
+		Items:              []*models.InvoiceItem{{ID: 11, Description: "Consulting", Quantity: 3, UnitPrice: 3333}},
+		DiscountPercentage: 1000,
+		Subtotal:           9999,
+		DiscountAmount:     999,
+		Total:              8999,
+	}
+}
+
+func TestCreateCreditNote(t *testing.T) {
+	tests := []struct {
+		name            string
+		amountPaid      int64
+		status          string
+		creditNoteTotal int64
+		credited        map[int64]int32
+		quantity        int32
+		wantTotal       int64
+		wantApplied     int64
+		wantStatus      string
+	}{
+		{"part of an unpaid invoice", 0, models.StatusUnpaid, 0, map[int64]int32{}, 1, 3000, 3000, models.StatusPartiallyPaid},
+		{"whole of an unpaid invoice", 0, models.StatusUnpaid, 0, map[int64]int32{}, 3, 8999, 8999, models.StatusPaid},
+		{"last line absorbs rounding", 0, models.StatusPartiallyPaid, 5998, map[int64]int32{11: 2}, 1, 3001, 3001, models.StatusPaid},
+		{"more than the balance due", 6000, models.StatusPartiallyPaid, 0, map[int64]int32{}, 2, 5999, 2999, models.StatusPaid},
+		{"a paid invoice", 8999, models.StatusPaid, 0, map[int64]int32{}, 1, 3000, 0, models.StatusPaid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			invoice := issuedInvoice()
+			invoice.Status = tt.status
+			invoice.AmountPaid = tt.amountPaid
+			invoice.AmountCredited = tt.creditNoteTotal
+			invoice.CreditNoteTotal = tt.creditNoteTotal
+			mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(invoice, nil)
+			mockRepo.On("GetCreditedQuantities", mock.Anything, int64(1)).Return(tt.credited, nil)
+
+			// The repository numbers the credit note from the user's sequence as it inserts it
+			var activities []models.Activity
+			mockRepo.On("CreateCreditNote", mock.Anything, mock.Anything, invoice, tt.wantStatus).Run(func(args mock.Arguments) {
+				args.Get(1).(*models.CreditNote).CreditNoteNumber = models.FormatCreditNoteNumber(1)
+				activities = invoice.TakeActivities(invoice.ID, invoice.UserID)
+			}).Return(nil)
+
+			creditNote, result, err := svc.CreateCreditNote(context.Background(), &models.CreditNote{
+				UserID:    1,
+				InvoiceID: 1,
+				Reason:    "Returned goods",
+				Items:     []*models.CreditNoteItem{{InvoiceItemID: 11, Quantity: tt.quantity}},
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, "CN-000001", creditNote.CreditNoteNumber)
+			assert.Equal(t, fmt.Sprintf("Issued credit note CN-000001 of %d against invoice %s", tt.wantTotal, invoice.InvoiceNumber),
+				activities[0].Description)
+			assert.Equal(t, "Consulting", creditNote.Items[0].Description)
+			assert.Equal(t, int64(3333), creditNote.Items[0].UnitPrice)
+			assert.Equal(t, tt.wantTotal, creditNote.Total)
+			assert.Equal(t, tt.wantApplied, creditNote.AmountApplied)
+			assert.Equal(t, tt.wantTotal-tt.wantApplied, creditNote.RemainingCredit())
+			assert.Equal(t, tt.wantStatus, result.Status)
+			assert.Equal(t, tt.creditNoteTotal+tt.wantTotal, result.CreditNoteTotal)
+			if tt.wantApplied > 0 {
+				assert.Len(t, creditNote.Allocations, 1)
+			} else {
+				assert.Empty(t, creditNote.Allocations)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateCreditNoteInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   string
+		credited map[int64]int32
+		items    []*models.CreditNoteItem
+		wantErr  error
+	}{
+		{"draft invoice", models.StatusDraft, map[int64]int32{}, []*models.CreditNoteItem{{InvoiceItemID: 11, Quantity: 1}}, service.ErrInvalidTransition},
+		{"void invoice", models.StatusVoid, map[int64]int32{}, []*models.CreditNoteItem{{InvoiceItemID: 11, Quantity: 1}}, service.ErrInvalidTransition},
+		{"no items", models.StatusUnpaid, map[int64]int32{}, nil, service.ErrInvalidRequest},
+		{"unknown item", models.StatusUnpaid, map[int64]int32{}, []*models.CreditNoteItem{{InvoiceItemID: 99, Quantity: 1}}, service.ErrInvalidRequest},
+		{"zero quantity", models.StatusUnpaid, map[int64]int32{}, []*models.CreditNoteItem{{InvoiceItemID: 11, Quantity: 0}}, service.ErrInvalidRequest},
+		{"already credited", models.StatusUnpaid, map[int64]int32{11: 2}, []*models.CreditNoteItem{{InvoiceItemID: 11, Quantity: 2}}, service.ErrInvalidRequest},
+		{"same line twice", models.StatusUnpaid, map[int64]int32{}, []*models.CreditNoteItem{{InvoiceItemID: 11, Quantity: 2}, {InvoiceItemID: 11, Quantity: 2}}, service.ErrInvalidRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			invoice := issuedInvoice()
+			invoice.Status = tt.status
+			mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(invoice, nil)
+			mockRepo.On("GetCreditedQuantities", mock.Anything, int64(1)).Return(tt.credited, nil)
+
+			_, _, err := svc.CreateCreditNote(context.Background(), &models.CreditNote{UserID: 1, InvoiceID: 1, Items: tt.items})
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			mockRepo.AssertNotCalled(t, "CreateCreditNote", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestCreateCreditNoteOtherUser(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(issuedInvoice(), nil)
+
+	_, _, err := svc.CreateCreditNote(context.Background(), &models.CreditNote{
+		UserID:    7,
+		InvoiceID: 1,
+		Items:     []*models.CreditNoteItem{{InvoiceItemID: 11, Quantity: 1}},
+	})
+
+	assert.ErrorIs(t, err, service.ErrNotFound)
+}
+
+func TestCreateCreditNoteConflict(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	invoice := issuedInvoice()
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(invoice, nil)
+	mockRepo.On("GetCreditedQuantities", mock.Anything, int64(1)).Return(map[int64]int32{}, nil)
+	mockRepo.On("CreateCreditNote", mock.Anything, mock.Anything, invoice, models.StatusPartiallyPaid).Return(sql.ErrNoRows)
+
+	// The invoice was paid or credited by another request in the meantime
+	_, _, err := svc.CreateCreditNote(context.Background(), &models.CreditNote{
+		UserID:    1,
+		InvoiceID: 1,
+		Items:     []*models.CreditNoteItem{{InvoiceItemID: 11, Quantity: 1}},
+	})
+
+	assert.ErrorIs(t, err, service.ErrConflict)
+}
+
+func TestApplyCreditNote(t *testing.T) {
+	tests := []struct {
+		name        string
+		customerID  int64
+		currency    string
+		status      string
+		amount      int64
+		wantApplied int64
+		wantStatus  string
+		wantErr     error
+	}{
+		{"as much as possible", 2, "USD", models.StatusUnpaid, 0, 5000, models.StatusPartiallyPaid, nil},
+		{"part of the credit", 2, "USD", models.StatusOverdue, 1000, 1000, models.StatusPartiallyPaid, nil},
+		{"more than remaining", 2, "USD", models.StatusUnpaid, 6000, 0, "", service.ErrInvalidRequest},
+		{"other customer", 3, "USD", models.StatusUnpaid, 0, 0, "", service.ErrInvalidRequest},
+		{"other currency", 2, "EUR", models.StatusUnpaid, 0, 0, "", service.ErrInvalidRequest},
+		{"paid invoice", 2, "USD", models.StatusPaid, 0, 0, "", service.ErrInvalidTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			creditNote := &models.CreditNote{
+				ID:               4,
+				UserID:           1,
+				CustomerID:       2,
+				CreditNoteNumber: "CN-100001",
+				Currency:         "USD",
+				Total:            8999,
+				AmountApplied:    3999,
+			}
+			invoice := &models.Invoice{
+				ID:         5,
+				UserID:     1,
+				CustomerID: tt.customerID,
+				Status:     tt.status,
+				Currency:   tt.currency,
+				Total:      20000,
+			}
+			mockRepo.On("GetCreditNoteByID", mock.Anything, int64(4), int64(1)).Return(creditNote, nil)
+			mockRepo.On("GetInvoiceByID", mock.Anything, int64(5)).Return(invoice, nil)
+			mockRepo.On("ApplyCreditNote", mock.Anything, mock.Anything, creditNote, invoice, tt.wantStatus).Return(nil)
+
+			creditNote, invoice, err := svc.ApplyCreditNote(context.Background(), 4, 1, 5, tt.amount)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockRepo.AssertNotCalled(t, "ApplyCreditNote", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantApplied, invoice.AmountCredited)
+			assert.Equal(t, tt.wantStatus, invoice.Status)
+			assert.Equal(t, 5000-tt.wantApplied, creditNote.RemainingCredit())
+		})
+	}
+}
+
+func TestRefundCreditNote(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	creditNote := &models.CreditNote{ID: 4, UserID: 1, Total: 8999, AmountApplied: 3999}
+	mockRepo.On("GetCreditNoteByID", mock.Anything, int64(4), int64(1)).Return(creditNote, nil)
+	mockRepo.On("RefundCreditNote", mock.Anything, mock.Anything, creditNote).Return(nil)
+
+	// An unknown method is rejected
+	_, err := svc.RefundCreditNote(context.Background(), 4, 1, 0, "crypto", "")
+	assert.ErrorIs(t, err, service.ErrInvalidRequest)
+
+	// A zero amount refunds all of the remaining credit
+	result, err := svc.RefundCreditNote(context.Background(), 4, 1, 0, models.PaymentMethodBankTransfer, "REF-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(5000), result.AmountRefunded)
+	assert.Zero(t, result.RemainingCredit())
+	assert.Equal(t, models.AllocationKindRefund, result.Allocations[0].Kind)
+	mockRepo.AssertExpectations(t)
+}
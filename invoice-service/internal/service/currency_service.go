@@ -0,0 +1,225 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/shopspring/decimal"
+)
+
+// GetBaseCurrency returns the user's base currency, or an empty string if the user has not chosen one.
+func (s *InvoiceService) GetBaseCurrency(ctx context.Context, userID int64) (string, error) {
+	baseCurrency, err := s.repo.GetBaseCurrency(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+		return "", err
+	}
+	return baseCurrency, nil
+}
+
+// SetBaseCurrency changes the user's base currency. Issued invoices keep the base currency and rate they
+// were issued with; exchange rates are kept per base currency.
+func (s *InvoiceService) SetBaseCurrency(ctx context.Context, userID int64, baseCurrency string) (string, error) {
+	baseCurrency, err := normalizeCurrency(baseCurrency)
+	if err != nil {
+		return "", err
+	}
+
+	err = s.repo.SetBaseCurrency(ctx, userID, baseCurrency)
+	if err != nil {
+		return "", err
+	}
+	return baseCurrency, nil
+}
+
+// CreateExchangeRate records the rate from a currency to the user's base currency.
+func (s *InvoiceService) CreateExchangeRate(ctx context.Context, rate *models.ExchangeRate) (*models.ExchangeRate, error) {
+	baseCurrency, err := s.requireBaseCurrency(ctx, rate.UserID)
+	if err != nil {
+		return nil, err
+	}
+	rate.BaseCurrency = baseCurrency
+
+	err = validateExchangeRate(rate)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.CreateExchangeRates(ctx, []*models.ExchangeRate{rate})
+	if err != nil {
+		return nil, err
+	}
+	return rate, nil
+}
+
+func (s *InvoiceService) ListExchangeRates(ctx context.Context, userID int64) ([]*models.ExchangeRate, error) {
+	return s.repo.ListExchangeRatesByUserID(ctx, userID)
+}
+
+// ImportExchangeRates records the rates in a CSV file with a currency,rate,effective_date header. Either every
+// row is imported or, if any row is invalid, none are and the error lists the invalid rows.
+func (s *InvoiceService) ImportExchangeRates(ctx context.Context, userID int64, data []byte) ([]*models.ExchangeRate, error) {
+	baseCurrency, err := s.requireBaseCurrency(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = 3
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("%w: reading header: %v", ErrInvalidRequest, err)
+	}
+	if strings.ToLower(strings.Join(header, ",")) != "currency,rate,effective_date" {
+		return nil, fmt.Errorf("%w: header must be currency,rate,effective_date", ErrInvalidRequest)
+	}
+
+	var rates []*models.ExchangeRate
+	var rowErrors []string
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			rowErrors = append(rowErrors, fmt.Sprintf("row %d: %v", row, err))
+			continue
+		}
+
+		rate, err := parseExchangeRateRecord(record)
+		if err == nil {
+			rate.UserID = userID
+			rate.BaseCurrency = baseCurrency
+			err = validateExchangeRate(rate)
+		}
+		if err != nil {
+			reason := strings.TrimPrefix(err.Error(), ErrInvalidRequest.Error()+": ")
+			rowErrors = append(rowErrors, fmt.Sprintf("row %d: %s", row, reason))
+			continue
+		}
+		rates = append(rates, rate)
+	}
+
+	if len(rowErrors) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRequest, strings.Join(rowErrors, "; "))
+	}
+	if len(rates) == 0 {
+		return nil, fmt.Errorf("%w: the file contains no exchange rates", ErrInvalidRequest)
+	}
+
+	err = s.repo.CreateExchangeRates(ctx, rates)
+	if err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+func (s *InvoiceService) DeleteExchangeRate(ctx context.Context, exchangeRateID, userID int64) error {
+	err := s.repo.DeleteExchangeRate(ctx, exchangeRateID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// recordExchangeRate sets the base currency and the exchange rate in force on the invoice's issue date.
+// Nothing is recorded if the user has no base currency.
+func (s *InvoiceService) recordExchangeRate(ctx context.Context, invoice *models.Invoice) error {
+	baseCurrency, err := s.GetBaseCurrency(ctx, invoice.UserID)
+	if err != nil || baseCurrency == "" {
+		return err
+	}
+
+	if invoice.Currency == baseCurrency {
+		invoice.BaseCurrency = baseCurrency
+		invoice.ExchangeRate = decimal.NewFromInt(1)
+		return nil
+	}
+
+	rate, err := s.repo.GetExchangeRate(ctx, invoice.UserID, baseCurrency, invoice.Currency, invoice.IssueDate)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("%w: no exchange rate from %s to %s on or before %s", ErrInvalidRequest,
+				invoice.Currency, baseCurrency, invoice.IssueDate.Format(time.DateOnly))
+		}
+		return err
+	}
+	invoice.BaseCurrency = baseCurrency
+	invoice.ExchangeRate = rate.Rate
+
+	return nil
+}
+
+func (s *InvoiceService) requireBaseCurrency(ctx context.Context, userID int64) (string, error) {
+	baseCurrency, err := s.GetBaseCurrency(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if baseCurrency == "" {
+		return "", fmt.Errorf("%w: choose a base currency before adding exchange rates", ErrInvalidRequest)
+	}
+	return baseCurrency, nil
+}
+
+// normalizeCurrency upper-cases an ISO 4217 currency code and checks that it is valid.
+func normalizeCurrency(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if !models.IsCurrencyCode(code) {
+		return "", fmt.Errorf("%w: %q is not an ISO 4217 currency code", ErrInvalidRequest, code)
+	}
+	return code, nil
+}
+
+func validateExchangeRate(rate *models.ExchangeRate) error {
+	currency, err := normalizeCurrency(rate.Currency)
+	if err != nil {
+		return err
+	}
+	rate.Currency = currency
+
+	switch {
+	case rate.Currency == rate.BaseCurrency:
+		return fmt.Errorf("%w: %s is the base currency", ErrInvalidRequest, rate.Currency)
+	case !rate.Rate.IsPositive():
+		return fmt.Errorf("%w: exchange rate must be positive", ErrInvalidRequest)
+	}
+
+	if rate.EffectiveDate.IsZero() {
+		rate.EffectiveDate = time.Now()
+	}
+	rate.EffectiveDate = rate.EffectiveDate.UTC().Truncate(24 * time.Hour)
+
+	return nil
+}
+
+func parseExchangeRateRecord(record []string) (*models.ExchangeRate, error) {
+	rate, err := decimal.NewFromString(strings.TrimSpace(record[1]))
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid rate %q", ErrInvalidRequest, record[1])
+	}
+	effectiveDate, err := time.Parse(time.DateOnly, strings.TrimSpace(record[2]))
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid effective date %q", ErrInvalidRequest, record[2])
+	}
+
+	return &models.ExchangeRate{
+		Currency:      record[0],
+		Rate:          rate,
+		EffectiveDate: effectiveDate,
+	}, nil
+}
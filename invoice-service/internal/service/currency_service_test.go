@@ -0,0 +1,147 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateInvoiceCurrency(t *testing.T) {
+	tests := []struct {
+		name         string
+		currency     string
+		wantCurrency string
+		wantErr      error
+	}{
+		{"valid code", "EUR", "EUR", nil},
+		{"lower case code", " ngn ", "NGN", nil},
+		{"unknown code", "XYZ", "", service.ErrInvalidRequest},
+		{"empty code", "", "", service.ErrInvalidRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+			mockRepo.On("GetBrandingProfile", mock.Anything, mock.Anything, int64(0)).Return(nil, sql.ErrNoRows).Maybe()
+			if tt.wantErr == nil {
+				mockRepo.On("CreateInvoice", mock.Anything, mock.Anything).Return(nil)
+			}
+
+			invoice, err := svc.CreateInvoice(context.Background(), &models.Invoice{UserID: 1, Currency: tt.currency})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantCurrency, invoice.Currency)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestFinalizeInvoiceRecordsExchangeRate(t *testing.T) {
+	issueDate := time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name         string
+		currency     string
+		baseCurrency string
+		rate         *models.ExchangeRate
+		wantBase     string
+		wantRate     string
+		wantErr      error
+	}{
+		{"foreign currency", "EUR", "USD", &models.ExchangeRate{Rate: decimal.RequireFromString("1.0875")}, "USD", "1.0875", nil},
+		{"base currency", "USD", "USD", nil, "USD", "1", nil},
+		{"no base currency", "EUR", "", nil, "", "0", nil},
+		{"missing rate", "EUR", "USD", nil, "", "", service.ErrInvalidRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			current := &models.Invoice{
+				ID:        1,
+				UserID:    1,
+				Status:    "draft",
+				Currency:  tt.currency,
+				IssueDate: issueDate,
+				Items:     []*models.InvoiceItem{{Description: "Item 1", Quantity: 1, UnitPrice: 10000}},
+			}
+			mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(current, nil)
+			if tt.baseCurrency == "" {
+				mockRepo.On("GetBaseCurrency", mock.Anything, int64(1)).Return("", sql.ErrNoRows)
+			} else {
+				mockRepo.On("GetBaseCurrency", mock.Anything, int64(1)).Return(tt.baseCurrency, nil)
+			}
+			if tt.currency != tt.baseCurrency && tt.baseCurrency != "" {
+				if tt.rate != nil {
+					mockRepo.On("GetExchangeRate", mock.Anything, int64(1), tt.baseCurrency, tt.currency, issueDate).Return(tt.rate, nil)
+				} else {
+					mockRepo.On("GetExchangeRate", mock.Anything, int64(1), tt.baseCurrency, tt.currency, issueDate).Return((*models.ExchangeRate)(nil), sql.ErrNoRows)
+				}
+			}
+			if tt.wantErr == nil {
+				mockRepo.On("IssueInvoice", mock.Anything, current, "draft").Return(nil)
+			}
+
+			invoice, err := svc.FinalizeInvoice(context.Background(), 1, 1)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "unpaid", invoice.Status)
+				assert.Equal(t, tt.wantBase, invoice.BaseCurrency)
+				assert.Equal(t, tt.wantRate, invoice.ExchangeRate.String())
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestImportExchangeRates(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	mockRepo.On("GetBaseCurrency", mock.Anything, int64(1)).Return("USD", nil)
+	mockRepo.On("CreateExchangeRates", mock.Anything, mock.Anything).Return(nil)
+
+	data := []byte("currency,rate,effective_date\neur,1.0875,2024-03-01\nGBP,1.27,2024-03-01\n")
+	rates, err := svc.ImportExchangeRates(context.Background(), 1, data)
+
+	assert.NoError(t, err)
+	assert.Len(t, rates, 2)
+	assert.Equal(t, "EUR", rates[0].Currency)
+	assert.Equal(t, "USD", rates[0].BaseCurrency)
+	assert.Equal(t, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), rates[0].EffectiveDate)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestImportExchangeRatesInvalidRows(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	mockRepo.On("GetBaseCurrency", mock.Anything, int64(1)).Return("USD", nil)
+
+	data := []byte("currency,rate,effective_date\nEUR,abc,2024-03-01\nUSD,1,2024-03-01\nGBP,1.27,01/03/2024\nJPY,0.0067,2024-03-01\n")
+	_, err := svc.ImportExchangeRates(context.Background(), 1, data)
+
+	assert.ErrorIs(t, err, service.ErrInvalidRequest)
+	assert.Contains(t, err.Error(), `row 2: invalid rate "abc"`)
+	assert.Contains(t, err.Error(), "row 3: USD is the base currency")
+	assert.Contains(t, err.Error(), `row 4: invalid effective date "01/03/2024"`)
+	assert.NotContains(t, err.Error(), "row 5")
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "CreateExchangeRates", mock.Anything, mock.Anything)
+}
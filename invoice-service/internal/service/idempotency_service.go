@@ -0,0 +1,79 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+const (
+	defaultIdempotencyWindow = 24 * time.Hour
+	maxIdempotencyKeyLength  = 255
+)
+
+// BeginIdempotentRequest claims a user's idempotency key for the request with the given fingerprint. If the key
+// was already used for the same request, it returns the stored response, and the request must not be made
+// again. Otherwise it returns nil, and the caller makes the request and then completes or releases the key.
+func (s *InvoiceService) BeginIdempotentRequest(ctx context.Context, userID int64, key, fingerprint string, now time.Time) ([]byte, error) {
+	if key == "" || len(key) > maxIdempotencyKeyLength {
+		return nil, fmt.Errorf("%w: idempotency keys must be between 1 and %d characters", ErrInvalidRequest, maxIdempotencyKeyLength)
+	}
+
+	reserved, err := s.repo.ReserveIdempotencyKey(ctx, &models.IdempotencyKey{
+		UserID:      userID,
+		Key:         key,
+		Fingerprint: fingerprint,
+		ExpiresAt:   now.Add(s.idempotencyWindow),
+	}, now)
+	if err != nil {
+		return nil, err
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	existing, err := s.repo.GetIdempotencyKey(ctx, userID, key)
+	if err != nil {
+		// The key was released or expired since it was found in use
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRequestInProgress
+		}
+		return nil, err
+	}
+	if existing.Fingerprint != fingerprint {
+		return nil, fmt.Errorf("%w: idempotency key %q was already used for a different request", ErrInvalidRequest, key)
+	}
+	if existing.Response == nil {
+		return nil, ErrRequestInProgress
+	}
+	return existing.Response, nil
+}
+
+// CompleteIdempotentRequest stores the response to a request made with an idempotency key, to answer its
+// retries with.
+func (s *InvoiceService) CompleteIdempotentRequest(ctx context.Context, userID int64, key, fingerprint string, response []byte) error {
+	return s.repo.SaveIdempotencyResponse(ctx, &models.IdempotencyKey{
+		UserID:      userID,
+		Key:         key,
+		Fingerprint: fingerprint,
+		Response:    response,
+	})
+}
+
+// ReleaseIdempotencyKey gives up an idempotency key whose request failed, so a retry makes the request again.
+func (s *InvoiceService) ReleaseIdempotencyKey(ctx context.Context, userID int64, key, fingerprint string) error {
+	return s.repo.DeleteIdempotencyKey(ctx, &models.IdempotencyKey{
+		UserID:      userID,
+		Key:         key,
+		Fingerprint: fingerprint,
+	})
+}
+
+// DeleteExpiredIdempotencyKeys deletes the idempotency keys that expired by now, returning how many were deleted.
+func (s *InvoiceService) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error) {
+	return s.repo.DeleteExpiredIdempotencyKeys(ctx, now)
+}
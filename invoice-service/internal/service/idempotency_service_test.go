@@ -0,0 +1,89 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBeginIdempotentRequest(t *testing.T) {
+	now := time.Date(2024, 9, 30, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name         string
+		reserved     bool
+		existing     *models.IdempotencyKey
+		existingErr  error
+		wantResponse []byte
+		wantErr      error
+	}{
+		{
+			name:     "new key",
+			reserved: true,
+		},
+		{
+			name:         "retry of a completed request",
+			existing:     &models.IdempotencyKey{Fingerprint: "abc", Response: []byte("response")},
+			wantResponse: []byte("response"),
+		},
+		{
+			name:     "retry of a request in progress",
+			existing: &models.IdempotencyKey{Fingerprint: "abc"},
+			wantErr:  service.ErrRequestInProgress,
+		},
+		{
+			name:     "key reused for a different request",
+			existing: &models.IdempotencyKey{Fingerprint: "def", Response: []byte("response")},
+			wantErr:  service.ErrInvalidRequest,
+		},
+		{
+			name:        "key released since it was found in use",
+			existingErr: sql.ErrNoRows,
+			wantErr:     service.ErrRequestInProgress,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo, service.WithIdempotencyWindow(time.Hour))
+
+			mockRepo.On("ReserveIdempotencyKey", mock.Anything, &models.IdempotencyKey{
+				UserID:      7,
+				Key:         "retry-1",
+				Fingerprint: "abc",
+				ExpiresAt:   now.Add(time.Hour),
+			}, now).Return(tt.reserved, nil)
+			if !tt.reserved {
+				mockRepo.On("GetIdempotencyKey", mock.Anything, int64(7), "retry-1").Return(tt.existing, tt.existingErr)
+			}
+
+			response, err := svc.BeginIdempotentRequest(context.Background(), 7, "retry-1", "abc", now)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantResponse, response)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestBeginIdempotentRequestInvalidKey(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	for _, key := range []string{"", strings.Repeat("k", 256)} {
+		_, err := svc.BeginIdempotentRequest(context.Background(), 7, key, "abc", time.Now())
+		assert.ErrorIs(t, err, service.ErrInvalidRequest)
+	}
+	mockRepo.AssertExpectations(t)
+}
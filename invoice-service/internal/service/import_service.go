@@ -0,0 +1,309 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+// MaxImportRows is the most rows, not counting the header, an import file can have.
+const MaxImportRows = 5000
+
+// The columns of an invoice import file. Each row is a line item, and rows with the same invoice_ref make up one
+// invoice, whose own fields are taken from its first row.
+var (
+	invoiceImportRequired = []string{"invoice_ref", "customer_id", "issue_date", "due_date", "currency", "description", "quantity", "unit_price"}
+	invoiceImportOptional = []string{
+		"discount_percentage", "fixed_discount", "account_name", "account_number", "bank_name", "routing_number", "note",
+		"item_discount_percentage", "item_fixed_discount", "tax_rate_ids",
+	}
+	// invoiceImportFields are the columns that belong to the invoice rather than the item. Later rows of an invoice
+	// may leave them blank, but must not contradict its first row.
+	invoiceImportFields = []string{
+		"customer_id", "issue_date", "due_date", "currency", "discount_percentage", "fixed_discount", "account_name",
+		"account_number", "bank_name", "routing_number", "note",
+	}
+)
+
+// importedInvoice is an invoice being put together from the rows of an import file.
+type importedInvoice struct {
+	ref     string
+	row     int // First row of the invoice
+	fields  map[string]string
+	invoice *models.Invoice
+	failed  bool
+}
+
+// ImportInvoices creates draft invoices from a CSV file, each checked against the same rules as CreateInvoice.
+// Either every invoice is created or, if any row is invalid, none are and the rejected rows are returned. A dry
+// run checks the file and calculates the invoices without creating them. Errors that concern the whole file,
+// like a bad header, are returned as ErrInvalidRequest.
+func (s *InvoiceService) ImportInvoices(ctx context.Context, userID int64, data []byte, dryRun bool) ([]*models.Invoice, []models.ImportError, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: reading header: %v", ErrInvalidRequest, err)
+	}
+	columns, err := readImportHeader(header, invoiceImportRequired, invoiceImportOptional)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var imported []*importedInvoice
+	byRef := map[string]*importedInvoice{}
+	var rowErrors []models.ImportError
+	rowError := func(row int, err error) {
+		rowErrors = append(rowErrors, models.ImportError{Row: row, Message: importReason(err)})
+	}
+
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if row > MaxImportRows+1 {
+			return nil, nil, fmt.Errorf("%w: the file has more than %d rows", ErrInvalidRequest, MaxImportRows)
+		}
+		if err != nil {
+			rowError(row, err)
+			continue
+		}
+
+		ref := columns.get(record, "invoice_ref")
+		if ref == "" {
+			rowError(row, fmt.Errorf("%w: invoice_ref is required", ErrInvalidRequest))
+			continue
+		}
+
+		entry, ok := byRef[ref]
+		if !ok {
+			entry = &importedInvoice{ref: ref, row: row, fields: map[string]string{}}
+			for _, field := range invoiceImportFields {
+				entry.fields[field] = columns.get(record, field)
+			}
+			entry.invoice, err = parseImportedInvoice(entry.fields)
+			if err != nil {
+				entry.failed = true
+				rowError(row, err)
+			}
+			byRef[ref] = entry
+			imported = append(imported, entry)
+		} else {
+			for _, field := range invoiceImportFields {
+				if value := columns.get(record, field); value != "" && value != entry.fields[field] {
+					entry.failed = true
+					rowError(row, fmt.Errorf("%w: %s does not match row %d of invoice %s", ErrInvalidRequest, field, entry.row, ref))
+					break
+				}
+			}
+		}
+
+		item, err := parseImportedItem(columns, record)
+		if err != nil {
+			entry.failed = true
+			rowError(row, err)
+			continue
+		}
+		if entry.invoice != nil {
+			entry.invoice.Items = append(entry.invoice.Items, item)
+		}
+	}
+
+	if len(imported) == 0 && len(rowErrors) == 0 {
+		return nil, nil, fmt.Errorf("%w: the file contains no invoices", ErrInvalidRequest)
+	}
+
+	// Check the complete invoices and calculate their amounts
+	var invoices []*models.Invoice
+	for _, entry := range imported {
+		if entry.failed {
+			continue
+		}
+		entry.invoice.UserID = userID
+		err = s.prepareDraft(ctx, entry.invoice)
+		if err != nil {
+			if !errors.Is(err, ErrInvalidRequest) {
+				return nil, nil, err
+			}
+			rowError(entry.row, fmt.Errorf("invoice %s: %s", entry.ref, importReason(err)))
+			continue
+		}
+		invoices = append(invoices, entry.invoice)
+	}
+
+	if len(rowErrors) > 0 {
+		sort.SliceStable(rowErrors, func(i, j int) bool { return rowErrors[i].Row < rowErrors[j].Row })
+		return invoices, rowErrors, nil
+	}
+	if dryRun {
+		return invoices, nil, nil
+	}
+
+	for _, invoice := range invoices {
+		invoice.RecordActivityFunc(models.ActivityInvoiceCreated, func() string {
+			return fmt.Sprintf("Imported invoice %s", invoice.InvoiceNumber)
+		})
+	}
+
+	// The repository numbers the invoices in the same transaction as they are inserted
+	err = s.repo.CreateInvoices(ctx, invoices)
+	if err != nil {
+		return nil, nil, err
+	}
+	return invoices, nil, nil
+}
+
+func parseImportedInvoice(fields map[string]string) (*models.Invoice, error) {
+	customerID, err := strconv.ParseInt(fields["customer_id"], 10, 64)
+	if err != nil || customerID <= 0 {
+		return nil, fmt.Errorf("%w: invalid customer_id %q", ErrInvalidRequest, fields["customer_id"])
+	}
+	issueDate, err := time.Parse(time.DateOnly, fields["issue_date"])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid issue_date %q, use YYYY-MM-DD", ErrInvalidRequest, fields["issue_date"])
+	}
+	dueDate, err := time.Parse(time.DateOnly, fields["due_date"])
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid due_date %q, use YYYY-MM-DD", ErrInvalidRequest, fields["due_date"])
+	}
+	discountPercentage, err := parseImportInt(fields, "discount_percentage")
+	if err != nil {
+		return nil, err
+	}
+	fixedDiscount, err := parseImportInt(fields, "fixed_discount")
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.Invoice{
+		CustomerID:         customerID,
+		IssueDate:          issueDate,
+		DueDate:            dueDate,
+		Currency:           fields["currency"],
+		DiscountPercentage: discountPercentage,
+		FixedDiscount:      fixedDiscount,
+		AccountName:        fields["account_name"],
+		AccountNumber:      fields["account_number"],
+		BankName:           fields["bank_name"],
+		RoutingNumber:      fields["routing_number"],
+		Note:               fields["note"],
+	}, nil
+}
+
+func parseImportedItem(columns importColumns, record []string) (*models.InvoiceItem, error) {
+	fields := map[string]string{}
+	for _, field := range []string{"quantity", "unit_price", "item_discount_percentage", "item_fixed_discount"} {
+		fields[field] = columns.get(record, field)
+	}
+
+	quantity, err := strconv.ParseInt(fields["quantity"], 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid quantity %q", ErrInvalidRequest, fields["quantity"])
+	}
+	unitPrice, err := strconv.ParseInt(fields["unit_price"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid unit_price %q, use cents", ErrInvalidRequest, fields["unit_price"])
+	}
+	discountPercentage, err := parseImportInt(fields, "item_discount_percentage")
+	if err != nil {
+		return nil, err
+	}
+	fixedDiscount, err := parseImportInt(fields, "item_fixed_discount")
+	if err != nil {
+		return nil, err
+	}
+
+	// Tax rates are listed by ID, separated by semicolons
+	var taxRateIDs []int64
+	for _, id := range strings.Split(columns.get(record, "tax_rate_ids"), ";") {
+		if id = strings.TrimSpace(id); id == "" {
+			continue
+		}
+		taxRateID, err := strconv.ParseInt(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid tax rate ID %q", ErrInvalidRequest, id)
+		}
+		taxRateIDs = append(taxRateIDs, taxRateID)
+	}
+
+	return &models.InvoiceItem{
+		Description:        columns.get(record, "description"),
+		Quantity:           int32(quantity),
+		UnitPrice:          unitPrice,
+		DiscountPercentage: discountPercentage,
+		FixedDiscount:      fixedDiscount,
+		TaxRateIDs:         taxRateIDs,
+	}, nil
+}
+
+// importReason returns why an import row was rejected, without the ErrInvalidRequest prefix.
+func importReason(err error) string {
+	return strings.TrimPrefix(err.Error(), ErrInvalidRequest.Error()+": ")
+}
+
+// parseImportInt parses an optional whole-number column, which is zero when left blank.
+func parseImportInt(fields map[string]string, name string) (int64, error) {
+	if fields[name] == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(fields[name], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid %s %q", ErrInvalidRequest, name, fields[name])
+	}
+	return n, nil
+}
+
+// importColumns maps the column names of an import file to their positions.
+type importColumns map[string]int
+
+// readImportHeader reads the header of an import file. Column names are matched without regard to case and can
+// come in any order, but every required column must be present and no unknown ones.
+func readImportHeader(header, required, optional []string) (importColumns, error) {
+	known := map[string]bool{}
+	for _, name := range append(append([]string{}, required...), optional...) {
+		known[name] = true
+	}
+
+	columns := importColumns{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch {
+		case !known[name]:
+			return nil, fmt.Errorf("%w: unknown column %q", ErrInvalidRequest, name)
+		case columns.has(name):
+			return nil, fmt.Errorf("%w: column %q appears more than once", ErrInvalidRequest, name)
+		}
+		columns[name] = i
+	}
+	for _, name := range required {
+		if !columns.has(name) {
+			return nil, fmt.Errorf("%w: header must include %s", ErrInvalidRequest, strings.Join(required, ","))
+		}
+	}
+	return columns, nil
+}
+
+func (c importColumns) has(name string) bool {
+	_, ok := c[name]
+	return ok
+}
+
+// get returns the trimmed value of the named column, or "" if the file has no such column.
+func (c importColumns) get(record []string, name string) string {
+	i, ok := c[name]
+	if !ok || i >= len(record) {
+		return ""
+	}
+	return strings.TrimSpace(record[i])
+}
@@ -0,0 +1,117 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestImportInvoices(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	mockRepo.On("GetTaxRatesByIDs", mock.Anything, int64(1), []int64{3}).Return([]*models.TaxRate{
+		{ID: 3, UserID: 1, Name: "VAT", Rate: 1000},
+	}, nil)
+	mockRepo.On("CreateInvoices", mock.Anything, mock.Anything).Return(nil)
+
+	// Columns come in any order and invoice fields may be left blank after an invoice's first row
+	data := []byte("Invoice_Ref,description,quantity,unit_price,customer_id,issue_date,due_date,currency,tax_rate_ids,discount_percentage\n" +
+		"A,Design,2,10000,2,2024-03-01,2024-03-31,usd,,1000\n" +
+		"B,Hosting,1,5000,3,2024-03-05,2024-04-04,EUR,3,\n" +
+		"A,Support,1,5000,,,,,,\n")
+	invoices, importErrors, err := svc.ImportInvoices(context.Background(), 1, data, false)
+
+	assert.NoError(t, err)
+	assert.Empty(t, importErrors)
+	if assert.Len(t, invoices, 2) {
+		a, b := invoices[0], invoices[1]
+		assert.Equal(t, int64(1), a.UserID)
+		assert.Equal(t, int64(2), a.CustomerID)
+		assert.Equal(t, "USD", a.Currency)
+		assert.Equal(t, models.StatusDraft, a.Status)
+		assert.Equal(t, time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), a.DueDate)
+		assert.Len(t, a.Items, 2)
+		assert.Equal(t, int64(22500), a.Total) // 25000 less 10%
+
+		assert.Equal(t, "EUR", b.Currency)
+		assert.Equal(t, int64(5500), b.Total) // 5000 plus 10% VAT
+	}
+	mockRepo.AssertCalled(t, "CreateInvoices", mock.Anything, invoices)
+}
+
+func TestImportInvoicesDryRun(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	data := []byte("invoice_ref,customer_id,issue_date,due_date,currency,description,quantity,unit_price\n" +
+		"A,2,2024-03-01,2024-03-31,USD,Design,2,10000\n")
+	invoices, importErrors, err := svc.ImportInvoices(context.Background(), 1, data, true)
+
+	assert.NoError(t, err)
+	assert.Empty(t, importErrors)
+	assert.Len(t, invoices, 1)
+	assert.Equal(t, int64(20000), invoices[0].Total)
+	mockRepo.AssertNotCalled(t, "CreateInvoices", mock.Anything, mock.Anything)
+}
+
+func TestImportInvoicesInvalidRows(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	data := []byte("invoice_ref,customer_id,issue_date,due_date,currency,description,quantity,unit_price,fixed_discount\n" +
+		"A,2,2024-03-01,2024-03-31,USD,Design,2,10000,\n" +
+		"B,2,01/03/2024,2024-03-31,USD,Design,1,10000,\n" +
+		"A,3,,,,Support,1,5000,\n" +
+		"C,2,2024-03-01,2024-03-31,USD,Design,two,10000,\n" +
+		",2,2024-03-01,2024-03-31,USD,Design,1,10000,\n" +
+		"D,2,2024-03-01,2024-03-31,XYZ,Design,1,10000,\n" +
+		"E,2,2024-03-01,2024-03-31,USD,Design,1,10000,20000\n" +
+		"F,2,2024-03-01,2024-03-31,USD,Design,1,10000,\n")
+	invoices, importErrors, err := svc.ImportInvoices(context.Background(), 1, data, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ImportError{
+		{Row: 3, Message: `invalid issue_date "01/03/2024", use YYYY-MM-DD`},
+		{Row: 4, Message: "customer_id does not match row 2 of invoice A"},
+		{Row: 5, Message: `invalid quantity "two"`},
+		{Row: 6, Message: "invoice_ref is required"},
+		{Row: 7, Message: `invoice D: "XYZ" is not an ISO 4217 currency code`},
+		{Row: 8, Message: "invoice E: the fixed discount is more than the amount left after the other discounts"},
+	}, importErrors)
+
+	// Only the valid invoice is returned, and nothing is created
+	if assert.Len(t, invoices, 1) {
+		assert.Equal(t, int64(10000), invoices[0].Total)
+	}
+	mockRepo.AssertNotCalled(t, "CreateInvoices", mock.Anything, mock.Anything)
+}
+
+func TestImportInvoicesInvalidFile(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"empty", ""},
+		{"missing column", "invoice_ref,customer_id,issue_date,due_date,currency,description,quantity\n"},
+		{"unknown column", "invoice_ref,customer_id,issue_date,due_date,currency,description,quantity,unit_price,colour\n"},
+		{"repeated column", "invoice_ref,customer_id,issue_date,due_date,currency,description,quantity,unit_price,Quantity\n"},
+		{"no invoices", "invoice_ref,customer_id,issue_date,due_date,currency,description,quantity,unit_price\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			_, _, err := svc.ImportInvoices(context.Background(), 1, []byte(tt.data), false)
+
+			assert.ErrorIs(t, err, service.ErrInvalidRequest)
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+const (
+	defaultPageSize = 10
+	maxPageSize     = 100
+
+	// Invoices are listed newest first unless another order is asked for
+	defaultInvoiceSort = "-" + models.SortIssueDate
+)
+
+var invoiceSortFields = map[string]bool{
+	models.SortIssueDate:     true,
+	models.SortDueDate:       true,
+	models.SortCreatedAt:     true,
+	models.SortTotal:         true,
+	models.SortInvoiceNumber: true,
+}
+
+// ListInvoices returns a page of the invoices matching filter, sorted by sort: a field name, prefixed
+// with "-" for descending order. The page token of the previous page continues the list where it left
+// off; the returned token is empty on the last page.
+func (s *InvoiceService) ListInvoices(ctx context.Context, filter models.InvoiceFilter, sort string, pageSize int, pageToken string) ([]*models.Invoice, string, error) {
+	err := validateInvoiceFilter(&filter, sort)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if pageToken != "" {
+		cursor, err := decodeInvoiceCursor(pageToken)
+		if err != nil || cursor.SortBy != filter.SortBy || cursor.Descending != filter.Descending {
+			return nil, "", fmt.Errorf("%w: invalid page token", ErrInvalidRequest)
+		}
+		filter.After = cursor
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+
+	// Fetch one invoice more than the page holds to find out whether there is another page
+	filter.Limit = pageSize + 1
+	invoices, err := s.repo.ListInvoices(ctx, filter)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(invoices) <= pageSize {
+		return invoices, "", nil
+	}
+
+	invoices = invoices[:pageSize]
+	nextPageToken, err := encodeInvoiceCursor(models.CursorAfter(invoices[pageSize-1], filter.SortBy, filter.Descending))
+	if err != nil {
+		return nil, "", err
+	}
+	return invoices, nextPageToken, nil
+}
+
+func validateInvoiceFilter(filter *models.InvoiceFilter, sort string) error {
+	for _, status := range filter.Statuses {
+		if _, ok := invoiceTransitions[status]; !ok {
+			return fmt.Errorf("%w: unknown status %q", ErrInvalidRequest, status)
+		}
+	}
+
+	if filter.Currency != "" {
+		currency, err := normalizeCurrency(filter.Currency)
+		if err != nil {
+			return err
+		}
+		filter.Currency = currency
+	}
+
+	// Date ranges are whole days
+	for _, date := range []*time.Time{&filter.IssueDateFrom, &filter.IssueDateTo, &filter.DueDateFrom, &filter.DueDateTo} {
+		if !date.IsZero() {
+			*date = truncateToDate(*date)
+		}
+	}
+
+	switch {
+	case filter.MinTotal < 0 || filter.MaxTotal < 0:
+		return fmt.Errorf("%w: amount range must not be negative", ErrInvalidRequest)
+	case filter.MaxTotal != 0 && filter.MinTotal > filter.MaxTotal:
+		return fmt.Errorf("%w: minimum amount is greater than the maximum amount", ErrInvalidRequest)
+	case !filter.IssueDateTo.IsZero() && filter.IssueDateFrom.After(filter.IssueDateTo):
+		return fmt.Errorf("%w: issue date range ends before it starts", ErrInvalidRequest)
+	case !filter.DueDateTo.IsZero() && filter.DueDateFrom.After(filter.DueDateTo):
+		return fmt.Errorf("%w: due date range ends before it starts", ErrInvalidRequest)
+	}
+
+	if sort == "" {
+		sort = defaultInvoiceSort
+	}
+	filter.SortBy, filter.Descending = strings.TrimPrefix(sort, "-"), strings.HasPrefix(sort, "-")
+	if !invoiceSortFields[filter.SortBy] {
+		return fmt.Errorf("%w: invoices cannot be sorted by %q", ErrInvalidRequest, filter.SortBy)
+	}
+	return nil
+}
+
+// encodeInvoiceCursor turns a cursor into an opaque page token.
+func encodeInvoiceCursor(cursor *models.InvoiceCursor) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeInvoiceCursor(pageToken string) (*models.InvoiceCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor models.InvoiceCursor
+	err = json.Unmarshal(data, &cursor)
+	if err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
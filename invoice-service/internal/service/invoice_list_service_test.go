@@ -0,0 +1,120 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestListInvoices(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	invoices := []*models.Invoice{
+		{ID: 1, IssueDate: date(2024, 3, 3)},
+		{ID: 2, IssueDate: date(2024, 3, 2)},
+		{ID: 3, IssueDate: date(2024, 3, 1)},
+	}
+	mockRepo.On("ListInvoices", mock.Anything, mock.MatchedBy(func(filter models.InvoiceFilter) bool {
+		return filter.After == nil
+	})).Return(invoices, nil)
+
+	// The repository is asked for one invoice more than the page holds
+	result, nextPageToken, err := svc.ListInvoices(context.Background(), models.InvoiceFilter{UserID: 1}, "", 2, "")
+
+	assert.NoError(t, err)
+	assert.Equal(t, invoices[:2], result)
+	assert.NotEmpty(t, nextPageToken)
+	mockRepo.AssertCalled(t, "ListInvoices", mock.Anything, models.InvoiceFilter{
+		UserID:     1,
+		SortBy:     models.SortIssueDate,
+		Descending: true,
+		Limit:      3,
+	})
+
+	// The next page continues after the last invoice of this one
+	mockRepo.On("ListInvoices", mock.Anything, mock.MatchedBy(func(filter models.InvoiceFilter) bool {
+		return filter.After != nil
+	})).Return(invoices[2:], nil)
+
+	result, nextPageToken, err = svc.ListInvoices(context.Background(), models.InvoiceFilter{UserID: 1}, "", 2, nextPageToken)
+
+	assert.NoError(t, err)
+	assert.Equal(t, invoices[2:], result)
+	assert.Empty(t, nextPageToken)
+	mockRepo.AssertCalled(t, "ListInvoices", mock.Anything, models.InvoiceFilter{
+		UserID:     1,
+		SortBy:     models.SortIssueDate,
+		Descending: true,
+		After:      &models.InvoiceCursor{SortBy: models.SortIssueDate, Descending: true, Time: date(2024, 3, 2), ID: 2},
+		Limit:      3,
+	})
+}
+
+func TestListInvoices_Filters(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	mockRepo.On("ListInvoices", mock.Anything, models.InvoiceFilter{
+		UserID:        1,
+		Statuses:      []string{models.StatusUnpaid, models.StatusOverdue},
+		CustomerID:    4,
+		Currency:      "EUR",
+		IssueDateFrom: date(2024, 1, 1),
+		IssueDateTo:   date(2024, 1, 31),
+		MinTotal:      1000,
+		MaxTotal:      50000,
+		SortBy:        models.SortTotal,
+		Limit:         101,
+	}).Return([]*models.Invoice{}, nil)
+
+	_, _, err := svc.ListInvoices(context.Background(), models.InvoiceFilter{
+		UserID:        1,
+		Statuses:      []string{models.StatusUnpaid, models.StatusOverdue},
+		CustomerID:    4,
+		Currency:      "eur",
+		IssueDateFrom: time.Date(2024, 1, 1, 9, 30, 0, 0, time.UTC),
+		IssueDateTo:   time.Date(2024, 1, 31, 18, 0, 0, 0, time.UTC),
+		MinTotal:      1000,
+		MaxTotal:      50000,
+	}, "total", 500, "")
+
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestListInvoices_Invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    models.InvoiceFilter
+		sort      string
+		pageToken string
+	}{
+		{"unknown status", models.InvoiceFilter{Statuses: []string{"lost"}}, "", ""},
+		{"unknown currency", models.InvoiceFilter{Currency: "XYZ"}, "", ""},
+		{"unknown sort field", models.InvoiceFilter{}, "-customer_id", ""},
+		{"negative amount", models.InvoiceFilter{MinTotal: -1}, "", ""},
+		{"inverted amount range", models.InvoiceFilter{MinTotal: 500, MaxTotal: 100}, "", ""},
+		{"inverted date range", models.InvoiceFilter{DueDateFrom: date(2024, 2, 1), DueDateTo: date(2024, 1, 1)}, "", ""},
+		{"malformed page token", models.InvoiceFilter{}, "", "not a token"},
+		// eyJzb3J0X2J5IjoidG90YWwiLCJkZXNjZW5kaW5nIjpmYWxzZSwiaWQiOjF9 is {"sort_by":"total","descending":false,"id":1}
+		{"page token for another sort", models.InvoiceFilter{}, "-issue_date", "eyJzb3J0X2J5IjoidG90YWwiLCJkZXNjZW5kaW5nIjpmYWxzZSwiaWQiOjF9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			_, _, err := svc.ListInvoices(context.Background(), tt.filter, tt.sort, 10, tt.pageToken)
+
+			assert.ErrorIs(t, err, service.ErrInvalidRequest)
+			mockRepo.AssertNotCalled(t, "ListInvoices", mock.Anything, mock.Anything)
+		})
+	}
+}
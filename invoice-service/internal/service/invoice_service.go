@@ -2,8 +2,12 @@ package service
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/emzola/numer/invoice-service/internal/models"
 	"github.com/shopspring/decimal"
@@ -13,38 +17,176 @@ var (
 	ErrNotFound               = errors.New("not found")
 	ErrInvalidRequest         = errors.New("the request is invalid")
 	ErrInvoiceNumberIncrement = errors.New("failed to increment invoice number")
+	ErrInvalidTransition      = errors.New("the invoice cannot move to the requested status")
+	ErrInvoiceLocked          = errors.New("the items and amounts of an issued invoice cannot be changed")
+	ErrConflict               = errors.New("the invoice was changed by another request, please retry")
+	ErrNotDraft               = errors.New("only draft invoices can be deleted")
+	ErrRequestInProgress      = errors.New("a request with the same idempotency key is in progress, please retry")
 )
 
 type invoiceRepository interface {
 	CreateInvoice(ctx context.Context, invoice *models.Invoice) error
+	CreateInvoices(ctx context.Context, invoices []*models.Invoice) error
 	GetInvoiceByID(ctx context.Context, invoiceID int64) (*models.Invoice, error)
-	UpdateInvoice(ctx context.Context, invoice *models.Invoice) error
-	ListInvoicesByUserID(ctx context.Context, userID int64, pageSize int, pageToken string) ([]*models.Invoice, string, error)
-	IncrementInvoiceNumber(ctx context.Context) (int64, error)
+	UpdateInvoice(ctx context.Context, invoice *models.Invoice, replaceItems bool) error
+	UpdateInvoiceStatus(ctx context.Context, invoice *models.Invoice, from, to string) error
+	MarkOverdueInvoices(ctx context.Context, now time.Time, limit int, record func(*models.Invoice)) ([]*models.Invoice, error)
+	DeleteInvoice(ctx context.Context, invoice *models.Invoice) error
+	ArchiveInvoice(ctx context.Context, invoice *models.Invoice) error
+	CreateTaxRate(ctx context.Context, taxRate *models.TaxRate) error
+	GetTaxRatesByIDs(ctx context.Context, userID int64, taxRateIDs []int64) ([]*models.TaxRate, error)
+	ListTaxRatesByUserID(ctx context.Context, userID int64) ([]*models.TaxRate, error)
+	UpdateTaxRate(ctx context.Context, taxRate *models.TaxRate) error
+	DeleteTaxRate(ctx context.Context, taxRateID, userID int64) error
+	IssueInvoice(ctx context.Context, invoice *models.Invoice, from string) error
+	GetBaseCurrency(ctx context.Context, userID int64) (string, error)
+	SetBaseCurrency(ctx context.Context, userID int64, baseCurrency string) error
+	CreateExchangeRates(ctx context.Context, rates []*models.ExchangeRate) error
+	ListExchangeRatesByUserID(ctx context.Context, userID int64) ([]*models.ExchangeRate, error)
+	GetExchangeRate(ctx context.Context, userID int64, baseCurrency, currency string, date time.Time) (*models.ExchangeRate, error)
+	DeleteExchangeRate(ctx context.Context, exchangeRateID, userID int64) error
+	CreateRecurringInvoice(ctx context.Context, recurring *models.RecurringInvoice) error
+	GetRecurringInvoiceByID(ctx context.Context, recurringInvoiceID, userID int64) (*models.RecurringInvoice, error)
+	ListRecurringInvoicesByUserID(ctx context.Context, userID int64) ([]*models.RecurringInvoice, error)
+	ListDueRecurringInvoices(ctx context.Context, now time.Time, limit int) ([]*models.RecurringInvoice, error)
+	UpdateRecurringInvoiceSchedule(ctx context.Context, recurring *models.RecurringInvoice, from string) error
+	CreateRecurringInvoiceOccurrence(ctx context.Context, recurring *models.RecurringInvoice, invoice *models.Invoice, previousRunDate time.Time) error
+	ListInvoicesByRecurringInvoiceID(ctx context.Context, recurringInvoiceID int64) ([]*models.Invoice, error)
+	GetCreditedQuantities(ctx context.Context, invoiceID int64) (map[int64]int32, error)
+	CreateCreditNote(ctx context.Context, creditNote *models.CreditNote, invoice *models.Invoice, status string) error
+	GetCreditNoteByID(ctx context.Context, creditNoteID, userID int64) (*models.CreditNote, error)
+	ListCreditNotesByUserID(ctx context.Context, userID, invoiceID int64) ([]*models.CreditNote, error)
+	ApplyCreditNote(ctx context.Context, allocation *models.CreditNoteAllocation, creditNote *models.CreditNote, invoice *models.Invoice, status string) error
+	RefundCreditNote(ctx context.Context, allocation *models.CreditNoteAllocation, creditNote *models.CreditNote) error
+	CreatePayment(ctx context.Context, payment *models.Payment, invoice *models.Invoice, status string) error
+	GetPaymentByID(ctx context.Context, paymentID int64) (*models.Payment, error)
+	ListPaymentsByInvoiceID(ctx context.Context, invoiceID int64) ([]*models.Payment, error)
+	RefundPayment(ctx context.Context, payment *models.Payment, invoice *models.Invoice, status string) error
+	ListInvoices(ctx context.Context, filter models.InvoiceFilter) ([]*models.Invoice, error)
+	SearchInvoices(ctx context.Context, search models.InvoiceSearch) ([]*models.InvoiceSearchResult, error)
+	GetNumberingScheme(ctx context.Context, userID int64) (*models.NumberingScheme, error)
+	SetNumberingScheme(ctx context.Context, scheme *models.NumberingScheme) error
+	GetInvoiceNumberCounter(ctx context.Context, userID int64, year int) (int64, error)
+	CreateQuote(ctx context.Context, quote *models.Quote) error
+	GetQuoteByID(ctx context.Context, quoteID, userID int64) (*models.Quote, error)
+	ListQuotesByUserID(ctx context.Context, userID int64, status string) ([]*models.Quote, error)
+	UpdateQuoteStatus(ctx context.Context, quote *models.Quote, from string) error
+	ExpireQuotes(ctx context.Context, before time.Time, limit int) ([]*models.Quote, error)
+	CreateQuoteInvoice(ctx context.Context, quote *models.Quote, invoice *models.Invoice) error
+	CreateInvoiceShare(ctx context.Context, share *models.InvoiceShare) error
+	GetInvoiceShareByID(ctx context.Context, shareID int64) (*models.InvoiceShare, error)
+	ListInvoiceSharesByInvoiceID(ctx context.Context, invoiceID int64) ([]*models.InvoiceShare, error)
+	RevokeInvoiceShare(ctx context.Context, share *models.InvoiceShare) error
+	RecordInvoiceShareView(ctx context.Context, share *models.InvoiceShare, invoice *models.Invoice) error
+	CreateAttachment(ctx context.Context, attachment *models.Attachment, maxCount int, maxTotalSize int64) error
+	GetAttachmentByID(ctx context.Context, attachmentID int64) (*models.Attachment, error)
+	ListAttachmentsByInvoiceID(ctx context.Context, invoiceID int64) ([]*models.Attachment, error)
+	DeleteAttachment(ctx context.Context, attachmentID int64) error
+	GetLateFeePolicy(ctx context.Context, userID int64) (*models.LateFeePolicy, error)
+	SetLateFeePolicy(ctx context.Context, policy *models.LateFeePolicy) error
+	DeleteLateFeePolicy(ctx context.Context, userID int64) error
+	ListInvoicesDueLateFees(ctx context.Context, today time.Time, limit int) ([]*models.Invoice, error)
+	GetLateFeeByID(ctx context.Context, lateFeeID int64) (*models.LateFee, error)
+	ApplyLateFee(ctx context.Context, invoice *models.Invoice, fee *models.LateFee, assessedOn time.Time) error
+	ReverseLateFee(ctx context.Context, fee *models.LateFee, invoice *models.Invoice, status string) error
+	CreateActivities(ctx context.Context, invoice *models.Invoice) error
+	ListDueOutboxEvents(ctx context.Context, now time.Time, limit int) ([]*models.OutboxEvent, error)
+	ListOutboxEvents(ctx context.Context, status string, limit int) ([]*models.OutboxEvent, error)
+	GetOutboxStats(ctx context.Context) (*models.OutboxStats, error)
+	MarkOutboxEventPublished(ctx context.Context, event *models.OutboxEvent) error
+	MarkOutboxEventFailed(ctx context.Context, event *models.OutboxEvent) error
+	RetryOutboxEvent(ctx context.Context, eventID int64) (*models.OutboxEvent, error)
+	DeletePublishedOutboxEvents(ctx context.Context, before time.Time) (int64, error)
+	ReserveIdempotencyKey(ctx context.Context, key *models.IdempotencyKey, now time.Time) (bool, error)
+	GetIdempotencyKey(ctx context.Context, userID int64, key string) (*models.IdempotencyKey, error)
+	SaveIdempotencyResponse(ctx context.Context, key *models.IdempotencyKey) error
+	DeleteIdempotencyKey(ctx context.Context, key *models.IdempotencyKey) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error)
+	CreateBrandingProfile(ctx context.Context, profile *models.BrandingProfile) error
+	GetBrandingProfile(ctx context.Context, userID, profileID int64) (*models.BrandingProfile, error)
+	ListBrandingProfilesByUserID(ctx context.Context, userID int64) ([]*models.BrandingProfile, error)
+	UpdateBrandingProfile(ctx context.Context, profile *models.BrandingProfile) error
+	SetBrandingProfileLogo(ctx context.Context, profile *models.BrandingProfile) error
+	DeleteBrandingProfile(ctx context.Context, profileID, userID int64) error
+	CreateCatalogItem(ctx context.Context, item *models.CatalogItem) error
+	GetCatalogItem(ctx context.Context, userID, itemID int64) (*models.CatalogItem, error)
+	GetCatalogItemBySKU(ctx context.Context, userID int64, sku string) (*models.CatalogItem, error)
+	GetCatalogItemsByIDs(ctx context.Context, userID int64, itemIDs []int64) ([]*models.CatalogItem, error)
+	ListCatalogItemsByUserID(ctx context.Context, userID int64) ([]*models.CatalogItem, error)
+	UpdateCatalogItem(ctx context.Context, item *models.CatalogItem) error
+	DeleteCatalogItem(ctx context.Context, itemID, userID int64) error
+}
+
+// blobStore keeps the contents of attachments. Keys are slash-separated paths.
+type blobStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
 }
 
 type InvoiceService struct {
-	repo invoiceRepository
+	repo              invoiceRepository
+	shareSecret       []byte
+	blobs             blobStore
+	idempotencyWindow time.Duration
+}
+
+// Option configures an InvoiceService.
+type Option func(*InvoiceService)
+
+// WithShareSecret sets the key invoice share links are signed with. Share links can't be created without one.
+func WithShareSecret(secret []byte) Option {
+	return func(s *InvoiceService) {
+		s.shareSecret = secret
+	}
 }
 
-func NewInvoiceService(repo invoiceRepository) *InvoiceService {
-	return &InvoiceService{repo: repo}
+// WithBlobStore sets where attachment contents are kept. Attachments can't be added without one.
+func WithBlobStore(store blobStore) Option {
+	return func(s *InvoiceService) {
+		s.blobs = store
+	}
+}
+
+// WithIdempotencyWindow sets how long a request made with an idempotency key is remembered.
+func WithIdempotencyWindow(window time.Duration) Option {
+	return func(s *InvoiceService) {
+		s.idempotencyWindow = window
+	}
+}
+
+func NewInvoiceService(repo invoiceRepository, opts ...Option) *InvoiceService {
+	s := &InvoiceService{repo: repo, idempotencyWindow: defaultIdempotencyWindow}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *InvoiceService) CreateInvoice(ctx context.Context, invoice *models.Invoice) (*models.Invoice, error) {
-	invoiceNumber, err := s.repo.IncrementInvoiceNumber(ctx)
+	err := s.applyBrandingProfile(ctx, invoice)
+	if err != nil {
+		return nil, err
+	}
+	err = s.resolveCatalogItems(ctx, invoice.UserID, invoice.Items)
 	if err != nil {
 		return nil, err
 	}
-	invoice.InvoiceNumber = fmt.Sprintf("%06d", invoiceNumber)
-	invoice.Status = "draft"
 
-	// Calculate invoice amounts
-	subtotal, discount, total := calculateInvoiceAmounts(invoice.Items, invoice.DiscountPercentage) // e.g. 1000 discountPercentage == 10% discount
-	invoice.Subtotal = subtotal
-	invoice.DiscountAmount = discount
-	invoice.Total = total
+	invoice.RecordActivityFunc(models.ActivityInvoiceCreated, func() string {
+		return fmt.Sprintf("Created invoice %s", invoice.InvoiceNumber)
+	})
+	return s.createInvoice(ctx, invoice)
+}
 
+// createInvoice saves a new draft invoice along with the activities recorded on it.
+func (s *InvoiceService) createInvoice(ctx context.Context, invoice *models.Invoice) (*models.Invoice, error) {
+	err := s.prepareDraft(ctx, invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	// The repository numbers the invoice in the same transaction as it is inserted
 	err = s.repo.CreateInvoice(ctx, invoice)
 	if err != nil {
 		return nil, err
@@ -53,35 +195,458 @@ func (s *InvoiceService) CreateInvoice(ctx context.Context, invoice *models.Invo
 	return invoice, nil
 }
 
+// prepareDraft validates a new invoice and calculates its amounts, ready to be saved as a draft.
+func (s *InvoiceService) prepareDraft(ctx context.Context, invoice *models.Invoice) error {
+	currency, err := normalizeCurrency(invoice.Currency)
+	if err != nil {
+		return err
+	}
+	invoice.Currency = currency
+
+	err = validateAdjustments(invoice.Items, adjustmentsOf(invoice))
+	if err != nil {
+		return err
+	}
+	err = s.resolveTaxes(ctx, invoice.UserID, invoice.Items, invoice.Charges)
+	if err != nil {
+		return err
+	}
+
+	invoice.Status = models.StatusDraft
+
+	// Calculate invoice amounts
+	calculateInvoiceAmounts(invoice.Items, adjustmentsOf(invoice)).applyTo(invoice) // e.g. 1000 discountPercentage == 10% discount
+	return nil
+}
+
 func (s *InvoiceService) GetInvoice(ctx context.Context, invoiceID int64) (*models.Invoice, error) {
 	invoice, err := s.repo.GetInvoiceByID(ctx, invoiceID)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
 		return nil, err
 	}
 	return invoice, nil
 }
 
-func (s *InvoiceService) UpdateInvoice(ctx context.Context, invoice *models.Invoice) error {
-	// Recalculate invoice amounts
-	subtotal, discount, total := calculateInvoiceAmounts(invoice.Items, invoice.DiscountPercentage)
-	invoice.Subtotal = subtotal
-	invoice.DiscountAmount = discount
-	invoice.Total = total
+// invoiceUpdateFields are the fields an invoice update can name, in the order they are applied.
+var invoiceUpdateFields = []string{
+	"status", "issue_date", "due_date", "currency", "items", "discount_percentage", "fixed_discount", "charges",
+	"account_name", "account_number", "bank_name", "routing_number", "note", "branding_profile_id",
+}
 
-	err := s.repo.UpdateInvoice(ctx, invoice)
+// UpdateInvoice copies the named fields of changes onto the invoice and saves it, leaving every other field
+// as it is. Naming no fields updates all of them. If changes.Version is set the invoice must still be at that
+// version, otherwise ErrConflict is returned. Status changes must go through the lifecycle methods, and the
+// items and amounts are frozen once the invoice leaves draft.
+func (s *InvoiceService) UpdateInvoice(ctx context.Context, changes *models.Invoice, fields []string) (*models.Invoice, error) {
+	current, err := s.GetInvoice(ctx, changes.ID)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	if changes.Version != 0 && changes.Version != current.Version {
+		return nil, ErrConflict
+	}
+
+	if len(fields) == 0 {
+		fields = invoiceUpdateFields
+	}
+	invoice := *current
+	for _, field := range fields {
+		switch field {
+		case "status":
+			if changes.Status != "" {
+				invoice.Status = changes.Status
+			}
+		case "issue_date":
+			invoice.IssueDate = changes.IssueDate
+		case "due_date":
+			invoice.DueDate = changes.DueDate
+		case "currency":
+			invoice.Currency = changes.Currency
+		case "items":
+			invoice.Items = changes.Items
+		case "discount_percentage":
+			invoice.DiscountPercentage = changes.DiscountPercentage
+		case "fixed_discount":
+			invoice.FixedDiscount = changes.FixedDiscount
+		case "charges":
+			invoice.Charges = changes.Charges
+		case "account_name":
+			invoice.AccountName = changes.AccountName
+		case "account_number":
+			invoice.AccountNumber = changes.AccountNumber
+		case "bank_name":
+			invoice.BankName = changes.BankName
+		case "routing_number":
+			invoice.RoutingNumber = changes.RoutingNumber
+		case "note":
+			invoice.Note = changes.Note
+		case "branding_profile_id":
+			invoice.BrandingProfileID = changes.BrandingProfileID
+		default:
+			return nil, fmt.Errorf("%w: unknown invoice field %q", ErrInvalidRequest, field)
+		}
+	}
+
+	if invoice.Status != current.Status {
+		return nil, ErrInvalidTransition
+	}
+
+	currency, err := normalizeCurrency(invoice.Currency)
+	if err != nil {
+		return nil, err
+	}
+	invoice.Currency = currency
+
+	// New items are filled in from the catalog now, and keep those values if the catalog changes later
+	if slices.Contains(fields, "items") {
+		err = s.resolveCatalogItems(ctx, invoice.UserID, invoice.Items)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// A new branding profile must be one of the invoice owner's
+	if invoice.BrandingProfileID != 0 && invoice.BrandingProfileID != current.BrandingProfileID {
+		_, err = s.GetBrandingProfile(ctx, invoice.BrandingProfileID, invoice.UserID)
+		if err != nil {
+			if errors.Is(err, ErrNotFound) {
+				return nil, fmt.Errorf("%w: unknown branding profile %d", ErrInvalidRequest, invoice.BrandingProfileID)
+			}
+			return nil, err
+		}
+	}
+
+	// Only rewrite the items when something affecting the amounts changed
+	replaceItems := amountsChanged(current, &invoice)
+	if replaceItems {
+		if !isEditable(current.Status) {
+			return nil, ErrInvoiceLocked
+		}
+
+		// Recalculate invoice amounts
+		err = validateAdjustments(invoice.Items, adjustmentsOf(&invoice))
+		if err != nil {
+			return nil, err
+		}
+		err = s.resolveTaxes(ctx, invoice.UserID, invoice.Items, invoice.Charges)
+		if err != nil {
+			return nil, err
+		}
+		calculateInvoiceAmounts(invoice.Items, adjustmentsOf(&invoice)).applyTo(&invoice)
+	}
+
+	err = s.repo.UpdateInvoice(ctx, &invoice, replaceItems)
+	if err != nil {
+		// Another request saved the invoice after we read it
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrConflict
+		}
+		return nil, err
+	}
+	return &invoice, nil
+}
+
+// FinalizeInvoice issues a draft invoice, moving it to unpaid and recording the exchange rate in force
+// on its issue date.
+func (s *InvoiceService) FinalizeInvoice(ctx context.Context, invoiceID, userID int64) (*models.Invoice, error) {
+	invoice, err := s.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.UserID != userID {
+		return nil, ErrNotFound
+	}
+	if !canTransition(invoice.Status, models.StatusUnpaid) {
+		return nil, ErrInvalidTransition
+	}
+	if len(invoice.Items) == 0 {
+		return nil, ErrInvalidRequest
+	}
+
+	err = s.recordExchangeRate(ctx, invoice)
+	if err != nil {
+		return nil, err
+	}
+
+	from := invoice.Status
+	invoice.Status = models.StatusUnpaid
+	invoice.RecordActivity(models.ActivityInvoiceFinalized, fmt.Sprintf("Finalized invoice %s", invoice.InvoiceNumber))
+	err = s.repo.IssueInvoice(ctx, invoice, from)
+	if err != nil {
+		// The status changed underneath us, so the transition no longer applies
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidTransition
+		}
+		return nil, err
+	}
+	invoice.Version++
+
+	return invoice, nil
 }
 
-func (s *InvoiceService) ListInvoicesByUserID(ctx context.Context, userID int64, pageSize int, pageToken string) ([]*models.Invoice, string, error) {
-	invoices, nextPageToken, err := s.repo.ListInvoicesByUserID(ctx, userID, pageSize, pageToken)
+// VoidInvoice cancels an invoice, giving an optional reason for the activity log. A voided invoice is kept for
+// the audit trail but can no longer change.
+func (s *InvoiceService) VoidInvoice(ctx context.Context, invoiceID, userID int64, reason string) (*models.Invoice, error) {
+	invoice, err := s.GetInvoice(ctx, invoiceID)
 	if err != nil {
-		return nil, "", err
+		return nil, err
+	}
+	if invoice.UserID != userID {
+		return nil, ErrNotFound
 	}
 
-	return invoices, nextPageToken, nil
+	description := fmt.Sprintf("Voided invoice %s", invoice.InvoiceNumber)
+	if reason != "" {
+		description = fmt.Sprintf("%s: %s", description, reason)
+	}
+	invoice.RecordActivity(models.ActivityInvoiceVoided, description)
+	return s.transitionInvoice(ctx, invoice, models.StatusVoid)
+}
+
+// DeleteInvoice deletes one of the user's draft invoices, attachments included, and returns it. Issued invoices are kept for the audit
+// trail and can only be voided.
+func (s *InvoiceService) DeleteInvoice(ctx context.Context, invoiceID, userID int64) (*models.Invoice, error) {
+	invoice, err := s.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.UserID != userID {
+		return nil, ErrNotFound
+	}
+	if invoice.Status != models.StatusDraft {
+		return nil, ErrNotDraft
+	}
+
+	// The attachment rows go with the invoice, so find their contents first
+	var attachments []*models.Attachment
+	if s.blobs != nil {
+		attachments, err = s.repo.ListAttachmentsByInvoiceID(ctx, invoice.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	invoice.RecordActivity(models.ActivityInvoiceDeleted, fmt.Sprintf("Deleted draft invoice %s", invoice.InvoiceNumber))
+	err = s.repo.DeleteInvoice(ctx, invoice)
+	if err != nil {
+		// The invoice was issued underneath us
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotDraft
+		}
+		return nil, err
+	}
+
+	// As with DeleteAttachment, contents left behind by a failed delete are only wasted space
+	for _, attachment := range attachments {
+		_ = s.blobs.Delete(ctx, attachment.StorageKey)
+	}
+	return invoice, nil
+}
+
+// DuplicateInvoice creates a new draft invoice with the customer, items, discounts, charges, bank details and note of
+// one of the user's invoices. It is issued today with the same payment terms, and taxed at the current tax rates.
+// It returns the new invoice and the invoice it was copied from.
+func (s *InvoiceService) DuplicateInvoice(ctx context.Context, invoiceID, userID int64) (*models.Invoice, *models.Invoice, error) {
+	source, err := s.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if source.UserID != userID {
+		return nil, nil, ErrNotFound
+	}
+
+	issueDate := truncateToDate(time.Now())
+	paymentTermsDays := int(truncateToDate(source.DueDate).Sub(truncateToDate(source.IssueDate)).Hours() / 24)
+	invoice := &models.Invoice{
+		UserID:             source.UserID,
+		CustomerID:         source.CustomerID,
+		IssueDate:          issueDate,
+		DueDate:            issueDate.AddDate(0, 0, max(paymentTermsDays, 0)),
+		Currency:           source.Currency,
+		DiscountPercentage: source.DiscountPercentage,
+		FixedDiscount:      source.FixedDiscount,
+		AccountName:        source.AccountName,
+		AccountNumber:      source.AccountNumber,
+		BankName:           source.BankName,
+		RoutingNumber:      source.RoutingNumber,
+		Note:               source.Note,
+		BrandingProfileID:  source.BrandingProfileID,
+	}
+	for _, item := range source.Items {
+		invoice.Items = append(invoice.Items, &models.InvoiceItem{
+			CatalogItemID:      item.CatalogItemID,
+			SKU:                item.SKU,
+			Description:        item.Description,
+			Quantity:           item.Quantity,
+			Unit:               item.Unit,
+			UnitPrice:          item.UnitPrice,
+			DiscountPercentage: item.DiscountPercentage,
+			FixedDiscount:      item.FixedDiscount,
+			TaxRateIDs:         item.TaxRateIDs,
+		})
+	}
+	for _, charge := range source.Charges {
+		invoice.Charges = append(invoice.Charges, &models.InvoiceCharge{
+			Description: charge.Description,
+			Amount:      charge.Amount,
+			TaxRateIDs:  charge.TaxRateIDs,
+		})
+	}
+
+	invoice.RecordActivityFunc(models.ActivityInvoiceDuplicated, func() string {
+		return fmt.Sprintf("Created invoice %s as a copy of invoice %s", invoice.InvoiceNumber, source.InvoiceNumber)
+	})
+	invoice, err = s.createInvoice(ctx, invoice)
+	if err != nil {
+		return nil, nil, err
+	}
+	return invoice, source, nil
+}
+
+// ArchiveInvoice hides an invoice from default listings. Archiving changes nothing else about the invoice.
+func (s *InvoiceService) ArchiveInvoice(ctx context.Context, invoiceID, userID int64) (*models.Invoice, error) {
+	now := time.Now()
+	return s.setInvoiceArchived(ctx, invoiceID, userID, &now)
+}
+
+// UnarchiveInvoice returns an archived invoice to default listings.
+func (s *InvoiceService) UnarchiveInvoice(ctx context.Context, invoiceID, userID int64) (*models.Invoice, error) {
+	return s.setInvoiceArchived(ctx, invoiceID, userID, nil)
+}
+
+// setInvoiceArchived archives one of the user's invoices at archivedAt, or unarchives it if archivedAt is nil.
+func (s *InvoiceService) setInvoiceArchived(ctx context.Context, invoiceID, userID int64, archivedAt *time.Time) (*models.Invoice, error) {
+	invoice, err := s.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.UserID != userID {
+		return nil, ErrNotFound
+	}
+	if (invoice.ArchivedAt == nil) == (archivedAt == nil) {
+		return nil, ErrInvalidTransition
+	}
+
+	invoice.ArchivedAt = archivedAt
+	if archivedAt != nil {
+		invoice.RecordActivity(models.ActivityInvoiceArchived, fmt.Sprintf("Archived invoice %s", invoice.InvoiceNumber))
+	} else {
+		invoice.RecordActivity(models.ActivityInvoiceUnarchived, fmt.Sprintf("Unarchived invoice %s", invoice.InvoiceNumber))
+	}
+	err = s.repo.ArchiveInvoice(ctx, invoice)
+	if err != nil {
+		// Another request archived or unarchived it first
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidTransition
+		}
+		return nil, err
+	}
+	return invoice, nil
+}
+
+// MarkOverdueInvoices moves one batch of unpaid and partially paid invoices that are past their due date to overdue.
+func (s *InvoiceService) MarkOverdueInvoices(ctx context.Context, now time.Time, batchSize int) ([]*models.Invoice, error) {
+	if batchSize <= 0 {
+		return nil, ErrInvalidRequest
+	}
+	return s.repo.MarkOverdueInvoices(ctx, now, batchSize, func(invoice *models.Invoice) {
+		invoice.RecordActivity(models.ActivityInvoiceOverdue,
+			fmt.Sprintf("Invoice %s is overdue (due %s)", invoice.InvoiceNumber, invoice.DueDate.Format("2006-01-02")))
+	})
+}
+
+// transitionInvoice moves an invoice to a new status if the lifecycle allows it, saving the activities recorded
+// on it with the change.
+func (s *InvoiceService) transitionInvoice(ctx context.Context, invoice *models.Invoice, to string) (*models.Invoice, error) {
+	if !canTransition(invoice.Status, to) {
+		return nil, ErrInvalidTransition
+	}
+
+	err := s.repo.UpdateInvoiceStatus(ctx, invoice, invoice.Status, to)
+	if err != nil {
+		// The status changed underneath us, so the transition no longer applies
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidTransition
+		}
+		return nil, err
+	}
+	invoice.Status = to
+	invoice.Version++
+
+	return invoice, nil
+}
+
+// amountsChanged reports whether an update touches anything that affects the invoice amounts.
+func amountsChanged(current, updated *models.Invoice) bool {
+	if current.Currency != updated.Currency || current.DiscountPercentage != updated.DiscountPercentage ||
+		current.FixedDiscount != updated.FixedDiscount {
+		return true
+	}
+	if len(current.Items) != len(updated.Items) || len(current.Charges) != len(updated.Charges) {
+		return true
+	}
+	for i, item := range current.Items {
+		other := updated.Items[i]
+		if item.Description != other.Description || item.Quantity != other.Quantity || item.UnitPrice != other.UnitPrice ||
+			item.DiscountPercentage != other.DiscountPercentage || item.FixedDiscount != other.FixedDiscount ||
+			item.CatalogItemID != other.CatalogItemID || item.SKU != other.SKU || item.Unit != other.Unit {
+			return true
+		}
+		if !slices.Equal(item.TaxRateIDs, other.TaxRateIDs) {
+			return true
+		}
+	}
+	for i, charge := range current.Charges {
+		other := updated.Charges[i]
+		if charge.Description != other.Description || charge.Amount != other.Amount {
+			return true
+		}
+		if !slices.Equal(charge.TaxRateIDs, other.TaxRateIDs) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateAdjustments checks the line discounts, invoice discounts and charges of an invoice.
+func validateAdjustments(items []*models.InvoiceItem, adjustments invoiceAdjustments) error {
+	var netTotal int64
+	for _, item := range items {
+		switch {
+		case item.DiscountPercentage < 0 || item.DiscountPercentage > 10000:
+			return fmt.Errorf("%w: line discounts must be between 0 and 10000 hundredths of a percent", ErrInvalidRequest)
+		case item.FixedDiscount < 0:
+			return fmt.Errorf("%w: line discounts must not be negative", ErrInvalidRequest)
+		case item.FixedDiscount > item.Amount():
+			return fmt.Errorf("%w: the discount on %q is more than the line amount", ErrInvalidRequest, item.Description)
+		}
+		netTotal += item.NetAmount()
+	}
+
+	switch {
+	case adjustments.discountPercentage < 0 || adjustments.discountPercentage > 10000:
+		return fmt.Errorf("%w: the discount must be between 0 and 10000 hundredths of a percent", ErrInvalidRequest)
+	case adjustments.fixedDiscount < 0:
+		return fmt.Errorf("%w: the fixed discount must not be negative", ErrInvalidRequest)
+	}
+	percentageDiscount := roundToCents(decimal.NewFromInt(netTotal).Mul(ConvertPercentageToDecimal(adjustments.discountPercentage)))
+	if adjustments.fixedDiscount > netTotal-percentageDiscount {
+		return fmt.Errorf("%w: the fixed discount is more than the amount left after the other discounts", ErrInvalidRequest)
+	}
+
+	for _, charge := range adjustments.charges {
+		charge.Description = strings.TrimSpace(charge.Description)
+		switch {
+		case charge.Description == "":
+			return fmt.Errorf("%w: charges need a description", ErrInvalidRequest)
+		case charge.Amount <= 0:
+			return fmt.Errorf("%w: charges must be positive", ErrInvalidRequest)
+		}
+	}
+	return nil
 }
 
 // ConvertDecimalToCents converts a decimal.Decimal to int64 (cents).
@@ -100,20 +665,169 @@ func ConvertPercentageToDecimal(percentage int64) decimal.Decimal {
 	return decimal.NewFromInt(percentage).Div(decimal.NewFromInt(10000))
 }
 
-// calculateInvoiceValues calculates the subtotal, discount, and total of invoice items.
-func calculateInvoiceAmounts(items []*models.InvoiceItem, discountPercentage int64) (subtotal int64, discount int64, total int64) {
-	subtotalDecimal := decimal.Zero
+// invoiceAdjustments are the invoice-wide discounts and extra charges applied on top of the line amounts.
+type invoiceAdjustments struct {
+	discountPercentage int64 // Represented as hundredths of a percent
+	fixedDiscount      int64 // Represented in cents
+	charges            []*models.InvoiceCharge
+}
+
+// adjustmentsOf returns the invoice-wide discounts and charges of an invoice.
+func adjustmentsOf(invoice *models.Invoice) invoiceAdjustments {
+	return invoiceAdjustments{
+		discountPercentage: invoice.DiscountPercentage,
+		fixedDiscount:      invoice.FixedDiscount,
+		charges:            invoice.Charges,
+	}
+}
+
+// invoiceAmounts holds the calculated amounts of an invoice, in cents.
+type invoiceAmounts struct {
+	subtotal int64
+	discount int64
+	charges  int64
+	taxes    []*models.InvoiceTax
+	taxTotal int64
+	total    int64
+}
+
+// applyTo copies the calculated amounts onto an invoice.
+func (a invoiceAmounts) applyTo(invoice *models.Invoice) {
+	invoice.Subtotal = a.subtotal
+	invoice.DiscountAmount = a.discount
+	invoice.ChargeTotal = a.charges
+	invoice.Taxes = a.taxes
+	invoice.TaxTotal = a.taxTotal
+	invoice.Total = a.total
+}
+
+// calculateInvoiceAmounts calculates the subtotal, discount, charges, taxes and total of invoice items. The
+// adjustments combine in this order:
+//
+//  1. Each line's own discount, percentage then fixed, rounded to the cent per line (see InvoiceItem.DiscountAmount).
+//  2. The invoice discount percentage, on the sum of the discounted lines, rounded to the cent.
+//  3. The invoice fixed discount, on what is left, never taking it below zero.
+//  4. Extra charges, which are never discounted.
+//
+// The invoice discounts are spread over the lines in proportion to their discounted amounts before tax. Inclusive
+// taxes are carved out of the taxed amount, exclusive taxes are charged on the amount net of inclusive taxes, and
+// compound taxes are charged, in order, on that amount plus every tax applied to the line before them. Charges
+// are taxed the same way at their own rates. Taxes are summed per rate before being rounded half away from zero
+// to the cent, and the total is built from the rounded parts so the invoice always adds up. Only exclusive taxes
+// are added to the total, since inclusive taxes are already part of the subtotal.
+func calculateInvoiceAmounts(items []*models.InvoiceItem, adjustments invoiceAdjustments) invoiceAmounts {
+	var subtotal, lineDiscount, netTotal int64
+	for _, item := range items {
+		subtotal += item.Amount()
+		lineDiscount += item.DiscountAmount()
+		netTotal += item.NetAmount()
+	}
+
+	percentageDiscount := roundToCents(decimal.NewFromInt(netTotal).Mul(ConvertPercentageToDecimal(adjustments.discountPercentage)))
+	fixedDiscount := min(max(adjustments.fixedDiscount, 0), netTotal-percentageDiscount)
+	invoiceDiscount := percentageDiscount + fixedDiscount
+
+	type taxAccumulator struct {
+		tax     *models.TaxRate
+		taxable decimal.Decimal
+		amount  decimal.Decimal
+	}
+	var breakdown []*taxAccumulator
+	byRate := map[int64]*taxAccumulator{}
+	addTax := func(tax *models.TaxRate, taxable, amount decimal.Decimal) {
+		acc, ok := byRate[tax.ID]
+		if !ok {
+			acc = &taxAccumulator{tax: tax, taxable: decimal.Zero, amount: decimal.Zero}
+			byRate[tax.ID] = acc
+			breakdown = append(breakdown, acc)
+		}
+		acc.taxable = acc.taxable.Add(taxable)
+		acc.amount = acc.amount.Add(amount)
+	}
+
+	// taxLine charges taxes on an amount after discounts, in cents
+	taxLine := func(taxes []*models.TaxRate, lineAmount decimal.Decimal) {
+		// Remove any inclusive taxes to find the line's net amount
+		inclusiveRate := decimal.Zero
+		for _, tax := range taxes {
+			if tax.Inclusive {
+				inclusiveRate = inclusiveRate.Add(ConvertPercentageToDecimal(tax.Rate))
+			}
+		}
+		netAmount := lineAmount.Div(decimal.NewFromInt(1).Add(inclusiveRate))
+
+		// Simple taxes, inclusive or exclusive, are charged on the net amount
+		taxedAmount := netAmount
+		for _, tax := range taxes {
+			if tax.Compound {
+				continue
+			}
+			amount := netAmount.Mul(ConvertPercentageToDecimal(tax.Rate))
+			addTax(tax, netAmount, amount)
+			taxedAmount = taxedAmount.Add(amount)
+		}
+
+		// Compound taxes are charged on the net amount plus the taxes before them
+		for _, tax := range taxes {
+			if !tax.Compound {
+				continue
+			}
+			amount := taxedAmount.Mul(ConvertPercentageToDecimal(tax.Rate))
+			addTax(tax, taxedAmount, amount)
+			taxedAmount = taxedAmount.Add(amount)
+		}
+	}
+
 	for _, item := range items {
-		itemTotal := decimal.NewFromInt(int64(item.Quantity)).Mul(ConvertCentsToDecimal(item.UnitPrice))
-		subtotalDecimal = subtotalDecimal.Add(itemTotal)
+		if len(item.Taxes) == 0 {
+			continue
+		}
+
+		// Take the line's share of the invoice discounts
+		lineAmount := decimal.NewFromInt(item.NetAmount())
+		if invoiceDiscount != 0 && netTotal != 0 {
+			share := decimal.NewFromInt(invoiceDiscount).Mul(lineAmount).Div(decimal.NewFromInt(netTotal))
+			lineAmount = lineAmount.Sub(share)
+		}
+		taxLine(item.Taxes, lineAmount)
 	}
 
-	discountDecimal := subtotalDecimal.Mul(ConvertPercentageToDecimal(discountPercentage))
-	totalDecimal := subtotalDecimal.Sub(discountDecimal)
+	var charges int64
+	for _, charge := range adjustments.charges {
+		charges += charge.Amount
+		if len(charge.Taxes) > 0 {
+			taxLine(charge.Taxes, decimal.NewFromInt(charge.Amount))
+		}
+	}
 
-	subtotal = ConvertDecimalToCents(subtotalDecimal)
-	discount = ConvertDecimalToCents(discountDecimal)
-	total = ConvertDecimalToCents(totalDecimal)
+	amounts := invoiceAmounts{
+		subtotal: subtotal,
+		discount: lineDiscount + invoiceDiscount,
+		charges:  charges,
+	}
+	var exclusiveTax int64
+	for _, acc := range breakdown {
+		tax := &models.InvoiceTax{
+			TaxRateID:     acc.tax.ID,
+			Name:          acc.tax.Name,
+			Rate:          acc.tax.Rate,
+			Inclusive:     acc.tax.Inclusive,
+			Compound:      acc.tax.Compound,
+			TaxableAmount: roundToCents(acc.taxable),
+			Amount:        roundToCents(acc.amount),
+		}
+		amounts.taxes = append(amounts.taxes, tax)
+		amounts.taxTotal += tax.Amount
+		if !tax.Inclusive {
+			exclusiveTax += tax.Amount
+		}
+	}
+	amounts.total = amounts.subtotal - amounts.discount + amounts.charges + exclusiveTax
+
+	return amounts
+}
 
-	return
+// roundToCents rounds an amount in cents half away from zero to a whole cent.
+func roundToCents(cents decimal.Decimal) int64 {
+	return cents.Round(0).IntPart()
 }
@@ -2,7 +2,9 @@ package service_test
 
 import (
 	"context"
+	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/emzola/numer/invoice-service/internal/models"
 	"github.com/emzola/numer/invoice-service/internal/service"
@@ -20,35 +22,473 @@ func (m *MockInvoiceRepository) CreateInvoice(ctx context.Context, invoice *mode
 	return args.Error(0)
 }
 
+func (m *MockInvoiceRepository) CreateInvoices(ctx context.Context, invoices []*models.Invoice) error {
+	args := m.Called(ctx, invoices)
+	return args.Error(0)
+}
+
 func (m *MockInvoiceRepository) GetInvoiceByID(ctx context.Context, invoiceID int64) (*models.Invoice, error) {
 	args := m.Called(ctx, invoiceID)
 	return args.Get(0).(*models.Invoice), args.Error(1)
 }
 
-func (m *MockInvoiceRepository) UpdateInvoice(ctx context.Context, invoice *models.Invoice) error {
+func (m *MockInvoiceRepository) UpdateInvoice(ctx context.Context, invoice *models.Invoice, replaceItems bool) error {
+	args := m.Called(ctx, invoice, replaceItems)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) UpdateInvoiceStatus(ctx context.Context, invoice *models.Invoice, from, to string) error {
+	args := m.Called(ctx, invoice, from, to)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) MarkOverdueInvoices(ctx context.Context, now time.Time, limit int, record func(*models.Invoice)) ([]*models.Invoice, error) {
+	args := m.Called(ctx, now, limit, record)
+	invoices := args.Get(0).([]*models.Invoice)
+	for _, invoice := range invoices {
+		record(invoice)
+	}
+	return invoices, args.Error(1)
+}
+
+func (m *MockInvoiceRepository) DeleteInvoice(ctx context.Context, invoice *models.Invoice) error {
+	args := m.Called(ctx, invoice)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) ArchiveInvoice(ctx context.Context, invoice *models.Invoice) error {
 	args := m.Called(ctx, invoice)
 	return args.Error(0)
 }
 
-func (m *MockInvoiceRepository) ListInvoicesByUserID(ctx context.Context, userID int64, pageSize int, pageToken string) ([]*models.Invoice, string, error) {
-	args := m.Called(ctx, userID, pageSize, pageToken)
-	return args.Get(0).([]*models.Invoice), args.String(1), args.Error(2)
+func (m *MockInvoiceRepository) CreateTaxRate(ctx context.Context, taxRate *models.TaxRate) error {
+	args := m.Called(ctx, taxRate)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) GetTaxRatesByIDs(ctx context.Context, userID int64, taxRateIDs []int64) ([]*models.TaxRate, error) {
+	args := m.Called(ctx, userID, taxRateIDs)
+	return args.Get(0).([]*models.TaxRate), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) ListTaxRatesByUserID(ctx context.Context, userID int64) ([]*models.TaxRate, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*models.TaxRate), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) UpdateTaxRate(ctx context.Context, taxRate *models.TaxRate) error {
+	args := m.Called(ctx, taxRate)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) DeleteTaxRate(ctx context.Context, taxRateID, userID int64) error {
+	args := m.Called(ctx, taxRateID, userID)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) IssueInvoice(ctx context.Context, invoice *models.Invoice, from string) error {
+	args := m.Called(ctx, invoice, from)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) GetBaseCurrency(ctx context.Context, userID int64) (string, error) {
+	args := m.Called(ctx, userID)
+	return args.String(0), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) SetBaseCurrency(ctx context.Context, userID int64, baseCurrency string) error {
+	args := m.Called(ctx, userID, baseCurrency)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) CreateExchangeRates(ctx context.Context, rates []*models.ExchangeRate) error {
+	args := m.Called(ctx, rates)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) ListExchangeRatesByUserID(ctx context.Context, userID int64) ([]*models.ExchangeRate, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*models.ExchangeRate), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) GetExchangeRate(ctx context.Context, userID int64, baseCurrency, currency string, date time.Time) (*models.ExchangeRate, error) {
+	args := m.Called(ctx, userID, baseCurrency, currency, date)
+	return args.Get(0).(*models.ExchangeRate), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) DeleteExchangeRate(ctx context.Context, exchangeRateID, userID int64) error {
+	args := m.Called(ctx, exchangeRateID, userID)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) GetCreditedQuantities(ctx context.Context, invoiceID int64) (map[int64]int32, error) {
+	args := m.Called(ctx, invoiceID)
+	return args.Get(0).(map[int64]int32), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) CreateCreditNote(ctx context.Context, creditNote *models.CreditNote, invoice *models.Invoice, status string) error {
+	args := m.Called(ctx, creditNote, invoice, status)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) GetCreditNoteByID(ctx context.Context, creditNoteID, userID int64) (*models.CreditNote, error) {
+	args := m.Called(ctx, creditNoteID, userID)
+	return args.Get(0).(*models.CreditNote), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) ListCreditNotesByUserID(ctx context.Context, userID, invoiceID int64) ([]*models.CreditNote, error) {
+	args := m.Called(ctx, userID, invoiceID)
+	return args.Get(0).([]*models.CreditNote), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) ApplyCreditNote(ctx context.Context, allocation *models.CreditNoteAllocation, creditNote *models.CreditNote, invoice *models.Invoice, status string) error {
+	args := m.Called(ctx, allocation, creditNote, invoice, status)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) RefundCreditNote(ctx context.Context, allocation *models.CreditNoteAllocation, creditNote *models.CreditNote) error {
+	args := m.Called(ctx, allocation, creditNote)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) CreatePayment(ctx context.Context, payment *models.Payment, invoice *models.Invoice, status string) error {
+	args := m.Called(ctx, payment, invoice, status)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) GetPaymentByID(ctx context.Context, paymentID int64) (*models.Payment, error) {
+	args := m.Called(ctx, paymentID)
+	return args.Get(0).(*models.Payment), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) ListPaymentsByInvoiceID(ctx context.Context, invoiceID int64) ([]*models.Payment, error) {
+	args := m.Called(ctx, invoiceID)
+	return args.Get(0).([]*models.Payment), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) RefundPayment(ctx context.Context, payment *models.Payment, invoice *models.Invoice, status string) error {
+	args := m.Called(ctx, payment, invoice, status)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) CreateRecurringInvoice(ctx context.Context, recurring *models.RecurringInvoice) error {
+	args := m.Called(ctx, recurring)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) GetRecurringInvoiceByID(ctx context.Context, recurringInvoiceID, userID int64) (*models.RecurringInvoice, error) {
+	args := m.Called(ctx, recurringInvoiceID, userID)
+	return args.Get(0).(*models.RecurringInvoice), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) ListRecurringInvoicesByUserID(ctx context.Context, userID int64) ([]*models.RecurringInvoice, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*models.RecurringInvoice), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) ListDueRecurringInvoices(ctx context.Context, now time.Time, limit int) ([]*models.RecurringInvoice, error) {
+	args := m.Called(ctx, now, limit)
+	return args.Get(0).([]*models.RecurringInvoice), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) UpdateRecurringInvoiceSchedule(ctx context.Context, recurring *models.RecurringInvoice, from string) error {
+	args := m.Called(ctx, recurring, from)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) CreateRecurringInvoiceOccurrence(ctx context.Context, recurring *models.RecurringInvoice, invoice *models.Invoice, previousRunDate time.Time) error {
+	args := m.Called(ctx, recurring, invoice, previousRunDate)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) ListInvoicesByRecurringInvoiceID(ctx context.Context, recurringInvoiceID int64) ([]*models.Invoice, error) {
+	args := m.Called(ctx, recurringInvoiceID)
+	return args.Get(0).([]*models.Invoice), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) ListInvoices(ctx context.Context, filter models.InvoiceFilter) ([]*models.Invoice, error) {
+	args := m.Called(ctx, filter)
+	return args.Get(0).([]*models.Invoice), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) SearchInvoices(ctx context.Context, search models.InvoiceSearch) ([]*models.InvoiceSearchResult, error) {
+	args := m.Called(ctx, search)
+	return args.Get(0).([]*models.InvoiceSearchResult), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) GetNumberingScheme(ctx context.Context, userID int64) (*models.NumberingScheme, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(*models.NumberingScheme), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) SetNumberingScheme(ctx context.Context, scheme *models.NumberingScheme) error {
+	args := m.Called(ctx, scheme)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) GetInvoiceNumberCounter(ctx context.Context, userID int64, year int) (int64, error) {
+	args := m.Called(ctx, userID, year)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) CreateQuote(ctx context.Context, quote *models.Quote) error {
+	args := m.Called(ctx, quote)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) GetQuoteByID(ctx context.Context, quoteID, userID int64) (*models.Quote, error) {
+	args := m.Called(ctx, quoteID, userID)
+	return args.Get(0).(*models.Quote), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) ListQuotesByUserID(ctx context.Context, userID int64, status string) ([]*models.Quote, error) {
+	args := m.Called(ctx, userID, status)
+	return args.Get(0).([]*models.Quote), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) UpdateQuoteStatus(ctx context.Context, quote *models.Quote, from string) error {
+	args := m.Called(ctx, quote, from)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) ExpireQuotes(ctx context.Context, before time.Time, limit int) ([]*models.Quote, error) {
+	args := m.Called(ctx, before, limit)
+	return args.Get(0).([]*models.Quote), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) CreateQuoteInvoice(ctx context.Context, quote *models.Quote, invoice *models.Invoice) error {
+	args := m.Called(ctx, quote, invoice)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) CreateInvoiceShare(ctx context.Context, share *models.InvoiceShare) error {
+	args := m.Called(ctx, share)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) GetInvoiceShareByID(ctx context.Context, shareID int64) (*models.InvoiceShare, error) {
+	args := m.Called(ctx, shareID)
+	return args.Get(0).(*models.InvoiceShare), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) ListInvoiceSharesByInvoiceID(ctx context.Context, invoiceID int64) ([]*models.InvoiceShare, error) {
+	args := m.Called(ctx, invoiceID)
+	return args.Get(0).([]*models.InvoiceShare), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) RevokeInvoiceShare(ctx context.Context, share *models.InvoiceShare) error {
+	args := m.Called(ctx, share)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) RecordInvoiceShareView(ctx context.Context, share *models.InvoiceShare, invoice *models.Invoice) error {
+	args := m.Called(ctx, share, invoice)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) CreateAttachment(ctx context.Context, attachment *models.Attachment, maxCount int, maxTotalSize int64) error {
+	args := m.Called(ctx, attachment, maxCount, maxTotalSize)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) GetAttachmentByID(ctx context.Context, attachmentID int64) (*models.Attachment, error) {
+	args := m.Called(ctx, attachmentID)
+	return args.Get(0).(*models.Attachment), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) ListAttachmentsByInvoiceID(ctx context.Context, invoiceID int64) ([]*models.Attachment, error) {
+	args := m.Called(ctx, invoiceID)
+	return args.Get(0).([]*models.Attachment), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) DeleteAttachment(ctx context.Context, attachmentID int64) error {
+	args := m.Called(ctx, attachmentID)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) GetLateFeePolicy(ctx context.Context, userID int64) (*models.LateFeePolicy, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(*models.LateFeePolicy), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) SetLateFeePolicy(ctx context.Context, policy *models.LateFeePolicy) error {
+	args := m.Called(ctx, policy)
+	return args.Error(0)
 }
 
-func (m *MockInvoiceRepository) GetDueInvoices(ctx context.Context, daysBeforeDue int32) ([]*models.Invoice, error) {
-	args := m.Called(ctx, daysBeforeDue)
+func (m *MockInvoiceRepository) DeleteLateFeePolicy(ctx context.Context, userID int64) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) ListInvoicesDueLateFees(ctx context.Context, today time.Time, limit int) ([]*models.Invoice, error) {
+	args := m.Called(ctx, today, limit)
 	return args.Get(0).([]*models.Invoice), args.Error(1)
 }
 
-func (m *MockInvoiceRepository) IncrementInvoiceNumber(ctx context.Context) (int64, error) {
+func (m *MockInvoiceRepository) GetLateFeeByID(ctx context.Context, lateFeeID int64) (*models.LateFee, error) {
+	args := m.Called(ctx, lateFeeID)
+	return args.Get(0).(*models.LateFee), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) ApplyLateFee(ctx context.Context, invoice *models.Invoice, fee *models.LateFee, assessedOn time.Time) error {
+	args := m.Called(ctx, invoice, fee, assessedOn)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) ReverseLateFee(ctx context.Context, fee *models.LateFee, invoice *models.Invoice, status string) error {
+	args := m.Called(ctx, fee, invoice, status)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) CreateActivities(ctx context.Context, invoice *models.Invoice) error {
+	args := m.Called(ctx, invoice)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) ListDueOutboxEvents(ctx context.Context, now time.Time, limit int) ([]*models.OutboxEvent, error) {
+	args := m.Called(ctx, now, limit)
+	return args.Get(0).([]*models.OutboxEvent), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) ListOutboxEvents(ctx context.Context, status string, limit int) ([]*models.OutboxEvent, error) {
+	args := m.Called(ctx, status, limit)
+	return args.Get(0).([]*models.OutboxEvent), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) GetOutboxStats(ctx context.Context) (*models.OutboxStats, error) {
 	args := m.Called(ctx)
+	return args.Get(0).(*models.OutboxStats), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) MarkOutboxEventPublished(ctx context.Context, event *models.OutboxEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) MarkOutboxEventFailed(ctx context.Context, event *models.OutboxEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) RetryOutboxEvent(ctx context.Context, eventID int64) (*models.OutboxEvent, error) {
+	args := m.Called(ctx, eventID)
+	return args.Get(0).(*models.OutboxEvent), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) DeletePublishedOutboxEvents(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) ReserveIdempotencyKey(ctx context.Context, key *models.IdempotencyKey, now time.Time) (bool, error) {
+	args := m.Called(ctx, key, now)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) GetIdempotencyKey(ctx context.Context, userID int64, key string) (*models.IdempotencyKey, error) {
+	args := m.Called(ctx, userID, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.IdempotencyKey), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) SaveIdempotencyResponse(ctx context.Context, key *models.IdempotencyKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) DeleteIdempotencyKey(ctx context.Context, key *models.IdempotencyKey) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) (int64, error) {
+	args := m.Called(ctx, now)
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *MockInvoiceRepository) CreateBrandingProfile(ctx context.Context, profile *models.BrandingProfile) error {
+	args := m.Called(ctx, profile)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) GetBrandingProfile(ctx context.Context, userID, profileID int64) (*models.BrandingProfile, error) {
+	args := m.Called(ctx, userID, profileID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.BrandingProfile), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) ListBrandingProfilesByUserID(ctx context.Context, userID int64) ([]*models.BrandingProfile, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*models.BrandingProfile), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) UpdateBrandingProfile(ctx context.Context, profile *models.BrandingProfile) error {
+	args := m.Called(ctx, profile)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) SetBrandingProfileLogo(ctx context.Context, profile *models.BrandingProfile) error {
+	args := m.Called(ctx, profile)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) DeleteBrandingProfile(ctx context.Context, profileID, userID int64) error {
+	args := m.Called(ctx, profileID, userID)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) CreateCatalogItem(ctx context.Context, item *models.CatalogItem) error {
+	args := m.Called(ctx, item)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) GetCatalogItem(ctx context.Context, userID, itemID int64) (*models.CatalogItem, error) {
+	args := m.Called(ctx, userID, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.CatalogItem), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) GetCatalogItemBySKU(ctx context.Context, userID int64, sku string) (*models.CatalogItem, error) {
+	args := m.Called(ctx, userID, sku)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.CatalogItem), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) GetCatalogItemsByIDs(ctx context.Context, userID int64, itemIDs []int64) ([]*models.CatalogItem, error) {
+	args := m.Called(ctx, userID, itemIDs)
+	return args.Get(0).([]*models.CatalogItem), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) ListCatalogItemsByUserID(ctx context.Context, userID int64) ([]*models.CatalogItem, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).([]*models.CatalogItem), args.Error(1)
+}
+
+func (m *MockInvoiceRepository) UpdateCatalogItem(ctx context.Context, item *models.CatalogItem) error {
+	args := m.Called(ctx, item)
+	return args.Error(0)
+}
+
+func (m *MockInvoiceRepository) DeleteCatalogItem(ctx context.Context, itemID, userID int64) error {
+	args := m.Called(ctx, itemID, userID)
+	return args.Error(0)
+}
+
 func TestCreateInvoice(t *testing.T) {
 	mockRepo := new(MockInvoiceRepository)
 	svc := service.NewInvoiceService(mockRepo)
 
+	mockRepo.On("GetBrandingProfile", mock.Anything, mock.Anything, int64(0)).Return(nil, sql.ErrNoRows).Maybe()
+
 	invoice := &models.Invoice{
 		UserID:             1,
 		CustomerID:         2,
@@ -70,13 +510,21 @@ func TestCreateInvoice(t *testing.T) {
 	expectedInvoice.DiscountAmount = 2000 // 10% discount
 	expectedInvoice.Total = 18000         // $180.00
 
-	mockRepo.On("IncrementInvoiceNumber", mock.Anything).Return(int64(1), nil)
-	mockRepo.On("CreateInvoice", mock.Anything, mock.Anything).Return(nil)
+	// The repository numbers the invoice as it inserts it, then writes the activities recorded on it
+	var activities []models.Activity
+	mockRepo.On("CreateInvoice", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		created := args.Get(1).(*models.Invoice)
+		created.InvoiceNumber = "000001"
+		activities = created.TakeActivities(5, created.UserID)
+	}).Return(nil)
 
 	createdInvoice, err := svc.CreateInvoice(context.Background(), invoice)
 
 	assert.NoError(t, err)
 	assert.Equal(t, &expectedInvoice, createdInvoice)
+	assert.Equal(t, []models.Activity{
+		{InvoiceID: 5, UserID: 1, Action: models.ActivityInvoiceCreated, Description: "Created invoice 000001"},
+	}, activities)
 	mockRepo.AssertExpectations(t)
 }
 
@@ -114,32 +562,527 @@ func TestUpdateInvoice(t *testing.T) {
 	}
 
 	expectedInvoice := *invoice
+	expectedInvoice.Status = "draft"
 	expectedInvoice.Subtotal = 20000      // $200.00
 	expectedInvoice.DiscountAmount = 2000 // 10% discount
 	expectedInvoice.Total = 18000         // $180.00
 
-	mockRepo.On("UpdateInvoice", mock.Anything, mock.Anything).Return(nil)
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(&models.Invoice{ID: 1, UserID: 1, CustomerID: 2, Status: "draft"}, nil)
+	mockRepo.On("UpdateInvoice", mock.Anything, mock.Anything, true).Return(nil)
+
+	updated, err := svc.UpdateInvoice(context.Background(), invoice, nil)
 
-	err := svc.UpdateInvoice(context.Background(), invoice)
+	assert.NoError(t, err)
+	assert.Equal(t, &expectedInvoice, updated)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateInvoiceFieldMask(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	current := &models.Invoice{
+		ID:            1,
+		Status:        "unpaid",
+		Currency:      "USD",
+		Items:         []*models.InvoiceItem{{Description: "Item 1", Quantity: 2, UnitPrice: 10000}},
+		Total:         20000,
+		AccountName:   "Acme",
+		AccountNumber: "0123456789",
+		BankName:      "First Bank",
+		Version:       3,
+	}
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(current, nil)
+	mockRepo.On("UpdateInvoice", mock.Anything, mock.MatchedBy(func(invoice *models.Invoice) bool {
+		return invoice.Version == 3
+	}), false).Return(nil)
+
+	// Only the note is named, so the bank details and items are left alone
+	updated, err := svc.UpdateInvoice(context.Background(), &models.Invoice{ID: 1, Note: "Thanks for your business", Version: 3},
+		[]string{"note"})
 
 	assert.NoError(t, err)
+	assert.Equal(t, "Thanks for your business", updated.Note)
+	assert.Equal(t, "Acme", updated.AccountName)
+	assert.Equal(t, "0123456789", updated.AccountNumber)
+	assert.Equal(t, "First Bank", updated.BankName)
+	assert.Equal(t, current.Items, updated.Items)
+	assert.Equal(t, int64(20000), updated.Total)
+	assert.Equal(t, "", current.Note, "the invoice read from the repository is not modified")
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateInvoiceUnknownField(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(&models.Invoice{ID: 1, Status: "draft", Currency: "USD"}, nil)
+
+	_, err := svc.UpdateInvoice(context.Background(), &models.Invoice{ID: 1}, []string{"total"})
+
+	assert.ErrorIs(t, err, service.ErrInvalidRequest)
 	mockRepo.AssertExpectations(t)
 }
 
-func TestListInvoicesByUserID(t *testing.T) {
+func TestUpdateInvoiceVersionConflict(t *testing.T) {
+	tests := []struct {
+		name      string
+		version   int64
+		repoError error
+	}{
+		{"stale version", 2, nil},
+		{"changed after read", 3, sql.ErrNoRows},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			current := &models.Invoice{ID: 1, Status: "draft", Currency: "USD", Version: 3}
+			mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(current, nil)
+			if tt.repoError != nil {
+				mockRepo.On("UpdateInvoice", mock.Anything, mock.Anything, false).Return(tt.repoError)
+			}
+
+			_, err := svc.UpdateInvoice(context.Background(), &models.Invoice{ID: 1, Note: "Updated", Version: tt.version},
+				[]string{"note"})
+
+			assert.ErrorIs(t, err, service.ErrConflict)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUpdateInvoiceLockedAfterDraft(t *testing.T) {
 	mockRepo := new(MockInvoiceRepository)
 	svc := service.NewInvoiceService(mockRepo)
 
-	invoices := []*models.Invoice{
-		{ID: 1},
-		{ID: 2},
+	current := &models.Invoice{
+		ID:       1,
+		Status:   "unpaid",
+		Currency: "USD",
+		Items:    []*models.InvoiceItem{{Description: "Item 1", Quantity: 2, UnitPrice: 10000}},
 	}
-	mockRepo.On("ListInvoicesByUserID", mock.Anything, int64(1), 10, "").Return(invoices, "", nil)
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(current, nil)
 
-	result, nextPageToken, err := svc.ListInvoicesByUserID(context.Background(), 1, 10, "")
+	// Changing the items of an issued invoice is refused
+	_, err := svc.UpdateInvoice(context.Background(), &models.Invoice{
+		ID:       1,
+		Currency: "USD",
+		Items:    []*models.InvoiceItem{{Description: "Item 1", Quantity: 3, UnitPrice: 10000}},
+	}, nil)
+	assert.ErrorIs(t, err, service.ErrInvoiceLocked)
 
+	// Changing the status through an update is refused
+	_, err = svc.UpdateInvoice(context.Background(), &models.Invoice{
+		ID:       1,
+		Status:   "paid",
+		Currency: "USD",
+		Items:    []*models.InvoiceItem{{Description: "Item 1", Quantity: 2, UnitPrice: 10000}},
+	}, nil)
+	assert.ErrorIs(t, err, service.ErrInvalidTransition)
+
+	// Other fields may still change
+	mockRepo.On("UpdateInvoice", mock.Anything, mock.Anything, false).Return(nil)
+	_, err = svc.UpdateInvoice(context.Background(), &models.Invoice{
+		ID:       1,
+		Currency: "USD",
+		Items:    []*models.InvoiceItem{{Description: "Item 1", Quantity: 2, UnitPrice: 10000}},
+		Note:     "Thanks for your business",
+	}, nil)
 	assert.NoError(t, err)
-	assert.Equal(t, invoices, result)
-	assert.Empty(t, nextPageToken)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestInvoiceTransitions(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		apply   func(svc *service.InvoiceService) (*models.Invoice, error)
+		to      string
+		wantErr error
+	}{
+		{"finalize draft", "draft", func(svc *service.InvoiceService) (*models.Invoice, error) {
+			return svc.FinalizeInvoice(context.Background(), 1, 1)
+		}, "unpaid", nil},
+		{"finalize unpaid", "unpaid", func(svc *service.InvoiceService) (*models.Invoice, error) {
+			return svc.FinalizeInvoice(context.Background(), 1, 1)
+		}, "", service.ErrInvalidTransition},
+		{"void paid", "paid", func(svc *service.InvoiceService) (*models.Invoice, error) {
+			return svc.VoidInvoice(context.Background(), 1, 1, "")
+		}, "void", nil},
+		{"void void", "void", func(svc *service.InvoiceService) (*models.Invoice, error) {
+			return svc.VoidInvoice(context.Background(), 1, 1, "")
+		}, "", service.ErrInvalidTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			current := &models.Invoice{
+				ID:     1,
+				UserID: 1,
+				Status: tt.from,
+				Items:  []*models.InvoiceItem{{Description: "Item 1", Quantity: 1, UnitPrice: 10000}},
+			}
+			mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(current, nil)
+			switch {
+			case tt.wantErr != nil:
+			case tt.to == "unpaid":
+				mockRepo.On("GetBaseCurrency", mock.Anything, mock.Anything).Return("", sql.ErrNoRows)
+				mockRepo.On("IssueInvoice", mock.Anything, current, tt.from).Return(nil)
+			default:
+				mockRepo.On("UpdateInvoiceStatus", mock.Anything, current, tt.from, tt.to).Return(nil)
+			}
+
+			invoice, err := tt.apply(svc)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.to, invoice.Status)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMarkOverdueInvoices(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	now := time.Date(2024, 9, 30, 0, 0, 0, 0, time.UTC)
+	dueDate := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	overdue := []*models.Invoice{
+		{ID: 1, UserID: 3, InvoiceNumber: "INV-1", Status: "overdue", DueDate: dueDate},
+		{ID: 2, UserID: 3, InvoiceNumber: "INV-2", Status: "overdue", DueDate: dueDate},
+	}
+	mockRepo.On("MarkOverdueInvoices", mock.Anything, now, 50, mock.Anything).Return(overdue, nil)
+
+	invoices, err := svc.MarkOverdueInvoices(context.Background(), now, 50)
+
+	assert.NoError(t, err)
+	assert.Equal(t, overdue, invoices)
+
+	// Each invoice records its activity for the repository to save with the status change
+	assert.Equal(t, []models.Activity{{
+		InvoiceID: 2, UserID: 3, Action: models.ActivityInvoiceOverdue, Description: "Invoice INV-2 is overdue (due 2024-09-01)",
+	}}, invoices[1].TakeActivities(2, 3))
+
+	// A batch size is required to keep each sweep bounded
+	_, err = svc.MarkOverdueInvoices(context.Background(), now, 0)
+	assert.ErrorIs(t, err, service.ErrInvalidRequest)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestDeleteInvoice(t *testing.T) {
+	tests := []struct {
+		name      string
+		status    string
+		repoError error
+		wantErr   error
+	}{
+		{"draft", "draft", nil, nil},
+		{"issued", "unpaid", nil, service.ErrNotDraft},
+		{"issued after read", "draft", sql.ErrNoRows, service.ErrNotDraft},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(&models.Invoice{ID: 1, UserID: 1, Status: tt.status}, nil)
+			if tt.status == "draft" {
+				mockRepo.On("DeleteInvoice", mock.Anything, mock.AnythingOfType("*models.Invoice")).Return(tt.repoError)
+			}
+
+			invoice, err := svc.DeleteInvoice(context.Background(), 1, 1)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, int64(1), invoice.ID)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDuplicateInvoice(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	archivedAt := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	source := &models.Invoice{
+		ID:                 1,
+		UserID:             1,
+		CustomerID:         2,
+		InvoiceNumber:      "000001",
+		Status:             "paid",
+		IssueDate:          time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+		DueDate:            time.Date(2024, 2, 9, 0, 0, 0, 0, time.UTC),
+		Currency:           "USD",
+		Items:              []*models.InvoiceItem{{ID: 5, Description: "Item 1", Quantity: 2, UnitPrice: 10000}},
+		DiscountPercentage: 1000, // 10%
+		AmountPaid:         18000,
+		AccountName:        "Acme",
+		Note:               "Thanks for your business",
+		ArchivedAt:         &archivedAt,
+	}
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(source, nil)
+	mockRepo.On("CreateInvoice", mock.Anything, mock.Anything).Return(nil)
+
+	invoice, copied, err := svc.DuplicateInvoice(context.Background(), 1, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, source, copied)
+	assert.Equal(t, "draft", invoice.Status)
+	assert.Equal(t, int64(2), invoice.CustomerID)
+	assert.Equal(t, "Acme", invoice.AccountName)
+	assert.Equal(t, "Thanks for your business", invoice.Note)
+	assert.Equal(t, int64(0), invoice.AmountPaid)
+	assert.Nil(t, invoice.ArchivedAt)
+
+	// The copy is issued today with the same 30 day payment terms
+	today := time.Now().UTC()
+	assert.Equal(t, today.Format(time.DateOnly), invoice.IssueDate.Format(time.DateOnly))
+	assert.Equal(t, invoice.IssueDate.AddDate(0, 0, 30), invoice.DueDate)
+
+	// The items are copied rather than shared, and the amounts recalculated
+	assert.Len(t, invoice.Items, 1)
+	assert.NotSame(t, source.Items[0], invoice.Items[0])
+	assert.Equal(t, int64(0), invoice.Items[0].ID)
+	assert.Equal(t, int64(18000), invoice.Total)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestArchiveInvoice(t *testing.T) {
+	archivedAt := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		archivedAt *time.Time
+		apply      func(svc *service.InvoiceService) (*models.Invoice, error)
+		wantErr    error
+	}{
+		{"archive", nil, func(svc *service.InvoiceService) (*models.Invoice, error) {
+			return svc.ArchiveInvoice(context.Background(), 1, 1)
+		}, nil},
+		{"archive archived", &archivedAt, func(svc *service.InvoiceService) (*models.Invoice, error) {
+			return svc.ArchiveInvoice(context.Background(), 1, 1)
+		}, service.ErrInvalidTransition},
+		{"unarchive", &archivedAt, func(svc *service.InvoiceService) (*models.Invoice, error) {
+			return svc.UnarchiveInvoice(context.Background(), 1, 1)
+		}, nil},
+		{"unarchive unarchived", nil, func(svc *service.InvoiceService) (*models.Invoice, error) {
+			return svc.UnarchiveInvoice(context.Background(), 1, 1)
+		}, service.ErrInvalidTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			current := &models.Invoice{ID: 1, UserID: 1, Status: "paid", ArchivedAt: tt.archivedAt}
+			mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(current, nil)
+			if tt.wantErr == nil {
+				mockRepo.On("ArchiveInvoice", mock.Anything, current).Return(nil)
+			}
+
+			invoice, err := tt.apply(svc)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.archivedAt == nil, invoice.ArchivedAt != nil)
+				assert.Equal(t, "paid", invoice.Status)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestInvoiceActionsCheckOwner(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	// Another user's invoice looks like it doesn't exist
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(&models.Invoice{ID: 1, UserID: 2, Status: models.StatusDraft}, nil)
+
+	_, err := svc.DeleteInvoice(context.Background(), 1, 1)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+	_, _, err = svc.DuplicateInvoice(context.Background(), 1, 1)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+	_, err = svc.ArchiveInvoice(context.Background(), 1, 1)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+	_, err = svc.UnarchiveInvoice(context.Background(), 1, 1)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+	_, err = svc.FinalizeInvoice(context.Background(), 1, 1)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+	_, err = svc.VoidInvoice(context.Background(), 1, 1, "")
+	assert.ErrorIs(t, err, service.ErrNotFound)
+	_, err = svc.MarkPaid(context.Background(), 1, 1)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+
+	mockRepo.AssertNotCalled(t, "DeleteInvoice", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "CreateInvoice", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "ArchiveInvoice", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "IssueInvoice", mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "UpdateInvoiceStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "CreatePayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateInvoiceAdjustments(t *testing.T) {
+	tests := []struct {
+		name               string
+		discountPercentage int64
+		fixedDiscount      int64
+		items              []*models.InvoiceItem
+		charges            []*models.InvoiceCharge
+		taxRates           []*models.TaxRate
+		wantDiscount       int64
+		wantChargeTotal    int64
+		wantTotal          int64
+		wantTaxes          []*models.InvoiceTax
+	}{
+		{
+			name: "line discounts",
+			items: []*models.InvoiceItem{
+				{Description: "Item 1", Quantity: 2, UnitPrice: 10000, DiscountPercentage: 1000},
+				{Description: "Item 2", Quantity: 1, UnitPrice: 5000, FixedDiscount: 500},
+			},
+			wantDiscount: 2500,
+			wantTotal:    22500,
+		},
+		{
+			name:          "fixed discount spread across lines for tax",
+			fixedDiscount: 1000,
+			items: []*models.InvoiceItem{
+				{Description: "Item 1", Quantity: 1, UnitPrice: 6000, TaxRateIDs: []int64{1}},
+				{Description: "Item 2", Quantity: 1, UnitPrice: 4000},
+			},
+			taxRates:     []*models.TaxRate{vat},
+			wantDiscount: 1000,
+			wantTotal:    9540,
+			wantTaxes: []*models.InvoiceTax{
+				{TaxRateID: 1, Name: "VAT", Rate: 1000, TaxableAmount: 5400, Amount: 540},
+			},
+		},
+		{
+			name:               "charges are not discounted",
+			discountPercentage: 1000,
+			items:              []*models.InvoiceItem{{Description: "Item 1", Quantity: 1, UnitPrice: 10000, TaxRateIDs: []int64{1}}},
+			charges: []*models.InvoiceCharge{
+				{Description: "Shipping", Amount: 1500, TaxRateIDs: []int64{1}},
+				{Description: "Handling", Amount: 500},
+			},
+			taxRates:        []*models.TaxRate{vat},
+			wantDiscount:    1000,
+			wantChargeTotal: 2000,
+			wantTotal:       12050,
+			wantTaxes: []*models.InvoiceTax{
+				{TaxRateID: 1, Name: "VAT", Rate: 1000, TaxableAmount: 10500, Amount: 1050},
+			},
+		},
+		{
+			name:         "percentage discounts round half away from zero",
+			items:        []*models.InvoiceItem{{Description: "Item 1", Quantity: 1, UnitPrice: 1005, DiscountPercentage: 5000}},
+			wantDiscount: 503,
+			wantTotal:    502,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			mockRepo.On("GetBrandingProfile", mock.Anything, mock.Anything, int64(0)).Return(nil, sql.ErrNoRows).Maybe()
+			if tt.taxRates != nil {
+				mockRepo.On("GetTaxRatesByIDs", mock.Anything, int64(1), mock.Anything).Return(tt.taxRates, nil)
+			}
+			mockRepo.On("CreateInvoice", mock.Anything, mock.Anything).Return(nil)
+
+			invoice, err := svc.CreateInvoice(context.Background(), &models.Invoice{
+				UserID:             1,
+				Currency:           "USD",
+				DiscountPercentage: tt.discountPercentage,
+				FixedDiscount:      tt.fixedDiscount,
+				Items:              tt.items,
+				Charges:            tt.charges,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantDiscount, invoice.DiscountAmount)
+			assert.Equal(t, tt.wantChargeTotal, invoice.ChargeTotal)
+			assert.Equal(t, tt.wantTotal, invoice.Total)
+			assert.Equal(t, tt.wantTaxes, invoice.Taxes)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateInvoiceAdjustmentsInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		invoice *models.Invoice
+	}{
+		{
+			name: "line discount above the line amount",
+			invoice: &models.Invoice{Items: []*models.InvoiceItem{
+				{Description: "Item 1", Quantity: 1, UnitPrice: 1000, FixedDiscount: 1001},
+			}},
+		},
+		{
+			name: "line percentage above 100%",
+			invoice: &models.Invoice{Items: []*models.InvoiceItem{
+				{Description: "Item 1", Quantity: 1, UnitPrice: 1000, DiscountPercentage: 10001},
+			}},
+		},
+		{
+			name: "fixed discount above the discounted total",
+			invoice: &models.Invoice{
+				DiscountPercentage: 5000,
+				FixedDiscount:      600,
+				Items:              []*models.InvoiceItem{{Description: "Item 1", Quantity: 1, UnitPrice: 1000}},
+			},
+		},
+		{
+			name: "charge without a description",
+			invoice: &models.Invoice{
+				Items:   []*models.InvoiceItem{{Description: "Item 1", Quantity: 1, UnitPrice: 1000}},
+				Charges: []*models.InvoiceCharge{{Description: "  ", Amount: 100}},
+			},
+		},
+		{
+			name: "negative charge",
+			invoice: &models.Invoice{
+				Items:   []*models.InvoiceItem{{Description: "Item 1", Quantity: 1, UnitPrice: 1000}},
+				Charges: []*models.InvoiceCharge{{Description: "Shipping", Amount: -100}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			mockRepo.On("GetBrandingProfile", mock.Anything, mock.Anything, int64(0)).Return(nil, sql.ErrNoRows).Maybe()
+
+			tt.invoice.UserID = 1
+			tt.invoice.Currency = "USD"
+			_, err := svc.CreateInvoice(context.Background(), tt.invoice)
+
+			assert.ErrorIs(t, err, service.ErrInvalidRequest)
+			mockRepo.AssertNotCalled(t, "CreateInvoice", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package service
+
+import "github.com/emzola/numer/invoice-service/internal/models"
+
+// invoiceTransitions lists the statuses an invoice may move to from each status. The moves back
+// from paid and partially_paid only happen when a payment is refunded. A partially paid invoice goes
+// overdue like an unpaid one once it is past its due date.
+var invoiceTransitions = map[string][]string{
+	models.StatusDraft:         {models.StatusUnpaid, models.StatusVoid},
+	models.StatusUnpaid:        {models.StatusPartiallyPaid, models.StatusPaid, models.StatusOverdue, models.StatusVoid},
+	models.StatusPartiallyPaid: {models.StatusPaid, models.StatusUnpaid, models.StatusOverdue, models.StatusVoid},
+	models.StatusOverdue:       {models.StatusPartiallyPaid, models.StatusPaid, models.StatusVoid},
+	models.StatusPaid:          {models.StatusPartiallyPaid, models.StatusUnpaid, models.StatusVoid},
+	models.StatusVoid:          {},
+}
+
+// canTransition reports whether an invoice may move from one status to another.
+func canTransition(from, to string) bool {
+	for _, status := range invoiceTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// isEditable reports whether the items and amounts of an invoice in the given status may still change.
+func isEditable(status string) bool {
+	return status == models.StatusDraft
+}
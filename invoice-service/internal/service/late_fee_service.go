@@ -0,0 +1,262 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/shopspring/decimal"
+)
+
+// MaxLateFeeGraceDays is the longest grace period a late fee policy can have.
+const MaxLateFeeGraceDays = 365
+
+// GetLateFeePolicy returns the user's late fee policy.
+func (s *InvoiceService) GetLateFeePolicy(ctx context.Context, userID int64) (*models.LateFeePolicy, error) {
+	policy, err := s.repo.GetLateFeePolicy(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return policy, nil
+}
+
+// SetLateFeePolicy sets how the user's overdue invoices are charged for paying late. Fees already charged are
+// left as they are.
+func (s *InvoiceService) SetLateFeePolicy(ctx context.Context, policy *models.LateFeePolicy) (*models.LateFeePolicy, error) {
+	err := validateLateFeePolicy(policy)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.SetLateFeePolicy(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	return policy, nil
+}
+
+// DeleteLateFeePolicy stops late fees being charged on the user's invoices.
+func (s *InvoiceService) DeleteLateFeePolicy(ctx context.Context, userID int64) error {
+	err := s.repo.DeleteLateFeePolicy(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// ListLateFees returns the late fees charged on one of the user's invoices, reversed ones included.
+func (s *InvoiceService) ListLateFees(ctx context.Context, invoiceID, userID int64) ([]*models.LateFee, error) {
+	invoice, err := s.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.UserID != userID {
+		return nil, ErrNotFound
+	}
+	return invoice.LateFees, nil
+}
+
+// InvoicesDueLateFees returns one batch of invoices past their due date and grace period to assess for late
+// fees today.
+func (s *InvoiceService) InvoicesDueLateFees(ctx context.Context, now time.Time, batchSize int) ([]*models.Invoice, error) {
+	if batchSize <= 0 {
+		return nil, ErrInvalidRequest
+	}
+	return s.repo.ListInvoicesDueLateFees(ctx, truncateToDate(now), batchSize)
+}
+
+// AssessLateFee charges an issued invoice whatever its owner's late fee policy says is due today, and marks it
+// assessed so it isn't looked at again until tomorrow. Fees are due on the balance left once the invoice is past
+// its due date, whether or not the overdue sweep has reached it yet. It returns the fee, or nil if none was due,
+// and the invoice.
+func (s *InvoiceService) AssessLateFee(ctx context.Context, invoiceID int64, now time.Time) (*models.LateFee, *models.Invoice, error) {
+	invoice, err := s.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch invoice.Status {
+	case models.StatusUnpaid, models.StatusPartiallyPaid, models.StatusOverdue:
+	default:
+		return nil, nil, ErrInvalidTransition
+	}
+	policy, err := s.GetLateFeePolicy(ctx, invoice.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	today := truncateToDate(now)
+	fee := lateFeeDue(policy, invoice, today)
+	if fee != nil {
+		invoice.RecordActivity(models.ActivityLateFeeApplied,
+			fmt.Sprintf("%s of %d added to invoice %s", fee.Description, fee.Amount, invoice.InvoiceNumber))
+	}
+
+	err = s.repo.ApplyLateFee(ctx, invoice, fee, today)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrConflict
+		}
+		return nil, nil, err
+	}
+	if fee != nil {
+		invoice.LateFees = append(invoice.LateFees, fee)
+		invoice.LateFeeTotal += fee.Amount
+	}
+	return fee, invoice, nil
+}
+
+// ReverseLateFee takes a late fee back off an invoice. An invoice whose balance it settles moves to paid.
+func (s *InvoiceService) ReverseLateFee(ctx context.Context, lateFeeID, userID int64) (*models.LateFee, *models.Invoice, error) {
+	fee, err := s.repo.GetLateFeeByID(ctx, lateFeeID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrNotFound
+		}
+		return nil, nil, err
+	}
+	if fee.UserID != userID {
+		return nil, nil, ErrNotFound
+	}
+	if fee.ReversedAt != nil {
+		return nil, nil, fmt.Errorf("%w: late fee has already been reversed", ErrInvalidRequest)
+	}
+
+	invoice, err := s.GetInvoice(ctx, fee.InvoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	balanceDue := invoice.BalanceDue() - fee.Amount
+	if balanceDue < 0 {
+		return nil, nil, fmt.Errorf("%w: the late fee has been paid, refund the payment first", ErrInvalidRequest)
+	}
+	status := invoice.Status
+	if balanceDue == 0 && canTransition(status, models.StatusPaid) {
+		status = models.StatusPaid
+	}
+
+	invoice.RecordActivity(models.ActivityLateFeeReversed,
+		fmt.Sprintf("Reversed late fee of %d on invoice %s", fee.Amount, invoice.InvoiceNumber))
+	if status == models.StatusPaid {
+		invoice.RecordActivity(models.ActivityInvoicePaid, fmt.Sprintf("Invoice %s paid in full", invoice.InvoiceNumber))
+	}
+	err = s.repo.ReverseLateFee(ctx, fee, invoice, status)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrConflict
+		}
+		return nil, nil, err
+	}
+	invoice.LateFeeTotal -= fee.Amount
+	invoice.Status = status
+
+	return fee, invoice, nil
+}
+
+// lateFeeDue returns the late fee policy charges on an overdue invoice today, or nil if nothing is due. Fees are
+// charged on the balance left of the invoice total, never on earlier fees, and stop once they reach the cap.
+// One-off fees are charged once per invoice, even if reversed; interest is charged for the days since the
+// grace period ended that earlier interest did not cover.
+func lateFeeDue(policy *models.LateFeePolicy, invoice *models.Invoice, today time.Time) *models.LateFee {
+	graceEnd := truncateToDate(invoice.DueDate).AddDate(0, 0, int(policy.GraceDays))
+	overdueBalance := invoice.Total - invoice.AmountPaid - invoice.AmountCredited
+	if !today.After(graceEnd) || overdueBalance <= 0 {
+		return nil
+	}
+
+	fee := &models.LateFee{
+		InvoiceID:   invoice.ID,
+		UserID:      invoice.UserID,
+		Kind:        policy.Kind,
+		PeriodStart: today,
+		PeriodEnd:   today,
+	}
+	switch policy.Kind {
+	case models.LateFeeFlat, models.LateFeePercentage:
+		for _, charged := range invoice.LateFees {
+			if charged.Kind != models.LateFeeInterest {
+				return nil
+			}
+		}
+		fee.Description = "Late fee"
+		fee.Amount = policy.Amount
+		if policy.Kind == models.LateFeePercentage {
+			fee.Description = fmt.Sprintf("Late fee (%s of the overdue balance)", formatRate(policy.Rate))
+			fee.Amount = decimal.NewFromInt(overdueBalance).Mul(ConvertPercentageToDecimal(policy.Rate)).Round(0).IntPart()
+		}
+
+	case models.LateFeeInterest:
+		fee.PeriodStart = graceEnd
+		for _, charged := range invoice.LateFees {
+			if charged.Kind == models.LateFeeInterest && charged.PeriodEnd.After(fee.PeriodStart) {
+				fee.PeriodStart = charged.PeriodEnd
+			}
+		}
+		days := int64(today.Sub(fee.PeriodStart).Hours() / 24)
+		if days <= 0 {
+			return nil
+		}
+		// Simple interest: balance × annual rate × days / 365
+		fee.Amount = decimal.NewFromInt(overdueBalance).Mul(ConvertPercentageToDecimal(policy.Rate)).
+			Mul(decimal.NewFromInt(days)).Div(decimal.NewFromInt(365)).Round(0).IntPart()
+		fee.Description = fmt.Sprintf("Interest at %s a year, %s to %s", formatRate(policy.Rate),
+			fee.PeriodStart.Format("2006-01-02"), today.AddDate(0, 0, -1).Format("2006-01-02"))
+
+	default:
+		return nil
+	}
+
+	if policy.Cap > 0 {
+		fee.Amount = min(fee.Amount, policy.Cap-invoice.LateFeeTotal)
+	}
+	// Interest too small to round to a cent is left to build up until it does
+	if fee.Amount <= 0 {
+		return nil
+	}
+	return fee
+}
+
+func validateLateFeePolicy(policy *models.LateFeePolicy) error {
+	switch policy.Kind {
+	case models.LateFeeFlat:
+		if policy.Amount <= 0 {
+			return fmt.Errorf("%w: a flat late fee needs a positive amount", ErrInvalidRequest)
+		}
+		if policy.Rate != 0 {
+			return fmt.Errorf("%w: a flat late fee has no rate", ErrInvalidRequest)
+		}
+	case models.LateFeePercentage, models.LateFeeInterest:
+		if policy.Rate <= 0 || policy.Rate > 10000 {
+			return fmt.Errorf("%w: rate must be between 1 and 10000 hundredths of a percent", ErrInvalidRequest)
+		}
+		if policy.Amount != 0 {
+			return fmt.Errorf("%w: only flat late fees have an amount", ErrInvalidRequest)
+		}
+	default:
+		return fmt.Errorf("%w: late fee kind must be flat, percentage or interest", ErrInvalidRequest)
+	}
+
+	switch {
+	case policy.GraceDays < 0 || policy.GraceDays > MaxLateFeeGraceDays:
+		return fmt.Errorf("%w: grace period must be between 0 and %d days", ErrInvalidRequest, MaxLateFeeGraceDays)
+	case policy.Cap < 0:
+		return fmt.Errorf("%w: cap must not be negative", ErrInvalidRequest)
+	case policy.Kind == models.LateFeeFlat && policy.Cap > 0 && policy.Cap < policy.Amount:
+		return fmt.Errorf("%w: cap must not be less than the flat fee", ErrInvalidRequest)
+	}
+	return nil
+}
+
+// formatRate formats hundredths of a percent, e.g. 150 as "1.5%".
+func formatRate(hundredths int64) string {
+	return decimal.NewFromInt(hundredths).Div(decimal.NewFromInt(100)).String() + "%"
+}
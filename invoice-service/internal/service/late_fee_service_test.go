@@ -0,0 +1,246 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAssessLateFee(t *testing.T) {
+	dueDate := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2024, time.March, 31, 15, 0, 0, 0, time.UTC)
+	today := time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		policy     *models.LateFeePolicy
+		lateFees   []*models.LateFee
+		wantAmount int64 // Zero if no fee is due
+		wantStart  time.Time
+	}{
+		{
+			name:       "flat fee",
+			policy:     &models.LateFeePolicy{Kind: models.LateFeeFlat, Amount: 2500},
+			wantAmount: 2500,
+			wantStart:  today,
+		},
+		{
+			name:       "percentage of the balance left",
+			policy:     &models.LateFeePolicy{Kind: models.LateFeePercentage, Rate: 150},
+			wantAmount: 1200, // 1.5% of 80000
+			wantStart:  today,
+		},
+		{
+			name:       "interest from the end of the grace period",
+			policy:     &models.LateFeePolicy{Kind: models.LateFeeInterest, Rate: 1825, GraceDays: 10},
+			wantAmount: 800, // 18.25% a year on 80000 for 20 days
+			wantStart:  dueDate.AddDate(0, 0, 10),
+		},
+		{
+			name:   "interest since it was last charged",
+			policy: &models.LateFeePolicy{Kind: models.LateFeeInterest, Rate: 1825},
+			lateFees: []*models.LateFee{
+				{ID: 1, Kind: models.LateFeeInterest, Amount: 800, PeriodStart: dueDate, PeriodEnd: today.AddDate(0, 0, -1)},
+			},
+			wantAmount: 40,
+			wantStart:  today.AddDate(0, 0, -1),
+		},
+		{
+			name:       "capped",
+			policy:     &models.LateFeePolicy{Kind: models.LateFeePercentage, Rate: 150, Cap: 1000},
+			wantAmount: 1000,
+			wantStart:  today,
+		},
+		{
+			name:   "still in the grace period",
+			policy: &models.LateFeePolicy{Kind: models.LateFeeFlat, Amount: 2500, GraceDays: 30},
+		},
+		{
+			name:   "one-off fee already charged and reversed",
+			policy: &models.LateFeePolicy{Kind: models.LateFeeFlat, Amount: 2500},
+			lateFees: []*models.LateFee{
+				{ID: 1, Kind: models.LateFeeFlat, Amount: 2500, ReversedAt: &now},
+			},
+		},
+		{
+			name:   "cap reached",
+			policy: &models.LateFeePolicy{Kind: models.LateFeeInterest, Rate: 1825, Cap: 800},
+			lateFees: []*models.LateFee{
+				{ID: 1, Kind: models.LateFeeInterest, Amount: 800, PeriodStart: dueDate, PeriodEnd: today.AddDate(0, 0, -1)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			invoice := &models.Invoice{
+				ID: 1, UserID: 1, Status: models.StatusOverdue, DueDate: dueDate, Total: 100000, AmountPaid: 20000,
+				LateFees: tt.lateFees,
+			}
+			for _, fee := range tt.lateFees {
+				if fee.ReversedAt == nil {
+					invoice.LateFeeTotal += fee.Amount
+				}
+			}
+			mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(invoice, nil)
+			mockRepo.On("GetLateFeePolicy", mock.Anything, int64(1)).Return(tt.policy, nil)
+			mockRepo.On("ApplyLateFee", mock.Anything, invoice, mock.Anything, today).Return(nil)
+
+			fee, _, err := svc.AssessLateFee(context.Background(), 1, now)
+
+			assert.NoError(t, err)
+			if tt.wantAmount == 0 {
+				assert.Nil(t, fee)
+				return
+			}
+			assert.Equal(t, tt.wantAmount, fee.Amount)
+			assert.Equal(t, tt.policy.Kind, fee.Kind)
+			assert.Equal(t, tt.wantStart, fee.PeriodStart)
+			assert.Equal(t, today, fee.PeriodEnd)
+			assert.NotEmpty(t, fee.Description)
+		})
+	}
+}
+
+func TestAssessLateFeeByStatus(t *testing.T) {
+	dueDate := time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2024, time.March, 31, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		status     string
+		amountPaid int64
+		wantAmount int64
+		wantErr    error
+	}{
+		{"partially paid after going overdue", models.StatusPartiallyPaid, 20000, 2500, nil},
+		{"unpaid before the overdue sweep", models.StatusUnpaid, 0, 2500, nil},
+		{"paid", models.StatusPaid, 100000, 0, service.ErrInvalidTransition},
+		{"void", models.StatusVoid, 0, 0, service.ErrInvalidTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			// Fees follow the due date and balance owing rather than the overdue status
+			invoice := &models.Invoice{ID: 1, UserID: 1, Status: tt.status, DueDate: dueDate, Total: 100000, AmountPaid: tt.amountPaid}
+			mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(invoice, nil)
+			if tt.wantErr == nil {
+				mockRepo.On("GetLateFeePolicy", mock.Anything, int64(1)).Return(&models.LateFeePolicy{Kind: models.LateFeeFlat, Amount: 2500}, nil)
+				mockRepo.On("ApplyLateFee", mock.Anything, invoice, mock.Anything, mock.Anything).Return(nil)
+			}
+
+			fee, _, err := svc.AssessLateFee(context.Background(), 1, now)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantAmount, fee.Amount)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestReverseLateFee(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	// The customer paid the invoice total but not the late fee
+	fee := &models.LateFee{ID: 4, InvoiceID: 1, UserID: 1, Kind: models.LateFeeFlat, Amount: 2500}
+	invoice := &models.Invoice{ID: 1, UserID: 1, Status: models.StatusPartiallyPaid, Total: 100000, AmountPaid: 100000, LateFeeTotal: 2500}
+	mockRepo.On("GetLateFeeByID", mock.Anything, int64(4)).Return(fee, nil)
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(invoice, nil)
+	mockRepo.On("ReverseLateFee", mock.Anything, fee, invoice, models.StatusPaid).Return(nil)
+
+	_, reversed, err := svc.ReverseLateFee(context.Background(), 4, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.StatusPaid, reversed.Status)
+	assert.Equal(t, int64(0), reversed.BalanceDue())
+	mockRepo.AssertExpectations(t)
+}
+
+func TestReverseLateFeeInvalid(t *testing.T) {
+	reversedAt := time.Now()
+	tests := []struct {
+		name    string
+		userID  int64
+		fee     *models.LateFee
+		wantErr error
+	}{
+		{
+			name:    "other user's fee",
+			userID:  2,
+			fee:     &models.LateFee{ID: 4, InvoiceID: 1, UserID: 1, Amount: 2500},
+			wantErr: service.ErrNotFound,
+		},
+		{
+			name:    "already reversed",
+			userID:  1,
+			fee:     &models.LateFee{ID: 4, InvoiceID: 1, UserID: 1, Amount: 2500, ReversedAt: &reversedAt},
+			wantErr: service.ErrInvalidRequest,
+		},
+		{
+			name:    "fee already paid",
+			userID:  1,
+			fee:     &models.LateFee{ID: 4, InvoiceID: 1, UserID: 1, Amount: 2500},
+			wantErr: service.ErrInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			mockRepo.On("GetLateFeeByID", mock.Anything, int64(4)).Return(tt.fee, nil)
+			mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(&models.Invoice{
+				ID: 1, UserID: 1, Status: models.StatusPaid, Total: 100000, AmountPaid: 102500, LateFeeTotal: 2500,
+			}, nil).Maybe()
+
+			_, _, err := svc.ReverseLateFee(context.Background(), 4, tt.userID)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			mockRepo.AssertNotCalled(t, "ReverseLateFee", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestSetLateFeePolicyInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *models.LateFeePolicy
+	}{
+		{"unknown kind", &models.LateFeePolicy{Kind: "weekly", Amount: 100}},
+		{"flat fee without an amount", &models.LateFeePolicy{Kind: models.LateFeeFlat}},
+		{"flat fee with a rate", &models.LateFeePolicy{Kind: models.LateFeeFlat, Amount: 100, Rate: 100}},
+		{"percentage over 100%", &models.LateFeePolicy{Kind: models.LateFeePercentage, Rate: 10001}},
+		{"interest with an amount", &models.LateFeePolicy{Kind: models.LateFeeInterest, Rate: 500, Amount: 100}},
+		{"negative grace period", &models.LateFeePolicy{Kind: models.LateFeeInterest, Rate: 500, GraceDays: -1}},
+		{"cap below the flat fee", &models.LateFeePolicy{Kind: models.LateFeeFlat, Amount: 2500, Cap: 1000}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			_, err := svc.SetLateFeePolicy(context.Background(), tt.policy)
+
+			assert.ErrorIs(t, err, service.ErrInvalidRequest)
+			mockRepo.AssertNotCalled(t, "SetLateFeePolicy", mock.Anything, mock.Anything)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+// Package mail renders the emails that deliver invoices to customers, as plain text and as HTML in the style of
+// the invoice's branding profile.
+package mail
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"strings"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/pkg/format"
+)
+
+// logoContentID is the content ID the HTML body refers to the logo by.
+const logoContentID = "logo"
+
+// defaultAccentColor is used for the heading when the branding profile has no accent colour.
+const defaultAccentColor = "#111111"
+
+// Message is a rendered email.
+type Message struct {
+	Subject string
+	Text    string
+	HTML    string
+	Logo    *InlineImage // Shown at the top of the HTML body, or nil
+}
+
+// InlineImage is an image sent along with the HTML body, which refers to it as cid:<ContentID>.
+type InlineImage struct {
+	ContentID   string
+	Filename    string
+	ContentType string
+	Content     []byte
+}
+
+var logoExtensions = map[string]string{
+	"image/png":  ".png",
+	"image/jpeg": ".jpg",
+}
+
+var invoiceTemplate = template.Must(template.New("invoice").Parse(`<!DOCTYPE html>
+<html>
+<body style="margin: 0; padding: 24px; background: #f5f5f5; font-family: Helvetica, Arial, sans-serif; color: #111;">
+<div style="max-width: 560px; margin: 0 auto; background: #fff; padding: 32px;">
+{{- if .Logo}}
+<img src="cid:{{.Logo}}" alt="" style="max-width: 150px; max-height: 48px; margin-bottom: 16px;">
+{{- end}}
+<h1 style="margin: 0 0 16px; font-size: 22px; color: {{.Accent}};">Invoice {{.Number}}</h1>
+<p>Hello,</p>
+<p>Please find invoice {{.Number}} for <strong>{{.Amount}}</strong>, due on {{.DueDate}}.</p>
+{{- if .Note}}
+<p style="white-space: pre-line;">{{.Note}}</p>
+{{- end}}
+{{- if .PaymentInstructions}}
+<h2 style="margin: 24px 0 8px; font-size: 12px; color: #666; text-transform: uppercase;">Payment instructions</h2>
+<p style="white-space: pre-line; margin: 0;">{{.PaymentInstructions}}</p>
+{{- end}}
+{{- if .Footer}}
+<p style="margin-top: 32px; padding-top: 12px; border-top: 1px solid #ddd; font-size: 12px; color: #666;">{{.Footer}}</p>
+{{- end}}
+</div>
+</body>
+</html>
+`))
+
+// RenderInvoiceEmail renders the email delivering an invoice, in the style of a branding profile if branding
+// isn't nil.
+func RenderInvoiceEmail(invoice *models.Invoice, branding *models.BrandingProfile) (*Message, error) {
+	data := struct {
+		Number, Amount, DueDate, Note string
+		Accent                        string
+		Logo                          string
+		PaymentInstructions, Footer   string
+	}{
+		Number:  invoice.InvoiceNumber,
+		Amount:  format.Money(invoice.Currency, invoice.BalanceDue()),
+		DueDate: format.Date(invoice.DueDate),
+		Note:    strings.TrimSpace(invoice.Note),
+		Accent:  defaultAccentColor,
+	}
+
+	message := &Message{Subject: "Invoice " + invoice.InvoiceNumber}
+	if branding != nil {
+		if branding.AccentColor != "" {
+			data.Accent = branding.AccentColor
+		}
+		data.PaymentInstructions = strings.TrimSpace(branding.PaymentInstructions)
+		data.Footer = strings.TrimSpace(branding.Footer)
+		if extension, ok := logoExtensions[branding.LogoContentType]; ok && len(branding.Logo) > 0 {
+			data.Logo = logoContentID
+			message.Logo = &InlineImage{
+				ContentID:   logoContentID,
+				Filename:    "logo" + extension,
+				ContentType: branding.LogoContentType,
+				Content:     branding.Logo,
+			}
+		}
+	}
+
+	var text strings.Builder
+	fmt.Fprintf(&text, "Hello,\n\nPlease find invoice %s for %s, due on %s.\n", data.Number, data.Amount, data.DueDate)
+	for _, section := range []string{data.Note, data.PaymentInstructions} {
+		if section != "" {
+			fmt.Fprintf(&text, "\n%s\n", section)
+		}
+	}
+	if data.Footer != "" {
+		fmt.Fprintf(&text, "\n--\n%s\n", data.Footer)
+	}
+	message.Text = text.String()
+
+	var html bytes.Buffer
+	err := invoiceTemplate.Execute(&html, data)
+	if err != nil {
+		return nil, err
+	}
+	message.HTML = html.String()
+	return message, nil
+}
@@ -0,0 +1,54 @@
+package mail_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service/mail"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderInvoiceEmail(t *testing.T) {
+	invoice := &models.Invoice{
+		InvoiceNumber: "INV-000042",
+		Currency:      "EUR",
+		Total:         123456789,
+		AmountPaid:    100,
+		DueDate:       time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC),
+		Note:          "Thanks for <everything>",
+	}
+
+	t.Run("unbranded", func(t *testing.T) {
+		message, err := mail.RenderInvoiceEmail(invoice, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Invoice INV-000042", message.Subject)
+		assert.Equal(t, "Hello,\n\nPlease find invoice INV-000042 for EUR 1,234,566.89, due on 31 Mar 2024.\n\nThanks for <everything>\n", message.Text)
+		assert.Contains(t, message.HTML, "Thanks for &lt;everything&gt;")
+		assert.NotContains(t, message.HTML, "cid:")
+		assert.Nil(t, message.Logo)
+	})
+
+	t.Run("branded", func(t *testing.T) {
+		branding := &models.BrandingProfile{
+			AccentColor:         "#1a73e8",
+			Footer:              "Acme Studio Ltd",
+			PaymentInstructions: "Pay by bank transfer.",
+			LogoContentType:     "image/png",
+			Logo:                []byte("png"),
+		}
+
+		message, err := mail.RenderInvoiceEmail(invoice, branding)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Hello,\n\nPlease find invoice INV-000042 for EUR 1,234,566.89, due on 31 Mar 2024.\n\n"+
+			"Thanks for <everything>\n\nPay by bank transfer.\n\n--\nAcme Studio Ltd\n", message.Text)
+		assert.Contains(t, message.HTML, `src="cid:logo"`)
+		assert.Contains(t, message.HTML, "color: #1a73e8;")
+		assert.Contains(t, message.HTML, "Pay by bank transfer.")
+		assert.Contains(t, message.HTML, "Acme Studio Ltd")
+		assert.Equal(t, &mail.InlineImage{ContentID: "logo", Filename: "logo.png", ContentType: "image/png", Content: []byte("png")}, message.Logo)
+	})
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+// GetNumberingScheme returns the user's numbering scheme, or the default scheme if they have not chosen
+// one, along with the number the next invoice issued today would get.
+func (s *InvoiceService) GetNumberingScheme(ctx context.Context, userID int64) (*models.NumberingScheme, string, error) {
+	scheme, err := s.repo.GetNumberingScheme(ctx, userID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, "", err
+		}
+		scheme = &models.NumberingScheme{UserID: userID, Format: models.DefaultInvoiceNumberFormat}
+	}
+
+	nextNumber, err := s.nextInvoiceNumber(ctx, scheme)
+	if err != nil {
+		return nil, "", err
+	}
+	return scheme, nextNumber, nil
+}
+
+// UpdateNumberingScheme changes how the user's invoices are numbered from the next invoice on. Existing
+// invoices keep their numbers.
+func (s *InvoiceService) UpdateNumberingScheme(ctx context.Context, scheme *models.NumberingScheme) (*models.NumberingScheme, string, error) {
+	err := validateNumberingScheme(scheme)
+	if err != nil {
+		return nil, "", err
+	}
+
+	err = s.repo.SetNumberingScheme(ctx, scheme)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextNumber, err := s.nextInvoiceNumber(ctx, scheme)
+	if err != nil {
+		return nil, "", err
+	}
+	return scheme, nextNumber, nil
+}
+
+func (s *InvoiceService) nextInvoiceNumber(ctx context.Context, scheme *models.NumberingScheme) (string, error) {
+	now := time.Now()
+	seq, err := s.repo.GetInvoiceNumberCounter(ctx, scheme.UserID, scheme.SequenceYear(now))
+	if err != nil {
+		return "", err
+	}
+	return scheme.FormatNumber(seq+1, now), nil
+}
+
+func validateNumberingScheme(scheme *models.NumberingScheme) error {
+	scheme.Format = strings.TrimSpace(scheme.Format)
+	if scheme.Format == "" {
+		return fmt.Errorf("%w: numbering format is required", ErrInvalidRequest)
+	}
+	if len(scheme.Format) > 32 {
+		return fmt.Errorf("%w: numbering format must be at most 32 characters", ErrInvalidRequest)
+	}
+
+	sequences, hasYear := 0, false
+	for _, match := range models.NumberPlaceholder.FindAllStringSubmatch(scheme.Format, -1) {
+		name := match[1]
+		switch name {
+		case "YYYY", "YY":
+			hasYear = true
+		case "MM":
+		case "seq":
+			sequences++
+		default:
+			width, ok := models.SequenceWidth(name)
+			if !ok {
+				return fmt.Errorf("%w: unknown placeholder %s in numbering format", ErrInvalidRequest, match[0])
+			}
+			if width > 12 {
+				return fmt.Errorf("%w: sequence numbers can be padded to at most 12 digits", ErrInvalidRequest)
+			}
+			sequences++
+		}
+	}
+
+	switch {
+	case sequences != 1:
+		return fmt.Errorf("%w: numbering format must contain {seq} exactly once", ErrInvalidRequest)
+	case scheme.YearlyReset && !hasYear:
+		return fmt.Errorf("%w: a sequence that resets yearly needs {YYYY} or {YY} in the format", ErrInvalidRequest)
+	}
+	return nil
+}
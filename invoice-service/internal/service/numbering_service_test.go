@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestNumberingSchemeFormatNumber(t *testing.T) {
+	issued := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		format string
+		seq    int64
+		want   string
+	}{
+		{"{seq:6}", 1, "000001"},
+		{"INV-{YYYY}-{seq:5}", 42, "INV-2024-00042"},
+		{"{YY}{MM}/{seq}", 7, "2403/7"},
+		{"INV-{seq:3}", 12345, "INV-12345"},
+		{"{seq:2}-{unknown}", 3, "03-{unknown}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			scheme := &models.NumberingScheme{Format: tt.format}
+			assert.Equal(t, tt.want, scheme.FormatNumber(tt.seq, issued))
+		})
+	}
+}
+
+func TestNumberingSchemeSequenceYear(t *testing.T) {
+	issued := time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 0, (&models.NumberingScheme{}).SequenceYear(issued))
+	assert.Equal(t, 2024, (&models.NumberingScheme{YearlyReset: true}).SequenceYear(issued))
+}
+
+func TestGetNumberingScheme_Default(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	mockRepo.On("GetNumberingScheme", mock.Anything, int64(1)).Return((*models.NumberingScheme)(nil), sql.ErrNoRows)
+	mockRepo.On("GetInvoiceNumberCounter", mock.Anything, int64(1), 0).Return(int64(100041), nil)
+
+	scheme, nextNumber, err := svc.GetNumberingScheme(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.DefaultInvoiceNumberFormat, scheme.Format)
+	assert.False(t, scheme.YearlyReset)
+	assert.Equal(t, "100042", nextNumber)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestUpdateNumberingScheme(t *testing.T) {
+	tests := []struct {
+		name        string
+		format      string
+		yearlyReset bool
+		wantErr     error
+	}{
+		{"yearly sequence", "INV-{YYYY}-{seq:5}", true, nil},
+		{"plain sequence", "{seq}", false, nil},
+		{"short year", "{YY}-{seq:4}", true, nil},
+		{"empty", "  ", false, service.ErrInvalidRequest},
+		{"too long", "INVOICE-NUMBER-FOR-{YYYY}-{seq:5}", false, service.ErrInvalidRequest},
+		{"missing sequence", "INV-{YYYY}", false, service.ErrInvalidRequest},
+		{"two sequences", "{seq}-{seq:2}", false, service.ErrInvalidRequest},
+		{"unknown placeholder", "{DD}-{seq}", false, service.ErrInvalidRequest},
+		{"bad padding", "{seq:x}", false, service.ErrInvalidRequest},
+		{"padding too wide", "{seq:13}", false, service.ErrInvalidRequest},
+		{"yearly reset without year", "INV-{seq:5}", true, service.ErrInvalidRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			if tt.wantErr == nil {
+				mockRepo.On("SetNumberingScheme", mock.Anything, mock.Anything).Return(nil)
+				mockRepo.On("GetInvoiceNumberCounter", mock.Anything, int64(1), mock.Anything).Return(int64(0), nil)
+			}
+
+			scheme, nextNumber, err := svc.UpdateNumberingScheme(context.Background(), &models.NumberingScheme{
+				UserID:      1,
+				Format:      tt.format,
+				YearlyReset: tt.yearlyReset,
+			})
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, scheme.FormatNumber(1, time.Now()), nextNumber)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
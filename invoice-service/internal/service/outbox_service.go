@@ -0,0 +1,109 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+const (
+	// A failed event is retried after outboxInitialBackoff, doubling with each attempt up to outboxMaxBackoff,
+	// and given up on after maxOutboxAttempts, about an hour after its first attempt
+	outboxInitialBackoff = time.Second
+	outboxMaxBackoff     = 5 * time.Minute
+	maxOutboxAttempts    = 20
+
+	defaultOutboxListLimit = 50
+	maxOutboxListLimit     = 500
+)
+
+var outboxStatuses = map[string]bool{
+	models.OutboxStatusPending:   true,
+	models.OutboxStatusPublished: true,
+	models.OutboxStatusFailed:    true,
+}
+
+// RecordInvoiceSent records that an invoice was emailed to its customer.
+func (s *InvoiceService) RecordInvoiceSent(ctx context.Context, invoice *models.Invoice) error {
+	invoice.RecordActivity(models.ActivityInvoiceSent, fmt.Sprintf("Sent invoice %s to user %d", invoice.InvoiceNumber, invoice.UserID))
+	return s.repo.CreateActivities(ctx, invoice)
+}
+
+// DueOutboxEvents returns one batch of outbox events ready to be published, in the order they must be published.
+func (s *InvoiceService) DueOutboxEvents(ctx context.Context, now time.Time, batchSize int) ([]*models.OutboxEvent, error) {
+	if batchSize <= 0 {
+		return nil, ErrInvalidRequest
+	}
+	return s.repo.ListDueOutboxEvents(ctx, now, batchSize)
+}
+
+// MarkOutboxEventPublished records that the broker confirmed an outbox event.
+func (s *InvoiceService) MarkOutboxEventPublished(ctx context.Context, event *models.OutboxEvent) error {
+	return s.repo.MarkOutboxEventPublished(ctx, event)
+}
+
+// MarkOutboxEventFailed records a failed attempt to publish an outbox event at now. The event is retried with
+// exponential backoff until it runs out of attempts, when it is marked failed and waits for an operator.
+func (s *InvoiceService) MarkOutboxEventFailed(ctx context.Context, event *models.OutboxEvent, publishErr error, now time.Time) error {
+	event.Attempts++
+	event.LastError = publishErr.Error()
+	event.NextAttemptAt = now.Add(outboxBackoff(event.Attempts))
+	if event.Attempts >= maxOutboxAttempts {
+		event.Status = models.OutboxStatusFailed
+	}
+	return s.repo.MarkOutboxEventFailed(ctx, event)
+}
+
+// ListOutboxEvents returns the most recent outbox events in a status, or of every status if status is empty,
+// along with the number of events in each status.
+func (s *InvoiceService) ListOutboxEvents(ctx context.Context, status string, limit int) ([]*models.OutboxEvent, *models.OutboxStats, error) {
+	if status != "" && !outboxStatuses[status] {
+		return nil, nil, fmt.Errorf("%w: unknown outbox status %q", ErrInvalidRequest, status)
+	}
+	if limit <= 0 {
+		limit = defaultOutboxListLimit
+	}
+	limit = min(limit, maxOutboxListLimit)
+
+	events, err := s.repo.ListOutboxEvents(ctx, status, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	stats, err := s.repo.GetOutboxStats(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return events, stats, nil
+}
+
+// RetryOutboxEvent gives a failed outbox event a fresh set of attempts, starting now. Later events of the same
+// invoice are published after it.
+func (s *InvoiceService) RetryOutboxEvent(ctx context.Context, eventID int64) (*models.OutboxEvent, error) {
+	event, err := s.repo.RetryOutboxEvent(ctx, eventID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: no failed outbox event %d", ErrNotFound, eventID)
+		}
+		return nil, err
+	}
+	return event, nil
+}
+
+// DeletePublishedOutboxEvents deletes outbox events published before a cutoff, returning how many were deleted.
+func (s *InvoiceService) DeletePublishedOutboxEvents(ctx context.Context, before time.Time) (int64, error) {
+	return s.repo.DeletePublishedOutboxEvents(ctx, before)
+}
+
+// outboxBackoff returns how long to wait before the next attempt to publish an event that has failed attempts
+// times.
+func outboxBackoff(attempts int32) time.Duration {
+	backoff := outboxInitialBackoff
+	for i := int32(1); i < attempts && backoff < outboxMaxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, outboxMaxBackoff)
+}
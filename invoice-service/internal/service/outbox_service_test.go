@@ -0,0 +1,105 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRecordPaymentActivities(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	current := &models.Invoice{ID: 1, UserID: 3, InvoiceNumber: "INV-1", Status: "partially_paid", Total: 10000, AmountPaid: 4000}
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(current, nil)
+
+	// The activities are recorded before the payment is saved, so the repository writes them in its transaction
+	var activities []models.Activity
+	mockRepo.On("CreatePayment", mock.Anything, mock.Anything, current, "paid").Run(func(args mock.Arguments) {
+		activities = args.Get(2).(*models.Invoice).TakeActivities(1, 3)
+	}).Return(nil)
+
+	_, err := svc.RecordPayment(context.Background(), &models.Payment{InvoiceID: 1, Amount: 6000, Method: "card"}, 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Activity{
+		{InvoiceID: 1, UserID: 3, Action: models.ActivityPaymentRecorded, Description: "Recorded payment of 6000 on invoice INV-1"},
+		{InvoiceID: 1, UserID: 3, Action: models.ActivityInvoicePaid, Description: "Invoice INV-1 paid in full"},
+	}, activities)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRecordInvoiceSent(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	invoice := &models.Invoice{ID: 1, UserID: 3, InvoiceNumber: "INV-1"}
+	mockRepo.On("CreateActivities", mock.Anything, invoice).Return(nil)
+
+	err := svc.RecordInvoiceSent(context.Background(), invoice)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Activity{
+		{InvoiceID: 1, UserID: 3, Action: models.ActivityInvoiceSent, Description: "Sent invoice INV-1 to user 3"},
+	}, invoice.TakeActivities(1, 3))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestMarkOutboxEventFailed(t *testing.T) {
+	now := time.Date(2024, 9, 30, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name        string
+		attempts    int32
+		wantStatus  string
+		wantBackoff time.Duration
+	}{
+		{"first failure", 0, "pending", time.Second},
+		{"third failure", 2, "pending", 4 * time.Second},
+		{"backoff capped", 12, "pending", 5 * time.Minute},
+		{"out of attempts", 19, "failed", 5 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			event := &models.OutboxEvent{ID: 1, Status: "pending", Attempts: tt.attempts}
+			mockRepo.On("MarkOutboxEventFailed", mock.Anything, event).Return(nil)
+
+			err := svc.MarkOutboxEventFailed(context.Background(), event, errors.New("connection refused"), now)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.attempts+1, event.Attempts)
+			assert.Equal(t, tt.wantStatus, event.Status)
+			assert.Equal(t, "connection refused", event.LastError)
+			assert.Equal(t, now.Add(tt.wantBackoff), event.NextAttemptAt)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestListOutboxEvents(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	events := []*models.OutboxEvent{{ID: 2, Status: "failed"}}
+	mockRepo.On("ListOutboxEvents", mock.Anything, "failed", 500).Return(events, nil)
+	mockRepo.On("GetOutboxStats", mock.Anything).Return(&models.OutboxStats{Pending: 4, Published: 10, Failed: 1}, nil)
+
+	listed, stats, err := svc.ListOutboxEvents(context.Background(), "failed", 1000)
+
+	assert.NoError(t, err)
+	assert.Equal(t, events, listed)
+	assert.Equal(t, int64(1), stats.Failed)
+
+	_, _, err = svc.ListOutboxEvents(context.Background(), "lost", 0)
+	assert.ErrorIs(t, err, service.ErrInvalidRequest)
+	mockRepo.AssertExpectations(t)
+}
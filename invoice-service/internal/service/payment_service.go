@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+var paymentMethods = map[string]bool{
+	models.PaymentMethodBankTransfer: true,
+	models.PaymentMethodCard:         true,
+	models.PaymentMethodCash:         true,
+	models.PaymentMethodCheque:       true,
+	models.PaymentMethodOther:        true,
+}
+
+// RecordPayment records a payment against an issued invoice. The invoice moves to partially_paid,
+// or to paid once the balance due reaches zero.
+func (s *InvoiceService) RecordPayment(ctx context.Context, payment *models.Payment, userID int64) (*models.Invoice, error) {
+	return s.recordPayment(ctx, payment, userID, func(invoice *models.Invoice, status string) {
+		invoice.RecordActivity(models.ActivityPaymentRecorded,
+			fmt.Sprintf("Recorded payment of %d on invoice %s", payment.Amount, invoice.InvoiceNumber))
+		if status == models.StatusPaid {
+			invoice.RecordActivity(models.ActivityInvoicePaid, fmt.Sprintf("Invoice %s paid in full", invoice.InvoiceNumber))
+		}
+	})
+}
+
+// recordPayment records a payment, calling record to record the activities saved with it once the invoice's
+// new status is known.
+func (s *InvoiceService) recordPayment(ctx context.Context, payment *models.Payment, userID int64, record func(invoice *models.Invoice, status string)) (*models.Invoice, error) {
+	invoice, err := s.GetInvoice(ctx, payment.InvoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.UserID != userID {
+		return nil, ErrNotFound
+	}
+
+	switch invoice.Status {
+	case models.StatusUnpaid, models.StatusPartiallyPaid, models.StatusOverdue:
+	default:
+		return nil, ErrInvalidTransition
+	}
+
+	if payment.Amount <= 0 {
+		return nil, fmt.Errorf("%w: payment amount must be positive", ErrInvalidRequest)
+	}
+	if payment.Amount > invoice.BalanceDue() {
+		return nil, fmt.Errorf("%w: payment exceeds the balance due", ErrInvalidRequest)
+	}
+	if !paymentMethods[payment.Method] {
+		return nil, fmt.Errorf("%w: unknown payment method %q", ErrInvalidRequest, payment.Method)
+	}
+	if payment.PaymentDate.IsZero() {
+		payment.PaymentDate = time.Now()
+	}
+
+	status := statusForBalance(invoice.BalanceDue() - payment.Amount)
+	record(invoice, status)
+
+	err = s.repo.CreatePayment(ctx, payment, invoice, status)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrConflict
+		}
+		return nil, err
+	}
+	invoice.AmountPaid += payment.Amount
+	invoice.Status = status
+	invoice.Version++
+
+	return invoice, nil
+}
+
+// MarkPaid marks an issued invoice as paid in full by recording a payment for the balance due. An invoice
+// with nothing left to pay, such as one settled by credit, moves straight to paid.
+func (s *InvoiceService) MarkPaid(ctx context.Context, invoiceID, userID int64) (*models.Invoice, error) {
+	invoice, err := s.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.UserID != userID {
+		return nil, ErrNotFound
+	}
+	if !canTransition(invoice.Status, models.StatusPaid) {
+		return nil, ErrInvalidTransition
+	}
+
+	if invoice.BalanceDue() <= 0 {
+		invoice.RecordActivity(models.ActivityInvoicePaid, fmt.Sprintf("Marked invoice %s as paid", invoice.InvoiceNumber))
+		return s.transitionInvoice(ctx, invoice, models.StatusPaid)
+	}
+
+	return s.recordPayment(ctx, &models.Payment{
+		InvoiceID: invoice.ID,
+		Amount:    invoice.BalanceDue(),
+		Method:    models.PaymentMethodOther,
+		Reference: "Marked as paid",
+	}, userID, func(invoice *models.Invoice, _ string) {
+		invoice.RecordActivity(models.ActivityInvoicePaid, fmt.Sprintf("Marked invoice %s as paid", invoice.InvoiceNumber))
+	})
+}
+
+func (s *InvoiceService) ListPayments(ctx context.Context, invoiceID, userID int64) ([]*models.Payment, error) {
+	invoice, err := s.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.UserID != userID {
+		return nil, ErrNotFound
+	}
+
+	return s.repo.ListPaymentsByInvoiceID(ctx, invoiceID)
+}
+
+// RefundPayment refunds a payment in full and takes it back off the invoice's amount paid.
+func (s *InvoiceService) RefundPayment(ctx context.Context, paymentID, userID int64) (*models.Payment, *models.Invoice, error) {
+	payment, err := s.repo.GetPaymentByID(ctx, paymentID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrNotFound
+		}
+		return nil, nil, err
+	}
+
+	invoice, err := s.GetInvoice(ctx, payment.InvoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if invoice.UserID != userID {
+		return nil, nil, ErrNotFound
+	}
+	if payment.RefundedAt != nil {
+		return nil, nil, fmt.Errorf("%w: payment has already been refunded", ErrInvalidRequest)
+	}
+
+	amountPaid := invoice.AmountPaid - payment.Amount
+	status := statusAfterRefund(invoice.Status, amountPaid+invoice.AmountCredited)
+	if status != invoice.Status && !canTransition(invoice.Status, status) {
+		return nil, nil, ErrInvalidTransition
+	}
+
+	invoice.RecordActivity(models.ActivityPaymentRefunded,
+		fmt.Sprintf("Refunded payment of %d on invoice %s", payment.Amount, invoice.InvoiceNumber))
+	err = s.repo.RefundPayment(ctx, payment, invoice, status)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrConflict
+		}
+		return nil, nil, err
+	}
+	invoice.AmountPaid = amountPaid
+	invoice.Status = status
+	invoice.Version++
+
+	return payment, invoice, nil
+}
+
+// statusForBalance returns the status of an issued invoice that has been partly settled, leaving balanceDue.
+func statusForBalance(balanceDue int64) string {
+	if balanceDue == 0 {
+		return models.StatusPaid
+	}
+	return models.StatusPartiallyPaid
+}
+
+// statusAfterRefund returns the status an invoice should have once the amount settled by payments and
+// credit drops to amountSettled. Void and overdue invoices keep their status.
+func statusAfterRefund(status string, amountSettled int64) string {
+	switch {
+	case status == models.StatusVoid || status == models.StatusOverdue:
+		return status
+	case amountSettled == 0:
+		return models.StatusUnpaid
+	default:
+		return models.StatusPartiallyPaid
+	}
+}
@@ -0,0 +1,166 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRecordPayment(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		amountPaid int64
+		amount     int64
+		method     string
+		wantStatus string
+		wantErr    error
+	}{
+		{"partial payment", "unpaid", 0, 4000, "card", "partially_paid", nil},
+		{"settles balance", "partially_paid", 4000, 6000, "bank_transfer", "paid", nil},
+		{"overdue partial payment", "overdue", 0, 5000, "cash", "partially_paid", nil},
+		{"exceeds balance", "partially_paid", 4000, 6001, "card", "", service.ErrInvalidRequest},
+		{"zero amount", "unpaid", 0, 0, "card", "", service.ErrInvalidRequest},
+		{"unknown method", "unpaid", 0, 100, "barter", "", service.ErrInvalidRequest},
+		{"draft invoice", "draft", 0, 100, "card", "", service.ErrInvalidTransition},
+		{"paid invoice", "paid", 10000, 100, "card", "", service.ErrInvalidTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			current := &models.Invoice{ID: 1, UserID: 1, Status: tt.status, Total: 10000, AmountPaid: tt.amountPaid}
+			mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(current, nil)
+			if tt.wantErr == nil {
+				mockRepo.On("CreatePayment", mock.Anything, mock.Anything, mock.Anything, tt.wantStatus).Return(nil)
+			}
+
+			invoice, err := svc.RecordPayment(context.Background(), &models.Payment{InvoiceID: 1, Amount: tt.amount, Method: tt.method}, 1)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantStatus, invoice.Status)
+				assert.Equal(t, tt.amountPaid+tt.amount, invoice.AmountPaid)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestMarkPaid(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	current := &models.Invoice{ID: 1, UserID: 3, InvoiceNumber: "INV-1", Status: "overdue", Total: 10000, AmountPaid: 2500}
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(current, nil)
+	mockRepo.On("CreatePayment", mock.Anything, mock.MatchedBy(func(p *models.Payment) bool {
+		return p.Amount == 7500
+	}), mock.Anything, "paid").Return(nil)
+
+	invoice, err := svc.MarkPaid(context.Background(), 1, 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "paid", invoice.Status)
+	assert.Equal(t, int64(0), invoice.BalanceDue())
+
+	// Marking an invoice paid is logged as such, rather than as a payment
+	assert.Equal(t, []models.Activity{
+		{InvoiceID: 1, UserID: 3, Action: models.ActivityInvoicePaid, Description: "Marked invoice INV-1 as paid"},
+	}, invoice.TakeActivities(1, 3))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestMarkPaidZeroBalance(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	// Credit has already settled the invoice, so there is no payment to record
+	current := &models.Invoice{ID: 1, UserID: 3, InvoiceNumber: "INV-1", Status: "unpaid", Total: 10000, AmountCredited: 10000}
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(current, nil)
+	mockRepo.On("UpdateInvoiceStatus", mock.Anything, current, "unpaid", "paid").Return(nil)
+
+	invoice, err := svc.MarkPaid(context.Background(), 1, 3)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "paid", invoice.Status)
+	assert.Equal(t, []models.Activity{
+		{InvoiceID: 1, UserID: 3, Action: models.ActivityInvoicePaid, Description: "Marked invoice INV-1 as paid"},
+	}, invoice.TakeActivities(1, 3))
+	mockRepo.AssertNotCalled(t, "CreatePayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestMarkPaidDraft(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(&models.Invoice{ID: 1, UserID: 1, Status: "draft", Total: 10000}, nil)
+
+	_, err := svc.MarkPaid(context.Background(), 1, 1)
+
+	assert.ErrorIs(t, err, service.ErrInvalidTransition)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRefundPayment(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		amountPaid int64
+		amount     int64
+		wantStatus string
+	}{
+		{"refund only payment", "paid", 10000, 10000, "unpaid"},
+		{"refund one of several payments", "paid", 10000, 4000, "partially_paid"},
+		{"refund on overdue invoice", "overdue", 4000, 4000, "overdue"},
+		{"refund on void invoice", "void", 10000, 10000, "void"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			payment := &models.Payment{ID: 7, InvoiceID: 1, Amount: tt.amount, Method: "card"}
+			current := &models.Invoice{ID: 1, UserID: 1, Status: tt.status, Total: 10000, AmountPaid: tt.amountPaid}
+			mockRepo.On("GetPaymentByID", mock.Anything, int64(7)).Return(payment, nil)
+			mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(current, nil)
+			mockRepo.On("RefundPayment", mock.Anything, payment, mock.Anything, tt.wantStatus).Return(nil)
+
+			_, invoice, err := svc.RefundPayment(context.Background(), 7, 1)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, invoice.Status)
+			assert.Equal(t, tt.amountPaid-tt.amount, invoice.AmountPaid)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestPaymentsCheckOwner(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	// Payments on another user's invoice look like they don't exist
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(&models.Invoice{ID: 1, UserID: 2, Status: "paid", Total: 10000, AmountPaid: 10000}, nil)
+	mockRepo.On("GetPaymentByID", mock.Anything, int64(7)).Return(&models.Payment{ID: 7, InvoiceID: 1, Amount: 10000, Method: "card"}, nil)
+
+	_, err := svc.RecordPayment(context.Background(), &models.Payment{InvoiceID: 1, Amount: 100, Method: "card"}, 1)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+	_, err = svc.ListPayments(context.Background(), 1, 1)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+	_, _, err = svc.RefundPayment(context.Background(), 7, 1)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+
+	mockRepo.AssertNotCalled(t, "CreatePayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "ListPaymentsByInvoiceID", mock.Anything, mock.Anything)
+	mockRepo.AssertNotCalled(t, "RefundPayment", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
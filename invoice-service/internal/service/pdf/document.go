@@ -0,0 +1,296 @@
+// Package pdf renders invoices as PDF documents. It writes PDF 1.4 directly and uses the standard
+// Helvetica fonts, so no fonts are embedded and the same invoice always renders to the same bytes.
+package pdf
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// A4 page size in points.
+const (
+	pageWidth  = 595.28
+	pageHeight = 841.89
+)
+
+type font int
+
+const (
+	regular font = iota
+	bold
+)
+
+// rgb is a colour with components ranging from 0 to 1.
+type rgb struct {
+	r, g, b float64
+}
+
+// parseColor parses a colour in #rrggbb form.
+func parseColor(hex string) (rgb, bool) {
+	if len(hex) != 7 || hex[0] != '#' {
+		return rgb{}, false
+	}
+	v, err := strconv.ParseUint(hex[1:], 16, 32)
+	if err != nil {
+		return rgb{}, false
+	}
+	return rgb{float64(v>>16) / 255, float64(v>>8&0xff) / 255, float64(v&0xff) / 255}, true
+}
+
+// tint mixes c with white, keeping the given share of c.
+func (c rgb) tint(share float64) rgb {
+	return rgb{1 - (1-c.r)*share, 1 - (1-c.g)*share, 1 - (1-c.b)*share}
+}
+
+func (c rgb) String() string {
+	return fmt.Sprintf("%s %s %s", formatNumber(c.r), formatNumber(c.g), formatNumber(c.b))
+}
+
+// document is a PDF document under construction. Each page is a content stream of drawing operators.
+type document struct {
+	title  string
+	pages  []*page
+	images []*imageObject
+}
+
+type page struct {
+	content bytes.Buffer
+}
+
+func (d *document) addPage() *page {
+	p := &page{}
+	d.pages = append(d.pages, p)
+	return p
+}
+
+// text draws s with its baseline starting at x, y. gray ranges from 0 (black) to 1 (white).
+func (p *page) text(x, y float64, f font, size, gray float64, s string) {
+	fmt.Fprintf(&p.content, "BT /F%d %s Tf %s g 1 0 0 1 %s %s Tm (%s) Tj ET\n",
+		f+1, formatNumber(size), formatNumber(gray), formatNumber(x), formatNumber(y), escape(encode(s)))
+}
+
+// textRight draws s so that it ends at x.
+func (p *page) textRight(x, y float64, f font, size, gray float64, s string) {
+	p.text(x-textWidth(s, f, size), y, f, size, gray, s)
+}
+
+// textColor draws s like text, in colour c.
+func (p *page) textColor(x, y float64, f font, size float64, c rgb, s string) {
+	fmt.Fprintf(&p.content, "BT /F%d %s Tf %s rg 1 0 0 1 %s %s Tm (%s) Tj ET\n",
+		f+1, formatNumber(size), c, formatNumber(x), formatNumber(y), escape(encode(s)))
+}
+
+func (p *page) line(x1, y1, x2, y2, width, gray float64) {
+	fmt.Fprintf(&p.content, "%s G %s w %s %s m %s %s l S\n",
+		formatNumber(gray), formatNumber(width), formatNumber(x1), formatNumber(y1), formatNumber(x2), formatNumber(y2))
+}
+
+func (p *page) fillRect(x, y, w, h, gray float64) {
+	fmt.Fprintf(&p.content, "%s g %s %s %s %s re f\n",
+		formatNumber(gray), formatNumber(x), formatNumber(y), formatNumber(w), formatNumber(h))
+}
+
+func (p *page) fillRectColor(x, y, w, h float64, c rgb) {
+	fmt.Fprintf(&p.content, "%s rg %s %s %s %s re f\n",
+		c, formatNumber(x), formatNumber(y), formatNumber(w), formatNumber(h))
+}
+
+// drawImage draws the document image with the given index scaled to w by h points, with its lower left corner
+// at x, y.
+func (p *page) drawImage(index int, x, y, w, h float64) {
+	fmt.Fprintf(&p.content, "q %s 0 0 %s %s %s cm /Im%d Do Q\n",
+		formatNumber(w), formatNumber(h), formatNumber(x), formatNumber(y), index+1)
+}
+
+// addImage adds an image any page can draw and returns its index.
+func (d *document) addImage(img *imageObject) int {
+	d.images = append(d.images, img)
+	return len(d.images) - 1
+}
+
+// bytes serializes the document. Objects 1 to 5 are the catalog, the page tree, the two fonts and the
+// document information; each page then takes two objects, the page and its content stream, and each image
+// one object, or two if it has a soft mask.
+func (d *document) bytes() []byte {
+	var buf bytes.Buffer
+	var offsets []int
+	writeObject := func(body string) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	// The comment of high bytes marks the file as binary
+	buf.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	kids := make([]string, len(d.pages))
+	for i := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", 6+2*i)
+	}
+	writeObject("<< /Type /Catalog /Pages 2 0 R >>")
+	writeObject(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages)))
+	writeObject("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+	writeObject("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>")
+	writeObject(fmt.Sprintf("<< /Title (%s) /Producer (Numer) >>", escape(encode(d.title))))
+
+	// Every page can draw every image, so they share one XObject dictionary
+	resources := "/Font << /F1 3 0 R /F2 4 0 R >>"
+	imageObjects := make([]int, len(d.images))
+	if len(d.images) > 0 {
+		next := 6 + 2*len(d.pages)
+		xobjects := make([]string, len(d.images))
+		for i, img := range d.images {
+			imageObjects[i] = next
+			xobjects[i] = fmt.Sprintf("/Im%d %d 0 R", i+1, next)
+			next++
+			if img.mask != nil {
+				next++
+			}
+		}
+		resources += fmt.Sprintf(" /XObject << %s >>", strings.Join(xobjects, " "))
+	}
+
+	for i, p := range d.pages {
+		writeObject(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] "+
+			"/Resources << %s >> /Contents %d 0 R >>",
+			formatNumber(pageWidth), formatNumber(pageHeight), resources, 7+2*i))
+		writeObject(fmt.Sprintf("<< /Length %d >>\nstream\n%s\nendstream", p.content.Len(), p.content.Bytes()))
+	}
+
+	for i, img := range d.images {
+		smask := ""
+		if img.mask != nil {
+			smask = fmt.Sprintf(" /SMask %d 0 R", imageObjects[i]+1)
+		}
+		writeObject(img.object(smask))
+		if img.mask != nil {
+			writeObject(img.mask.object(""))
+		}
+	}
+
+	// Cross-reference table, one 20-byte entry per object
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R /Info 5 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return buf.Bytes()
+}
+
+// textWidth returns the width of s in points when set in font f at the given size.
+func textWidth(s string, f font, size float64) float64 {
+	widths := helveticaWidths
+	if f == bold {
+		widths = helveticaBoldWidths
+	}
+
+	total := 0
+	for _, b := range []byte(encode(s)) {
+		if b >= 32 && b <= 126 {
+			total += widths[b-32]
+		} else {
+			total += 556
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+// wrap breaks s into lines no wider than maxWidth, keeping explicit line breaks. Words too long for a
+// line are split.
+func wrap(s string, f font, size, maxWidth float64) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n") {
+		line := ""
+		for _, word := range strings.Fields(paragraph) {
+			candidate := word
+			if line != "" {
+				candidate = line + " " + word
+			}
+			if textWidth(candidate, f, size) <= maxWidth {
+				line = candidate
+				continue
+			}
+			if line != "" {
+				lines = append(lines, line)
+			}
+
+			// Split a word that doesn't fit on a line of its own
+			line = ""
+			for _, r := range word {
+				if line != "" && textWidth(line+string(r), f, size) > maxWidth {
+					lines = append(lines, line)
+					line = ""
+				}
+				line += string(r)
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// winAnsi maps the characters outside Latin-1 that WinAnsiEncoding can represent.
+var winAnsi = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87, 'ˆ': 0x88, '‰': 0x89,
+	'Š': 0x8a, '‹': 0x8b, 'Œ': 0x8c, 'Ž': 0x8e, '‘': 0x91, '’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95,
+	'–': 0x96, '—': 0x97, '˜': 0x98, '™': 0x99, 'š': 0x9a, '›': 0x9b, 'œ': 0x9c, 'ž': 0x9e, 'Ÿ': 0x9f,
+}
+
+// encode converts s to WinAnsiEncoding, replacing characters it can't represent with '?' and dropping
+// control characters.
+func encode(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\t':
+			b.WriteByte(' ')
+		case r < 32 || r == 127:
+		case r < 127 || (r >= 0xa0 && r <= 0xff):
+			b.WriteByte(byte(r))
+		default:
+			if c, ok := winAnsi[r]; ok {
+				b.WriteByte(c)
+			} else {
+				b.WriteByte('?')
+			}
+		}
+	}
+	return b.String()
+}
+
+// escape escapes the characters that are special inside a PDF literal string.
+func escape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `(`, `\(`, `)`, `\)`).Replace(s)
+}
+
+// formatNumber formats a coordinate or size with at most two decimals.
+func formatNumber(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 2, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+// Glyph widths of the printable ASCII characters, in thousandths of the font size, from the Adobe font metrics.
+var helveticaWidths = [95]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278, // space to /
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556, // 0 to ?
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778, // @ to O
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556, // P to _
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556, // ` to o
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584, // p to ~
+}
+
+var helveticaBoldWidths = [95]int{
+	278, 333, 474, 556, 556, 889, 722, 238, 333, 333, 389, 584, 278, 333, 278, 278, // space to /
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 333, 333, 584, 584, 584, 611, // 0 to ?
+	975, 722, 722, 722, 722, 667, 611, 778, 722, 278, 556, 722, 611, 833, 722, 778, // @ to O
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 333, 278, 333, 584, 556, // P to _
+	333, 556, 611, 556, 611, 556, 333, 611, 611, 278, 278, 556, 278, 889, 611, 611, // ` to o
+	611, 611, 389, 556, 333, 611, 556, 778, 556, 556, 500, 389, 280, 389, 584, // p to ~
+}
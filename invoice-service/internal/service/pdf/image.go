@@ -0,0 +1,98 @@
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+)
+
+// imageObject is an image XObject, holding either a JPEG file as is or zlib-compressed samples.
+type imageObject struct {
+	width, height int
+	colorSpace    string
+	filter        string
+	data          []byte
+	mask          *imageObject // Alpha channel, if the image isn't opaque
+}
+
+// object returns the PDF object of the image, with any extra dictionary entries appended.
+func (img *imageObject) object(extra string) string {
+	return fmt.Sprintf("<< /Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /%s "+
+		"/BitsPerComponent 8 /Filter /%s%s /Length %d >>\nstream\n%s\nendstream",
+		img.width, img.height, img.colorSpace, img.filter, extra, len(img.data), img.data)
+}
+
+// decodeImage converts a PNG or JPEG image into an image XObject. JPEG images in RGB or grayscale are embedded
+// as they are; anything else is decoded and embedded as RGB samples, with an alpha mask if needed.
+func decodeImage(contentType string, data []byte) (*imageObject, error) {
+	var img image.Image
+	switch contentType {
+	case "image/jpeg":
+		config, err := jpeg.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if colorSpace, ok := jpegColorSpace(config); ok {
+			return &imageObject{width: config.Width, height: config.Height, colorSpace: colorSpace, filter: "DCTDecode", data: data}, nil
+		}
+		// CMYK JPEGs are often stored inverted, so they are converted rather than embedded
+		img, err = jpeg.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+	case "image/png":
+		var err error
+		img, err = png.Decode(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported image type %q", contentType)
+	}
+
+	bounds := img.Bounds()
+	samples := make([]byte, 0, bounds.Dx()*bounds.Dy()*3)
+	alpha := make([]byte, 0, bounds.Dx()*bounds.Dy())
+	opaque := true
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r, g, b, a := img.At(x, y).RGBA()
+			// Samples are premultiplied by alpha, which the mask applies again
+			if a > 0 {
+				r, g, b = r*0xffff/a, g*0xffff/a, b*0xffff/a
+			}
+			samples = append(samples, byte(r>>8), byte(g>>8), byte(b>>8))
+			alpha = append(alpha, byte(a>>8))
+			opaque = opaque && a == 0xffff
+		}
+	}
+
+	obj := &imageObject{width: bounds.Dx(), height: bounds.Dy(), colorSpace: "DeviceRGB", filter: "FlateDecode", data: deflate(samples)}
+	if !opaque {
+		obj.mask = &imageObject{width: bounds.Dx(), height: bounds.Dy(), colorSpace: "DeviceGray", filter: "FlateDecode", data: deflate(alpha)}
+	}
+	return obj, nil
+}
+
+// jpegColorSpace returns the colour space a JPEG can be embedded with as it is.
+func jpegColorSpace(config image.Config) (string, bool) {
+	switch config.ColorModel {
+	case color.GrayModel:
+		return "DeviceGray", true
+	case color.YCbCrModel:
+		return "DeviceRGB", true
+	}
+	return "", false
+}
+
+func deflate(data []byte) []byte {
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	w.Write(data) // Writes to a bytes.Buffer can't fail
+	w.Close()
+	return buf.Bytes()
+}
@@ -0,0 +1,411 @@
+package pdf
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/pkg/format"
+)
+
+const (
+	margin       = 50.0
+	contentRight = pageWidth - margin
+	// The lowest baseline content may use, leaving room for the footer
+	contentBottom = margin + 30
+
+	// Right edges of the item table's numeric columns
+	quantityRight  = 370.0
+	unitPriceRight = 460.0
+	amountRight    = contentRight
+
+	descriptionWidth = 250.0
+	totalsLeft       = 330.0
+	partyColumnWidth = 230.0
+	customerLeft     = 310.0
+
+	textSize      = 10.0
+	smallSize     = 8.0
+	lineHeight    = 14.0
+	smallHeight   = 11.0
+	rowPadding    = 6.0
+	labelGray     = 0.4
+	ruleGray      = 0.85
+	headerRowGray = 0.93
+
+	// The largest a logo is drawn, keeping its aspect ratio
+	maxLogoWidth  = 150.0
+	maxLogoHeight = 48.0
+	// The most lines of a branding footer shown on each page
+	maxFooterLines = 2
+)
+
+// invoiceLayout lays an invoice out top to bottom, starting a new page whenever the next block doesn't fit.
+type invoiceLayout struct {
+	doc      *document
+	page     *page
+	y        float64 // Baseline of the next line
+	bottom   float64 // Lowest baseline content may use
+	invoice  *models.Invoice
+	branding *models.BrandingProfile
+
+	accent    *rgb
+	logo      int // Index of the logo image, or -1
+	logoSize  [2]float64
+	footnotes []string // Branding footer lines
+}
+
+// RenderInvoice renders invoice as a PDF issued by issuer to customer, in the style of a branding profile if
+// branding isn't nil. The output depends only on its arguments, so rendering the same invoice twice gives
+// identical bytes.
+func RenderInvoice(invoice *models.Invoice, issuer, customer models.Party, branding *models.BrandingProfile) []byte {
+	l := &invoiceLayout{
+		doc:      &document{title: "Invoice " + invoice.InvoiceNumber},
+		bottom:   contentBottom,
+		invoice:  invoice,
+		branding: branding,
+		logo:     -1,
+	}
+	l.applyBranding()
+	l.newPage()
+
+	l.header()
+	l.parties(issuer, customer)
+	l.items()
+	l.totals()
+	l.paymentDetails()
+	l.note()
+	l.footers()
+
+	return l.doc.bytes()
+}
+
+func (l *invoiceLayout) newPage() {
+	l.page = l.doc.addPage()
+	l.y = pageHeight - margin
+}
+
+// applyBranding prepares the accent colour, logo and footer of the branding profile, if any. A logo that
+// can't be decoded is left out rather than failing the whole invoice.
+func (l *invoiceLayout) applyBranding() {
+	if l.branding == nil {
+		return
+	}
+
+	if accent, ok := parseColor(l.branding.AccentColor); ok {
+		l.accent = &accent
+	}
+	if len(l.branding.Logo) > 0 {
+		img, err := decodeImage(l.branding.LogoContentType, l.branding.Logo)
+		if err == nil && img.width > 0 && img.height > 0 {
+			scale := min(maxLogoWidth/float64(img.width), maxLogoHeight/float64(img.height))
+			l.logo = l.doc.addImage(img)
+			l.logoSize = [2]float64{float64(img.width) * scale, float64(img.height) * scale}
+		}
+	}
+	if footer := strings.TrimSpace(l.branding.Footer); footer != "" {
+		l.footnotes = wrap(footer, regular, smallSize, contentRight-margin)
+		if len(l.footnotes) > maxFooterLines {
+			l.footnotes = l.footnotes[:maxFooterLines]
+		}
+		l.bottom += float64(len(l.footnotes)) * smallHeight
+	}
+}
+
+// ensure starts a new page unless height points fit above the footer.
+func (l *invoiceLayout) ensure(height float64) bool {
+	if l.y-height >= l.bottom {
+		return false
+	}
+	l.newPage()
+	return true
+}
+
+func (l *invoiceLayout) header() {
+	if l.logo >= 0 {
+		width, height := l.logoSize[0], l.logoSize[1]
+		l.page.drawImage(l.logo, margin, l.y-height, width, height)
+		l.y -= height + 16
+	}
+
+	l.y -= 24
+	if l.accent != nil {
+		l.page.textColor(margin, l.y, bold, 24, *l.accent, "INVOICE")
+	} else {
+		l.page.text(margin, l.y, bold, 24, 0, "INVOICE")
+	}
+	switch l.invoice.Status {
+	case models.StatusDraft:
+		l.page.textRight(contentRight, l.y, bold, 14, labelGray, "DRAFT")
+	case models.StatusVoid:
+		l.page.textRight(contentRight, l.y, bold, 14, labelGray, "VOID")
+	}
+	l.y -= 30
+
+	meta := [][2]string{
+		{"Invoice number", l.invoice.InvoiceNumber},
+		{"Issue date", format.Date(l.invoice.IssueDate)},
+		{"Due date", format.Date(l.invoice.DueDate)},
+	}
+	for _, row := range meta {
+		l.page.text(totalsLeft, l.y, regular, textSize, labelGray, row[0])
+		l.page.textRight(contentRight, l.y, regular, textSize, 0, row[1])
+		l.y -= lineHeight
+	}
+	l.y -= 16
+}
+
+func (l *invoiceLayout) parties(issuer, customer models.Party) {
+	from := partyLines(issuer)
+	to := partyLines(customer)
+
+	l.page.text(margin, l.y, bold, smallSize, labelGray, "FROM")
+	l.page.text(customerLeft, l.y, bold, smallSize, labelGray, "BILL TO")
+	l.y -= lineHeight
+
+	for i := 0; i < max(len(from), len(to)); i++ {
+		f := regular
+		if i == 0 {
+			f = bold
+		}
+		if i < len(from) {
+			l.page.text(margin, l.y, f, textSize, 0, from[i])
+		}
+		if i < len(to) {
+			l.page.text(customerLeft, l.y, f, textSize, 0, to[i])
+		}
+		l.y -= lineHeight
+	}
+	l.y -= 20
+}
+
+// partyLines returns the lines naming a party: its name, falling back to its email, then its email and address.
+func partyLines(party models.Party) []string {
+	name := strings.TrimSpace(party.Name)
+	email := party.Email
+	if name == "" {
+		name, email = email, ""
+	}
+
+	lines := wrap(name, bold, textSize, partyColumnWidth)
+	if email != "" {
+		lines = append(lines, email)
+	}
+	if party.Address != "" {
+		lines = append(lines, wrap(party.Address, regular, textSize, partyColumnWidth)...)
+	}
+	return lines
+}
+
+func (l *invoiceLayout) itemHeader() {
+	if l.accent != nil {
+		l.page.fillRectColor(margin, l.y-6, contentRight-margin, 20, l.accent.tint(0.15))
+	} else {
+		l.page.fillRect(margin, l.y-6, contentRight-margin, 20, headerRowGray)
+	}
+	l.page.text(margin+6, l.y, bold, 9, 0, "Description")
+	l.page.textRight(quantityRight, l.y, bold, 9, 0, "Qty")
+	l.page.textRight(unitPriceRight, l.y, bold, 9, 0, "Unit price")
+	l.page.textRight(amountRight-6, l.y, bold, 9, 0, "Amount")
+	l.y -= 20 + rowPadding
+}
+
+func (l *invoiceLayout) items() {
+	l.ensure(60)
+	l.itemHeader()
+
+	for _, item := range l.invoice.Items {
+		description := wrap(item.Description, regular, textSize, descriptionWidth)
+		var taxes []string
+		if len(item.Taxes) > 0 {
+			names := make([]string, len(item.Taxes))
+			for i, tax := range item.Taxes {
+				names[i] = fmt.Sprintf("%s %s", tax.Name, formatPercent(tax.Rate))
+			}
+			taxes = wrap("Tax: "+strings.Join(names, ", "), regular, smallSize, descriptionWidth)
+		}
+		if discount := item.DiscountAmount(); discount > 0 {
+			label := "Discount"
+			if item.DiscountPercentage > 0 && item.FixedDiscount == 0 {
+				label = fmt.Sprintf("Discount %s", formatPercent(item.DiscountPercentage))
+			}
+			taxes = append(wrap(fmt.Sprintf("%s: %s", label, l.money(-discount)), regular, smallSize, descriptionWidth), taxes...)
+		}
+		var details []string
+		if item.SKU != "" {
+			details = append(details, "SKU: "+item.SKU)
+		}
+		if item.Unit != "" {
+			details = append(details, "Unit: "+item.Unit)
+		}
+		if len(details) > 0 {
+			taxes = append(wrap(strings.Join(details, ", "), regular, smallSize, descriptionWidth), taxes...)
+		}
+
+		height := float64(len(description))*lineHeight + float64(len(taxes))*smallHeight + rowPadding
+		if l.ensure(height) {
+			l.itemHeader()
+		}
+
+		top := l.y
+		l.page.textRight(quantityRight, top, regular, textSize, 0, strconv.Itoa(int(item.Quantity)))
+		l.page.textRight(unitPriceRight, top, regular, textSize, 0, l.money(item.UnitPrice))
+		l.page.textRight(amountRight-6, top, regular, textSize, 0, l.money(item.NetAmount()))
+		for _, line := range description {
+			l.page.text(margin+6, l.y, regular, textSize, 0, line)
+			l.y -= lineHeight
+		}
+		for _, line := range taxes {
+			l.page.text(margin+6, l.y+2, regular, smallSize, labelGray, line)
+			l.y -= smallHeight
+		}
+
+		l.page.line(margin, l.y+lineHeight-4, contentRight, l.y+lineHeight-4, 0.5, ruleGray)
+		l.y -= rowPadding
+	}
+	l.y -= 10
+}
+
+func (l *invoiceLayout) totals() {
+	type row struct {
+		label  string
+		amount string
+		bold   bool
+	}
+
+	invoice := l.invoice
+	rows := []row{{"Subtotal", l.money(invoice.Subtotal), false}}
+	if invoice.DiscountAmount > 0 {
+		rows = append(rows, row{discountLabel(invoice), l.money(-invoice.DiscountAmount), false})
+	}
+	for _, charge := range invoice.Charges {
+		rows = append(rows, row{charge.Description, l.money(charge.Amount), false})
+	}
+	for _, tax := range invoice.Taxes {
+		label := fmt.Sprintf("%s (%s)", tax.Name, formatPercent(tax.Rate))
+		if tax.Inclusive {
+			label = fmt.Sprintf("%s (%s, included)", tax.Name, formatPercent(tax.Rate))
+		}
+		rows = append(rows, row{label, l.money(tax.Amount), false})
+	}
+	rows = append(rows, row{"Total", l.money(invoice.Total), true})
+	for _, fee := range invoice.LateFees {
+		if fee.ReversedAt == nil {
+			rows = append(rows, row{fee.Description, l.money(fee.Amount), false})
+		}
+	}
+	if invoice.AmountPaid > 0 || invoice.AmountCredited > 0 || invoice.LateFeeTotal > 0 {
+		if invoice.AmountPaid > 0 {
+			rows = append(rows, row{"Amount paid", l.money(-invoice.AmountPaid), false})
+		}
+		if invoice.AmountCredited > 0 {
+			rows = append(rows, row{"Credited", l.money(-invoice.AmountCredited), false})
+		}
+		rows = append(rows, row{"Balance due", l.money(invoice.BalanceDue()), true})
+	}
+
+	l.ensure(float64(len(rows)) * (lineHeight + 4))
+	for _, r := range rows {
+		f := regular
+		if r.bold {
+			f = bold
+			l.page.line(totalsLeft, l.y+lineHeight-2, contentRight, l.y+lineHeight-2, 0.5, ruleGray)
+		}
+		l.page.text(totalsLeft, l.y, f, textSize, 0, r.label)
+		l.page.textRight(amountRight-6, l.y, f, textSize, 0, r.amount)
+		l.y -= lineHeight + 4
+	}
+	l.y -= 16
+}
+
+// discountLabel names the discount row of the totals. The invoice percentage is only shown when it is the sole
+// discount, because the amount otherwise also includes line and fixed discounts.
+func discountLabel(invoice *models.Invoice) string {
+	if invoice.DiscountPercentage == 0 || invoice.FixedDiscount > 0 {
+		return "Discount"
+	}
+	for _, item := range invoice.Items {
+		if item.DiscountAmount() > 0 {
+			return "Discount"
+		}
+	}
+	return fmt.Sprintf("Discount (%s)", formatPercent(invoice.DiscountPercentage))
+}
+
+func (l *invoiceLayout) paymentDetails() {
+	var rows [][2]string
+	for _, row := range [][2]string{
+		{"Bank", l.invoice.BankName},
+		{"Account name", l.invoice.AccountName},
+		{"Account number", l.invoice.AccountNumber},
+		{"Routing number", l.invoice.RoutingNumber},
+	} {
+		if row[1] != "" {
+			rows = append(rows, row)
+		}
+	}
+	var instructions []string
+	if l.branding != nil && strings.TrimSpace(l.branding.PaymentInstructions) != "" {
+		instructions = wrap(strings.TrimSpace(l.branding.PaymentInstructions), regular, textSize, contentRight-margin)
+	}
+	if len(rows) == 0 && len(instructions) == 0 {
+		return
+	}
+
+	l.ensure(float64(len(rows)+1) * lineHeight)
+	l.page.text(margin, l.y, bold, smallSize, labelGray, "PAYMENT DETAILS")
+	l.y -= lineHeight
+	for _, row := range rows {
+		l.page.text(margin, l.y, regular, textSize, labelGray, row[0])
+		l.page.text(margin+100, l.y, regular, textSize, 0, row[1])
+		l.y -= lineHeight
+	}
+	if len(rows) > 0 && len(instructions) > 0 {
+		l.y -= 4
+	}
+	for _, line := range instructions {
+		l.ensure(lineHeight)
+		l.page.text(margin, l.y, regular, textSize, 0, line)
+		l.y -= lineHeight
+	}
+	l.y -= 16
+}
+
+func (l *invoiceLayout) note() {
+	if strings.TrimSpace(l.invoice.Note) == "" {
+		return
+	}
+
+	lines := wrap(l.invoice.Note, regular, textSize, contentRight-margin)
+	l.ensure(2 * lineHeight)
+	l.page.text(margin, l.y, bold, smallSize, labelGray, "NOTE")
+	l.y -= lineHeight
+	for _, line := range lines {
+		l.ensure(lineHeight)
+		l.page.text(margin, l.y, regular, textSize, 0, line)
+		l.y -= lineHeight
+	}
+}
+
+// footers numbers the pages once the layout knows how many there are, below the branding footer if any.
+func (l *invoiceLayout) footers() {
+	for i, p := range l.doc.pages {
+		for j, line := range l.footnotes {
+			p.text(margin, margin+6+float64(len(l.footnotes)-1-j)*smallHeight, regular, smallSize, labelGray, line)
+		}
+		p.line(margin, margin-2, contentRight, margin-2, 0.5, ruleGray)
+		p.text(margin, margin-14, regular, smallSize, labelGray, "Invoice "+l.invoice.InvoiceNumber)
+		p.textRight(contentRight, margin-14, regular, smallSize, labelGray, fmt.Sprintf("Page %d of %d", i+1, len(l.doc.pages)))
+	}
+}
+
+func (l *invoiceLayout) money(cents int64) string {
+	return format.Money(l.invoice.Currency, cents)
+}
+
+// formatPercent formats hundredths of a percent, e.g. 750 as "7.5%".
+func formatPercent(hundredths int64) string {
+	s := fmt.Sprintf("%d.%02d", hundredths/100, hundredths%100)
+	return strings.TrimSuffix(strings.TrimRight(s, "0"), ".") + "%"
+}
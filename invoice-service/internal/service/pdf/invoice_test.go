@@ -0,0 +1,258 @@
+package pdf_test
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service/pdf"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+var (
+	issuer = models.Party{
+		Name:    "Acme Studio Ltd",
+		Email:   "billing@acme.test",
+		Address: "12 Harbour Road\nLagos 101241\nNigeria",
+	}
+	customer = models.Party{
+		Name:    "Globex (Europe) GmbH",
+		Email:   "accounts@globex.test",
+		Address: "Friedrichstraße 100\n10117 Berlin\nGermany",
+	}
+)
+
+func TestRenderInvoice(t *testing.T) {
+	tests := []struct {
+		name     string
+		invoice  *models.Invoice
+		customer models.Party
+	}{
+		{
+			name:     "simple",
+			invoice:  simpleInvoice(),
+			customer: customer,
+		},
+		{
+			name:     "multi_page",
+			invoice:  multiPageInvoice(),
+			customer: customer,
+		},
+		{
+			name: "draft",
+			invoice: func() *models.Invoice {
+				invoice := simpleInvoice()
+				invoice.Status = models.StatusDraft
+				return invoice
+			}(),
+			customer: models.Party{Email: "someone@example.test"},
+		},
+		{
+			name: "void",
+			invoice: func() *models.Invoice {
+				invoice := simpleInvoice()
+				invoice.Status = models.StatusVoid
+				return invoice
+			}(),
+			customer: customer,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pdf.RenderInvoice(tt.invoice, issuer, tt.customer, nil)
+			assertValidPDF(t, got)
+
+			golden := filepath.Join("testdata", tt.name+".golden.pdf")
+			if *update {
+				require.NoError(t, os.WriteFile(golden, got, 0o644))
+			}
+			want, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.True(t, bytes.Equal(want, got), "rendered PDF differs from %s, run the tests with -update to accept the change", golden)
+		})
+	}
+}
+
+func TestRenderInvoice_PageCount(t *testing.T) {
+	assert.Equal(t, 1, pageCount(t, pdf.RenderInvoice(simpleInvoice(), issuer, customer, nil)))
+	assert.Equal(t, 4, pageCount(t, pdf.RenderInvoice(multiPageInvoice(), issuer, customer, nil)))
+}
+
+func TestRenderInvoice_CatalogItem(t *testing.T) {
+	invoice := simpleInvoice()
+	invoice.Items[0].SKU = "CONS-1"
+	invoice.Items[0].Unit = "hour"
+
+	got := pdf.RenderInvoice(invoice, issuer, customer, nil)
+	assertValidPDF(t, got)
+	assert.Contains(t, string(got), "(SKU: CONS-1, Unit: hour)")
+}
+
+func TestRenderInvoice_Branding(t *testing.T) {
+	branding := &models.BrandingProfile{
+		Name:                "Studio",
+		AccentColor:         "#1a73e8",
+		Footer:              "Acme Studio Ltd, registered in Nigeria",
+		PaymentInstructions: "Pay by bank transfer quoting the invoice number.",
+		LogoContentType:     "image/png",
+		Logo:                encodeImage(t, png.Encode),
+	}
+
+	got := pdf.RenderInvoice(multiPageInvoice(), issuer, customer, branding)
+	assertValidPDF(t, got)
+	assert.Contains(t, string(got), "/XObject << /Im1 ")
+	assert.Contains(t, string(got), "/Subtype /Image /Width 4 /Height 2 /ColorSpace /DeviceRGB")
+	assert.Contains(t, string(got), "/SMask ")
+	assert.Contains(t, string(got), "/Im1 Do")
+	assert.Contains(t, string(got), "0.1 0.45 0.91 rg")
+	assert.Contains(t, string(got), "(Pay by bank transfer quoting the invoice number.)")
+	// The footer is on every page
+	assert.Equal(t, pageCount(t, got), bytes.Count(got, []byte("(Acme Studio Ltd, registered in Nigeria)")))
+
+	t.Run("jpeg logo", func(t *testing.T) {
+		jpegBranding := &models.BrandingProfile{
+			LogoContentType: "image/jpeg",
+			Logo: encodeImage(t, func(w io.Writer, img image.Image) error {
+				return jpeg.Encode(w, img, nil)
+			}),
+		}
+		got := pdf.RenderInvoice(simpleInvoice(), issuer, customer, jpegBranding)
+		assertValidPDF(t, got)
+		assert.Contains(t, string(got), "/Filter /DCTDecode")
+		assert.NotContains(t, string(got), "/SMask ")
+	})
+
+	t.Run("invalid logo", func(t *testing.T) {
+		brokenBranding := &models.BrandingProfile{LogoContentType: "image/png", Logo: []byte("not a png")}
+		got := pdf.RenderInvoice(simpleInvoice(), issuer, customer, brokenBranding)
+		assertValidPDF(t, got)
+		assert.NotContains(t, string(got), "/XObject")
+	})
+}
+
+// encodeImage encodes a small half-transparent image.
+func encodeImage(t *testing.T, encode func(io.Writer, image.Image) error) []byte {
+	t.Helper()
+	img := image.NewNRGBA(image.Rect(0, 0, 4, 2))
+	for x := 0; x < 4; x++ {
+		img.Set(x, 0, color.NRGBA{R: 0x1a, G: 0x73, B: 0xe8, A: 0xff})
+		img.Set(x, 1, color.NRGBA{R: 0x1a, G: 0x73, B: 0xe8, A: 0x80})
+	}
+	var buf bytes.Buffer
+	require.NoError(t, encode(&buf, img))
+	return buf.Bytes()
+}
+
+// assertValidPDF checks the structure a PDF reader depends on: the header, and a cross-reference table
+// pointing at the start of every object.
+func assertValidPDF(t *testing.T, doc []byte) {
+	t.Helper()
+
+	require.True(t, bytes.HasPrefix(doc, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(doc, []byte("%%EOF\n")))
+
+	match := regexp.MustCompile(`startxref\n(\d+)\n%%EOF\n$`).FindSubmatch(doc)
+	require.NotNil(t, match)
+	xref, err := strconv.Atoi(string(match[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(doc[xref:], []byte("xref\n")))
+
+	entries := regexp.MustCompile(`(\d{10}) 00000 n \n`).FindAllSubmatch(doc[xref:], -1)
+	require.NotEmpty(t, entries)
+	for i, entry := range entries {
+		offset, err := strconv.Atoi(string(entry[1]))
+		require.NoError(t, err)
+		assert.True(t, bytes.HasPrefix(doc[offset:], []byte(fmt.Sprintf("%d 0 obj\n", i+1))), "object %d", i+1)
+	}
+}
+
+func pageCount(t *testing.T, doc []byte) int {
+	t.Helper()
+	match := regexp.MustCompile(`/Type /Pages /Kids \[[^\]]*\] /Count (\d+)`).FindSubmatch(doc)
+	require.NotNil(t, match)
+	count, err := strconv.Atoi(string(match[1]))
+	require.NoError(t, err)
+	return count
+}
+
+func simpleInvoice() *models.Invoice {
+	return &models.Invoice{
+		ID:            1,
+		InvoiceNumber: "INV-000042",
+		Status:        models.StatusUnpaid,
+		IssueDate:     time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		DueDate:       time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC),
+		Currency:      "EUR",
+		Items: []*models.InvoiceItem{
+			{Description: "Website redesign", Quantity: 1, UnitPrice: 250000},
+			{Description: "Hosting (monthly)", Quantity: 3, UnitPrice: 1999},
+		},
+		Subtotal:      255997,
+		Total:         255997,
+		AccountName:   "Acme Studio Ltd",
+		AccountNumber: "0123456789",
+		BankName:      "First Bank",
+	}
+}
+
+func multiPageInvoice() *models.Invoice {
+	vat := &models.TaxRate{ID: 1, Name: "VAT", Rate: 750}
+	levy := &models.TaxRate{ID: 2, Name: "Service levy", Rate: 125, Inclusive: true}
+
+	invoice := &models.Invoice{
+		ID:                 2,
+		InvoiceNumber:      "INV-000043",
+		Status:             models.StatusPartiallyPaid,
+		IssueDate:          time.Date(2024, time.April, 2, 0, 0, 0, 0, time.UTC),
+		DueDate:            time.Date(2024, time.May, 2, 0, 0, 0, 0, time.UTC),
+		Currency:           "USD",
+		DiscountPercentage: 1000,
+		AmountPaid:         100000,
+		AmountCredited:     2500,
+		AccountName:        "Acme Studio Ltd",
+		AccountNumber:      "0123456789",
+		BankName:           "First Bank",
+		RoutingNumber:      "021000021",
+		Note: "Thank you for your business. Payment is due within 30 days of the issue date; " +
+			"late payments may incur a fee.\nPlease quote the invoice number with your payment.",
+	}
+	for i := 1; i <= 60; i++ {
+		item := &models.InvoiceItem{
+			Description: fmt.Sprintf("Consulting session %d", i),
+			Quantity:    int32(i%4 + 1),
+			UnitPrice:   int64(12500 + i*37),
+		}
+		if i%5 == 0 {
+			item.Description += " covering architecture review, deployment planning and a written summary of the recommendations"
+			item.Taxes = []*models.TaxRate{vat, levy}
+		}
+		invoice.Items = append(invoice.Items, item)
+		invoice.Subtotal += int64(item.Quantity) * item.UnitPrice
+	}
+
+	// The layout prints the amounts as given, so they only need to be plausible
+	invoice.DiscountAmount = invoice.Subtotal / 10
+	invoice.Taxes = []*models.InvoiceTax{
+		{TaxRateID: 1, Name: "VAT", Rate: 750, Amount: 21573},
+		{TaxRateID: 2, Name: "Service levy", Rate: 125, Inclusive: true, Amount: 3554},
+	}
+	invoice.TaxTotal = 21573
+	invoice.Total = invoice.Subtotal - invoice.DiscountAmount + invoice.TaxTotal
+	return invoice
+}
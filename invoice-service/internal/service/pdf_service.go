@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service/pdf"
+)
+
+// RenderInvoicePDF renders one of the user's invoices as a PDF issued by issuer to customer, in the style of
+// the invoice's branding profile.
+func (s *InvoiceService) RenderInvoicePDF(ctx context.Context, invoiceID, userID int64, issuer, customer models.Party) ([]byte, *models.Invoice, error) {
+	invoice, err := s.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if invoice.UserID != userID {
+		return nil, nil, ErrNotFound
+	}
+	branding, err := s.InvoiceBranding(ctx, invoice)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return pdf.RenderInvoice(invoice, issuer, customer, branding), invoice, nil
+}
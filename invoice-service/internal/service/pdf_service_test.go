@@ -0,0 +1,41 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRenderInvoicePDF(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	invoice := &models.Invoice{ID: 1, UserID: 7, InvoiceNumber: "INV-000001", Status: models.StatusUnpaid, Currency: "USD", Total: 10000}
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(invoice, nil)
+	// The invoice has no branding profile of its own, so it gets the user's default
+	mockRepo.On("GetBrandingProfile", mock.Anything, int64(7), int64(0)).Return(&models.BrandingProfile{ID: 3, UserID: 7, Footer: "Registered in Nigeria"}, nil)
+
+	doc, got, err := svc.RenderInvoicePDF(context.Background(), 1, 7, models.Party{Name: "Issuer"}, models.Party{Name: "Customer"})
+
+	assert.NoError(t, err)
+	assert.Equal(t, invoice, got)
+	assert.True(t, bytes.HasPrefix(doc, []byte("%PDF-")))
+	assert.True(t, bytes.Contains(doc, []byte("(Registered in Nigeria)")))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRenderInvoicePDF_OtherUsersInvoice(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(&models.Invoice{ID: 1, UserID: 8}, nil)
+
+	_, _, err := svc.RenderInvoicePDF(context.Background(), 1, 7, models.Party{}, models.Party{})
+
+	assert.ErrorIs(t, err, service.ErrNotFound)
+}
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+// DefaultQuoteValidityDays is how long a quote can be accepted for when it has no expiry date.
+const DefaultQuoteValidityDays = 30
+
+// quoteTransitions lists the statuses a quote may move to from each status. Draft and sent quotes also
+// move to expired once their expiry date has passed, through ExpireQuotes.
+var quoteTransitions = map[string][]string{
+	models.QuoteStatusDraft:    {models.QuoteStatusSent},
+	models.QuoteStatusSent:     {models.QuoteStatusAccepted, models.QuoteStatusDeclined},
+	models.QuoteStatusAccepted: {},
+	models.QuoteStatusDeclined: {},
+	models.QuoteStatusExpired:  {},
+}
+
+// CreateQuote validates a quote, calculates its amounts the same way as an invoice's and saves it as a draft.
+func (s *InvoiceService) CreateQuote(ctx context.Context, quote *models.Quote) (*models.Quote, error) {
+	currency, err := normalizeCurrency(quote.Currency)
+	if err != nil {
+		return nil, err
+	}
+	quote.Currency = currency
+
+	if quote.IssueDate.IsZero() {
+		quote.IssueDate = time.Now()
+	}
+	quote.IssueDate = truncateToDate(quote.IssueDate)
+	if quote.ExpiryDate.IsZero() {
+		quote.ExpiryDate = quote.IssueDate.AddDate(0, 0, DefaultQuoteValidityDays)
+	}
+	quote.ExpiryDate = truncateToDate(quote.ExpiryDate)
+
+	switch {
+	case len(quote.Items) == 0:
+		return nil, fmt.Errorf("%w: a quote needs at least one item", ErrInvalidRequest)
+	case quote.ExpiryDate.Before(quote.IssueDate):
+		return nil, fmt.Errorf("%w: the expiry date is before the issue date", ErrInvalidRequest)
+	case quote.PaymentTermsDays < 0:
+		return nil, fmt.Errorf("%w: payment terms must not be negative", ErrInvalidRequest)
+	}
+
+	adjustments := invoiceAdjustments{discountPercentage: quote.DiscountPercentage}
+	err = validateAdjustments(quote.Items, adjustments)
+	if err != nil {
+		return nil, err
+	}
+	err = s.resolveItemTaxes(ctx, quote.UserID, quote.Items)
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate quote amounts
+	amounts := calculateInvoiceAmounts(quote.Items, adjustments)
+	quote.Subtotal = amounts.subtotal
+	quote.DiscountAmount = amounts.discount
+	quote.Taxes = amounts.taxes
+	quote.TaxTotal = amounts.taxTotal
+	quote.Total = amounts.total
+
+	quote.Status = models.QuoteStatusDraft
+	quote.InvoiceID = 0
+
+	// The repository numbers the quote in the same transaction as it is inserted
+	err = s.repo.CreateQuote(ctx, quote)
+	if err != nil {
+		return nil, err
+	}
+	return quote, nil
+}
+
+func (s *InvoiceService) GetQuote(ctx context.Context, quoteID, userID int64) (*models.Quote, error) {
+	quote, err := s.repo.GetQuoteByID(ctx, quoteID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return quote, nil
+}
+
+// ListQuotes returns the quotes of a user, optionally only those in the given status.
+func (s *InvoiceService) ListQuotes(ctx context.Context, userID int64, status string) ([]*models.Quote, error) {
+	if _, ok := quoteTransitions[status]; status != "" && !ok {
+		return nil, fmt.Errorf("%w: unknown quote status %q", ErrInvalidRequest, status)
+	}
+	return s.repo.ListQuotesByUserID(ctx, userID, status)
+}
+
+// MarkQuoteSent records that a draft quote has been sent to the customer.
+func (s *InvoiceService) MarkQuoteSent(ctx context.Context, quoteID, userID int64) (*models.Quote, error) {
+	return s.transitionQuote(ctx, quoteID, userID, models.QuoteStatusSent)
+}
+
+// AcceptQuote records the customer's acceptance of a sent quote. It can be accepted up to and including its
+// expiry date.
+func (s *InvoiceService) AcceptQuote(ctx context.Context, quoteID, userID int64) (*models.Quote, error) {
+	return s.transitionQuote(ctx, quoteID, userID, models.QuoteStatusAccepted)
+}
+
+// DeclineQuote records that the customer turned down a sent quote.
+func (s *InvoiceService) DeclineQuote(ctx context.Context, quoteID, userID int64) (*models.Quote, error) {
+	return s.transitionQuote(ctx, quoteID, userID, models.QuoteStatusDeclined)
+}
+
+// ExpireQuotes moves one batch of draft and sent quotes that are past their expiry date to expired.
+func (s *InvoiceService) ExpireQuotes(ctx context.Context, now time.Time, batchSize int) ([]*models.Quote, error) {
+	if batchSize <= 0 {
+		return nil, ErrInvalidRequest
+	}
+	return s.repo.ExpireQuotes(ctx, truncateToDate(now), batchSize)
+}
+
+// ConvertQuoteToInvoice creates a draft invoice from an accepted quote, at the quoted prices, discount and taxes.
+// The invoice is issued today and due after the quote's payment terms. It returns the new invoice and the quote,
+// which is linked to it; a quote converts into one invoice at most.
+func (s *InvoiceService) ConvertQuoteToInvoice(ctx context.Context, quoteID, userID int64) (*models.Invoice, *models.Quote, error) {
+	quote, err := s.GetQuote(ctx, quoteID, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if quote.Status != models.QuoteStatusAccepted {
+		return nil, nil, fmt.Errorf("%w: only accepted quotes can be converted", ErrInvalidTransition)
+	}
+	if quote.InvoiceID != 0 {
+		return nil, nil, fmt.Errorf("%w: the quote was already converted into invoice %d", ErrInvalidTransition, quote.InvoiceID)
+	}
+
+	issueDate := truncateToDate(time.Now())
+	invoice := &models.Invoice{
+		UserID:             quote.UserID,
+		CustomerID:         quote.CustomerID,
+		Status:             models.StatusDraft,
+		IssueDate:          issueDate,
+		DueDate:            issueDate.AddDate(0, 0, int(quote.PaymentTermsDays)),
+		Currency:           quote.Currency,
+		DiscountPercentage: quote.DiscountPercentage,
+		Note:               quote.Note,
+	}
+	for _, item := range quote.Items {
+		invoice.Items = append(invoice.Items, &models.InvoiceItem{
+			Description:        item.Description,
+			Quantity:           item.Quantity,
+			UnitPrice:          item.UnitPrice,
+			DiscountPercentage: item.DiscountPercentage,
+			FixedDiscount:      item.FixedDiscount,
+			TaxRateIDs:         item.TaxRateIDs,
+			Taxes:              item.Taxes,
+		})
+	}
+
+	// Recalculate from the quoted tax snapshots rather than today's rates, so the amounts match the quote
+	calculateInvoiceAmounts(invoice.Items, adjustmentsOf(invoice)).applyTo(invoice)
+
+	invoice.RecordActivityFunc(models.ActivityInvoiceCreated, func() string {
+		return fmt.Sprintf("Created invoice %s from quote %s", invoice.InvoiceNumber, quote.QuoteNumber)
+	})
+	err = s.repo.CreateQuoteInvoice(ctx, quote, invoice)
+	if err != nil {
+		// Another request converted the quote first
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil, ErrInvalidTransition
+		}
+		return nil, nil, err
+	}
+	return invoice, quote, nil
+}
+
+// transitionQuote moves a quote to a new status if the quote lifecycle allows it and it has not expired.
+func (s *InvoiceService) transitionQuote(ctx context.Context, quoteID, userID int64, to string) (*models.Quote, error) {
+	quote, err := s.GetQuote(ctx, quoteID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !canTransitionQuote(quote.Status, to) {
+		return nil, ErrInvalidTransition
+	}
+	if truncateToDate(time.Now()).After(quote.ExpiryDate) {
+		return nil, fmt.Errorf("%w: the quote expired on %s", ErrInvalidTransition, quote.ExpiryDate.Format(time.DateOnly))
+	}
+
+	from := quote.Status
+	quote.Status = to
+	err = s.repo.UpdateQuoteStatus(ctx, quote, from)
+	if err != nil {
+		// The status changed underneath us, so the transition no longer applies
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidTransition
+		}
+		return nil, err
+	}
+	return quote, nil
+}
+
+// canTransitionQuote reports whether a quote may move from one status to another.
+func canTransitionQuote(from, to string) bool {
+	for _, status := range quoteTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
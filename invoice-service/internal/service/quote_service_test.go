@@ -0,0 +1,192 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateQuote(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	quote := &models.Quote{
+		UserID:             1,
+		CustomerID:         2,
+		IssueDate:          date(2024, 5, 10),
+		Currency:           "usd",
+		DiscountPercentage: 1000,
+		Items: []*models.InvoiceItem{
+			{Description: "Design", Quantity: 2, UnitPrice: 15000},
+			{Description: "Hosting", Quantity: 1, UnitPrice: 10000},
+		},
+	}
+	mockRepo.On("CreateQuote", mock.Anything, quote).Return(nil)
+
+	created, err := svc.CreateQuote(context.Background(), quote)
+
+	assert.NoError(t, err)
+	assert.Equal(t, models.QuoteStatusDraft, created.Status)
+	assert.Equal(t, "USD", created.Currency)
+	assert.Equal(t, date(2024, 6, 9), created.ExpiryDate)
+	assert.Equal(t, int64(40000), created.Subtotal)
+	assert.Equal(t, int64(4000), created.DiscountAmount)
+	assert.Equal(t, int64(36000), created.Total)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateQuoteValidation(t *testing.T) {
+	tests := []struct {
+		name  string
+		quote *models.Quote
+	}{
+		{"no items", &models.Quote{UserID: 1, Currency: "USD"}},
+		{"expires before issue", &models.Quote{
+			UserID:     1,
+			Currency:   "USD",
+			IssueDate:  date(2024, 5, 10),
+			ExpiryDate: date(2024, 5, 9),
+			Items:      []*models.InvoiceItem{{Description: "Design", Quantity: 1, UnitPrice: 100}},
+		}},
+		{"negative payment terms", &models.Quote{
+			UserID:           1,
+			Currency:         "USD",
+			PaymentTermsDays: -1,
+			Items:            []*models.InvoiceItem{{Description: "Design", Quantity: 1, UnitPrice: 100}},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			_, err := svc.CreateQuote(context.Background(), tt.quote)
+
+			assert.ErrorIs(t, err, service.ErrInvalidRequest)
+			mockRepo.AssertNotCalled(t, "CreateQuote", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestQuoteTransitions(t *testing.T) {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	tests := []struct {
+		name       string
+		from       string
+		expiryDate time.Time
+		transition func(*service.InvoiceService, context.Context, int64, int64) (*models.Quote, error)
+		want       string
+		wantErr    error
+	}{
+		{"send draft", models.QuoteStatusDraft, today, (*service.InvoiceService).MarkQuoteSent, models.QuoteStatusSent, nil},
+		{"accept sent", models.QuoteStatusSent, today, (*service.InvoiceService).AcceptQuote, models.QuoteStatusAccepted, nil},
+		{"decline sent", models.QuoteStatusSent, today, (*service.InvoiceService).DeclineQuote, models.QuoteStatusDeclined, nil},
+		{"accept draft", models.QuoteStatusDraft, today, (*service.InvoiceService).AcceptQuote, "", service.ErrInvalidTransition},
+		{"decline accepted", models.QuoteStatusAccepted, today, (*service.InvoiceService).DeclineQuote, "", service.ErrInvalidTransition},
+		{"accept expired", models.QuoteStatusSent, today.AddDate(0, 0, -1), (*service.InvoiceService).AcceptQuote, "", service.ErrInvalidTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			quote := &models.Quote{ID: 1, UserID: 2, Status: tt.from, ExpiryDate: tt.expiryDate}
+			mockRepo.On("GetQuoteByID", mock.Anything, int64(1), int64(2)).Return(quote, nil)
+			mockRepo.On("UpdateQuoteStatus", mock.Anything, quote, tt.from).Return(nil)
+
+			updated, err := tt.transition(svc, context.Background(), 1, 2)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockRepo.AssertNotCalled(t, "UpdateQuoteStatus", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, updated.Status)
+		})
+	}
+}
+
+func TestConvertQuoteToInvoice(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	vat := &models.TaxRate{ID: 7, Name: "VAT", Rate: 2000}
+	quote := &models.Quote{
+		ID:               1,
+		UserID:           2,
+		CustomerID:       3,
+		QuoteNumber:      "Q-000001",
+		Status:           models.QuoteStatusAccepted,
+		Currency:         "EUR",
+		PaymentTermsDays: 14,
+		Note:             "Thanks",
+		Items: []*models.InvoiceItem{
+			{Description: "Design", Quantity: 1, UnitPrice: 10000, TaxRateIDs: []int64{7}, Taxes: []*models.TaxRate{vat}},
+		},
+	}
+	mockRepo.On("GetQuoteByID", mock.Anything, int64(1), int64(2)).Return(quote, nil)
+	mockRepo.On("CreateQuoteInvoice", mock.Anything, quote, mock.AnythingOfType("*models.Invoice")).Return(nil)
+
+	invoice, converted, err := svc.ConvertQuoteToInvoice(context.Background(), 1, 2)
+
+	assert.NoError(t, err)
+	assert.Same(t, quote, converted)
+	assert.Equal(t, models.StatusDraft, invoice.Status)
+	assert.Equal(t, "EUR", invoice.Currency)
+	assert.Equal(t, "Thanks", invoice.Note)
+	assert.Equal(t, invoice.IssueDate.AddDate(0, 0, 14), invoice.DueDate)
+	assert.Equal(t, int64(10000), invoice.Subtotal)
+	assert.Equal(t, int64(2000), invoice.TaxTotal)
+	assert.Equal(t, int64(12000), invoice.Total)
+	mockRepo.AssertNotCalled(t, "GetTaxRatesByIDs", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestConvertQuoteToInvoiceInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		quote   *models.Quote
+		repoErr error
+	}{
+		{"not accepted", &models.Quote{ID: 1, UserID: 2, Status: models.QuoteStatusSent}, nil},
+		{"already converted", &models.Quote{ID: 1, UserID: 2, Status: models.QuoteStatusAccepted, InvoiceID: 9}, nil},
+		{"converted concurrently", &models.Quote{ID: 1, UserID: 2, Status: models.QuoteStatusAccepted}, sql.ErrNoRows},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			mockRepo.On("GetQuoteByID", mock.Anything, int64(1), int64(2)).Return(tt.quote, nil)
+			mockRepo.On("CreateQuoteInvoice", mock.Anything, tt.quote, mock.Anything).Return(tt.repoErr)
+
+			_, _, err := svc.ConvertQuoteToInvoice(context.Background(), 1, 2)
+
+			assert.ErrorIs(t, err, service.ErrInvalidTransition)
+		})
+	}
+}
+
+func TestExpireQuotes(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	expired := []*models.Quote{{ID: 1, Status: models.QuoteStatusExpired}}
+	mockRepo.On("ExpireQuotes", mock.Anything, date(2024, 5, 10), 50).Return(expired, nil)
+
+	quotes, err := svc.ExpireQuotes(context.Background(), time.Date(2024, 5, 10, 15, 30, 0, 0, time.UTC), 50)
+	assert.NoError(t, err)
+	assert.Equal(t, expired, quotes)
+
+	_, err = svc.ExpireQuotes(context.Background(), time.Now(), 0)
+	assert.ErrorIs(t, err, service.ErrInvalidRequest)
+}
@@ -1,76 +1,126 @@
 package rabbitmq
 
 import (
-	"encoding/json"
+	"context"
+	"errors"
 	"fmt"
-	"log"
+	"sync"
 	"time"
 
 	amqp "github.com/rabbitmq/amqp091-go"
 )
 
+// Publisher publishes persistent messages to a durable queue and waits for the broker to confirm each one. It
+// connects on first use and reconnects after a failure, so the service keeps running while RabbitMQ is down.
 type Publisher struct {
+	url   string
+	queue string
+
+	mu      sync.Mutex
 	conn    *amqp.Connection
 	channel *amqp.Channel
-	queue   string
 }
 
-// Initialize RabbitMQ connection
-func NewPublisher(url, queueName string) (*Publisher, error) {
-	var conn *amqp.Connection
-	var err error
-
-	for i := 0; i < 5; i++ { // Retry 5 times
-		conn, err = amqp.Dial(url)
-		if err == nil {
-			break
-		}
-		log.Printf("failed to connect to RabbitMQ, retrying in 5 seconds... (%d/5)", i+1)
-		time.Sleep(5 * time.Second)
-	}
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to connect to RabbitMQ: %w", err)
-	}
-
-	ch, err := conn.Channel()
-	if err != nil {
-		return nil, err
-	}
-
+func NewPublisher(url, queueName string) *Publisher {
 	return &Publisher{
-		conn:    conn,
-		channel: ch,
-		queue:   queueName,
-	}, nil
+		url:   url,
+		queue: queueName,
+	}
 }
 
-// Publish an event to RabbitMQ
-func (p *Publisher) Publish(message any) error {
-	// Serialize the message to JSON
-	body, err := json.Marshal(message)
+// Publish publishes a JSON message and waits until the broker confirms it. messageID identifies the message to
+// consumers, since a message whose confirmation is lost is published again.
+func (p *Publisher) Publish(ctx context.Context, messageID string, body []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ch, err := p.connect()
 	if err != nil {
-		log.Fatal("failed to serialize map to json")
+		return err
 	}
 
-	err = p.channel.Publish(
+	confirmation, err := ch.PublishWithDeferredConfirmWithContext(ctx,
 		"",      // exchange
 		p.queue, // routing key (queue name)
 		false,   // mandatory
 		false,   // immediate
 		amqp.Publishing{
-			ContentType: "application/json",
-			Body:        body,
+			ContentType:  "application/json",
+			DeliveryMode: amqp.Persistent,
+			MessageId:    messageID,
+			Timestamp:    time.Now(),
+			Body:         body,
 		})
 	if err != nil {
-		log.Fatal("failed to publish a message")
+		p.reset()
+		return fmt.Errorf("failed to publish message: %w", err)
+	}
+
+	acked, err := confirmation.WaitContext(ctx)
+	if err != nil {
+		// The confirmation may still arrive, so start again on a fresh channel rather than mix it up with the next
+		p.reset()
+		return fmt.Errorf("failed to confirm message: %w", err)
+	}
+	if !acked {
+		return errors.New("the broker did not accept the message")
 	}
-	log.Printf("Published message: %s", message)
 	return nil
 }
 
+// connect returns an open channel in confirm mode, dialling the broker and declaring the queue if needed.
+func (p *Publisher) connect() (*amqp.Channel, error) {
+	if p.channel != nil && !p.channel.IsClosed() {
+		return p.channel, nil
+	}
+	p.reset()
+
+	conn, err := amqp.Dial(p.url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to RabbitMQ: %w", err)
+	}
+	ch, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	err = ch.Confirm(false)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to put channel in confirm mode: %w", err)
+	}
+
+	// Declare the queue durable, so messages survive a broker restart
+	_, err = ch.QueueDeclare(
+		p.queue, // name
+		true,    // durable
+		false,   // delete when unused
+		false,   // exclusive
+		false,   // no-wait
+		nil,     // arguments
+	)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("failed to declare queue %s: %w", p.queue, err)
+	}
+
+	p.conn = conn
+	p.channel = ch
+	return ch, nil
+}
+
+// reset closes the connection, if any, so the next publish reconnects.
+func (p *Publisher) reset() {
+	if p.conn != nil {
+		p.conn.Close()
+	}
+	p.conn = nil
+	p.channel = nil
+}
+
 // Close the connection when done
 func (p *Publisher) Close() {
-	p.channel.Close()
-	p.conn.Close()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.reset()
 }
@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+// CreateRecurringInvoice validates a recurring invoice and schedules its first run on the first occurrence
+// on or after today.
+func (s *InvoiceService) CreateRecurringInvoice(ctx context.Context, recurring *models.RecurringInvoice) (*models.RecurringInvoice, error) {
+	err := s.validateRecurringInvoice(ctx, recurring)
+	if err != nil {
+		return nil, err
+	}
+
+	recurring.Status = models.RecurringStatusActive
+	recurring.Occurrences = 0
+	recurring.NextRunDate = nextOccurrence(recurring, truncateToDate(time.Now()).AddDate(0, 0, -1))
+	if isScheduleComplete(recurring) {
+		return nil, fmt.Errorf("%w: the schedule has no occurrences left", ErrInvalidRequest)
+	}
+
+	err = s.repo.CreateRecurringInvoice(ctx, recurring)
+	if err != nil {
+		return nil, err
+	}
+	return recurring, nil
+}
+
+func (s *InvoiceService) GetRecurringInvoice(ctx context.Context, recurringInvoiceID, userID int64) (*models.RecurringInvoice, error) {
+	recurring, err := s.repo.GetRecurringInvoiceByID(ctx, recurringInvoiceID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return recurring, nil
+}
+
+func (s *InvoiceService) ListRecurringInvoices(ctx context.Context, userID int64) ([]*models.RecurringInvoice, error) {
+	return s.repo.ListRecurringInvoicesByUserID(ctx, userID)
+}
+
+// ListRecurringInvoiceInvoices returns the invoices generated by a recurring invoice.
+func (s *InvoiceService) ListRecurringInvoiceInvoices(ctx context.Context, recurringInvoiceID, userID int64) ([]*models.Invoice, error) {
+	recurring, err := s.GetRecurringInvoice(ctx, recurringInvoiceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListInvoicesByRecurringInvoiceID(ctx, recurring.ID)
+}
+
+// PauseRecurringInvoice stops an active recurring invoice from generating invoices until it is resumed.
+func (s *InvoiceService) PauseRecurringInvoice(ctx context.Context, recurringInvoiceID, userID int64) (*models.RecurringInvoice, error) {
+	recurring, err := s.GetRecurringInvoice(ctx, recurringInvoiceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if recurring.Status != models.RecurringStatusActive {
+		return nil, ErrInvalidTransition
+	}
+	return s.updateRecurringSchedule(ctx, recurring, models.RecurringStatusPaused)
+}
+
+// ResumeRecurringInvoice restarts a paused recurring invoice. Occurrences missed while it was paused are skipped.
+func (s *InvoiceService) ResumeRecurringInvoice(ctx context.Context, recurringInvoiceID, userID int64) (*models.RecurringInvoice, error) {
+	recurring, err := s.GetRecurringInvoice(ctx, recurringInvoiceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if recurring.Status != models.RecurringStatusPaused {
+		return nil, ErrInvalidTransition
+	}
+
+	today := truncateToDate(time.Now())
+	if recurring.NextRunDate.Before(today) {
+		recurring.NextRunDate = nextOccurrence(recurring, today.AddDate(0, 0, -1))
+	}
+	status := models.RecurringStatusActive
+	if isScheduleComplete(recurring) {
+		status = models.RecurringStatusCompleted
+	}
+	return s.updateRecurringSchedule(ctx, recurring, status)
+}
+
+// CancelRecurringInvoice permanently stops a recurring invoice. Invoices it already generated are kept.
+func (s *InvoiceService) CancelRecurringInvoice(ctx context.Context, recurringInvoiceID, userID int64) (*models.RecurringInvoice, error) {
+	recurring, err := s.GetRecurringInvoice(ctx, recurringInvoiceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if recurring.Status != models.RecurringStatusActive && recurring.Status != models.RecurringStatusPaused {
+		return nil, ErrInvalidTransition
+	}
+	return s.updateRecurringSchedule(ctx, recurring, models.RecurringStatusCancelled)
+}
+
+// DueRecurringInvoices returns up to limit active recurring invoices that are due to run at now.
+func (s *InvoiceService) DueRecurringInvoices(ctx context.Context, now time.Time, limit int) ([]*models.RecurringInvoice, error) {
+	if limit <= 0 {
+		return nil, ErrInvalidRequest
+	}
+	return s.repo.ListDueRecurringInvoices(ctx, now, limit)
+}
+
+// GenerateRecurringInvoice creates the draft invoice for a recurring invoice's next run and advances its schedule.
+// It returns ErrConflict if another run already generated the invoice.
+func (s *InvoiceService) GenerateRecurringInvoice(ctx context.Context, recurring *models.RecurringInvoice) (*models.Invoice, error) {
+	runDate := recurring.NextRunDate
+	invoice := &models.Invoice{
+		UserID:             recurring.UserID,
+		CustomerID:         recurring.CustomerID,
+		Status:             models.StatusDraft,
+		IssueDate:          runDate,
+		DueDate:            runDate.AddDate(0, 0, int(recurring.PaymentTermsDays)),
+		Currency:           recurring.Currency,
+		DiscountPercentage: recurring.DiscountPercentage,
+		AccountName:        recurring.AccountName,
+		AccountNumber:      recurring.AccountNumber,
+		BankName:           recurring.BankName,
+		RoutingNumber:      recurring.RoutingNumber,
+		Note:               recurring.Note,
+		RecurringInvoiceID: recurring.ID,
+	}
+	for _, item := range recurring.Items {
+		invoice.Items = append(invoice.Items, &models.InvoiceItem{
+			Description:        item.Description,
+			Quantity:           item.Quantity,
+			UnitPrice:          item.UnitPrice,
+			DiscountPercentage: item.DiscountPercentage,
+			FixedDiscount:      item.FixedDiscount,
+			TaxRateIDs:         item.TaxRateIDs,
+		})
+	}
+
+	err := s.resolveItemTaxes(ctx, invoice.UserID, invoice.Items)
+	if err != nil {
+		return nil, err
+	}
+	calculateInvoiceAmounts(invoice.Items, adjustmentsOf(invoice)).applyTo(invoice)
+
+	// Advance the schedule past this run
+	recurring.Occurrences++
+	recurring.NextRunDate = nextOccurrence(recurring, runDate)
+	if isScheduleComplete(recurring) {
+		recurring.Status = models.RecurringStatusCompleted
+	}
+
+	invoice.RecordActivityFunc(models.ActivityInvoiceCreated, func() string {
+		return fmt.Sprintf("Created invoice %s from recurring invoice %d", invoice.InvoiceNumber, recurring.ID)
+	})
+	err = s.repo.CreateRecurringInvoiceOccurrence(ctx, recurring, invoice, runDate)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrConflict
+		}
+		return nil, err
+	}
+	return invoice, nil
+}
+
+func (s *InvoiceService) updateRecurringSchedule(ctx context.Context, recurring *models.RecurringInvoice, status string) (*models.RecurringInvoice, error) {
+	from := recurring.Status
+	recurring.Status = status
+	err := s.repo.UpdateRecurringInvoiceSchedule(ctx, recurring, from)
+	if err != nil {
+		// The status changed underneath us, so the transition no longer applies
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrInvalidTransition
+		}
+		return nil, err
+	}
+	return recurring, nil
+}
+
+func (s *InvoiceService) validateRecurringInvoice(ctx context.Context, recurring *models.RecurringInvoice) error {
+	currency, err := normalizeCurrency(recurring.Currency)
+	if err != nil {
+		return err
+	}
+	recurring.Currency = currency
+
+	if recurring.StartDate.IsZero() {
+		recurring.StartDate = time.Now()
+	}
+	recurring.StartDate = truncateToDate(recurring.StartDate)
+	if recurring.EndDate != nil {
+		endDate := truncateToDate(*recurring.EndDate)
+		recurring.EndDate = &endDate
+	}
+
+	switch {
+	case len(recurring.Items) == 0:
+		return fmt.Errorf("%w: a recurring invoice needs at least one item", ErrInvalidRequest)
+	case recurring.Frequency == models.FrequencyCustom && recurring.IntervalDays <= 0:
+		return fmt.Errorf("%w: a custom frequency needs a positive interval in days", ErrInvalidRequest)
+	case recurring.Frequency != models.FrequencyCustom && recurring.Frequency != models.FrequencyWeekly &&
+		recurring.Frequency != models.FrequencyMonthly && recurring.Frequency != models.FrequencyQuarterly &&
+		recurring.Frequency != models.FrequencyYearly:
+		return fmt.Errorf("%w: unknown frequency %q", ErrInvalidRequest, recurring.Frequency)
+	case recurring.EndDate != nil && recurring.EndDate.Before(recurring.StartDate):
+		return fmt.Errorf("%w: end date is before start date", ErrInvalidRequest)
+	case recurring.MaxOccurrences < 0:
+		return fmt.Errorf("%w: occurrences cannot be negative", ErrInvalidRequest)
+	case recurring.PaymentTermsDays < 0:
+		return fmt.Errorf("%w: payment terms cannot be negative", ErrInvalidRequest)
+	case recurring.AutoSend && recurring.CustomerEmail == "":
+		return fmt.Errorf("%w: auto-send needs a customer email", ErrInvalidRequest)
+	}
+	if recurring.Frequency != models.FrequencyCustom {
+		recurring.IntervalDays = 0
+	}
+	err = validateAdjustments(recurring.Items, invoiceAdjustments{discountPercentage: recurring.DiscountPercentage})
+	if err != nil {
+		return err
+	}
+
+	// Make sure every referenced tax rate exists
+	return s.resolveItemTaxes(ctx, recurring.UserID, recurring.Items)
+}
+
+// nextOccurrence returns the first scheduled date of a recurring invoice after the given date.
+func nextOccurrence(recurring *models.RecurringInvoice, after time.Time) time.Time {
+	for n := 0; ; n++ {
+		date := occurrence(recurring, n)
+		if date.After(after) {
+			return date
+		}
+	}
+}
+
+// occurrence returns the nth scheduled date of a recurring invoice, counting from its start date. Monthly
+// schedules that start late in the month fall on the last day of shorter months.
+func occurrence(recurring *models.RecurringInvoice, n int) time.Time {
+	start := recurring.StartDate
+	switch recurring.Frequency {
+	case models.FrequencyWeekly:
+		return start.AddDate(0, 0, 7*n)
+	case models.FrequencyMonthly:
+		return addMonths(start, n)
+	case models.FrequencyQuarterly:
+		return addMonths(start, 3*n)
+	case models.FrequencyYearly:
+		return addMonths(start, 12*n)
+	default:
+		return start.AddDate(0, 0, int(recurring.IntervalDays)*n)
+	}
+}
+
+// addMonths adds months to date, clamping the day to the length of the resulting month.
+func addMonths(date time.Time, months int) time.Time {
+	firstOfMonth := time.Date(date.Year(), date.Month()+time.Month(months), 1, 0, 0, 0, 0, date.Location())
+	lastDay := firstOfMonth.AddDate(0, 1, -1).Day()
+	day := date.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
+
+// isScheduleComplete reports whether a recurring invoice has no runs left.
+func isScheduleComplete(recurring *models.RecurringInvoice) bool {
+	if recurring.MaxOccurrences > 0 && recurring.Occurrences >= recurring.MaxOccurrences {
+		return true
+	}
+	return recurring.EndDate != nil && recurring.NextRunDate.After(*recurring.EndDate)
+}
+
+func truncateToDate(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
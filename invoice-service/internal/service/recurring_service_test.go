@@ -0,0 +1,273 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestGenerateRecurringInvoiceSchedule(t *testing.T) {
+	tests := []struct {
+		name          string
+		frequency     string
+		intervalDays  int32
+		startDate     time.Time
+		runDate       time.Time
+		wantNextRun   time.Time
+		wantCompleted bool
+	}{
+		{"weekly", models.FrequencyWeekly, 0, date(2024, 1, 1), date(2024, 1, 8), date(2024, 1, 15), false},
+		{"monthly clamps to leap day", models.FrequencyMonthly, 0, date(2024, 1, 31), date(2024, 1, 31), date(2024, 2, 29), false},
+		{"monthly returns to start day", models.FrequencyMonthly, 0, date(2024, 1, 31), date(2024, 2, 29), date(2024, 3, 31), false},
+		{"monthly clamps to short month", models.FrequencyMonthly, 0, date(2023, 1, 31), date(2023, 1, 31), date(2023, 2, 28), false},
+		{"quarterly", models.FrequencyQuarterly, 0, date(2024, 11, 30), date(2024, 11, 30), date(2025, 2, 28), false},
+		{"yearly from leap day", models.FrequencyYearly, 0, date(2024, 2, 29), date(2024, 2, 29), date(2025, 2, 28), false},
+		{"custom interval", models.FrequencyCustom, 10, date(2024, 1, 1), date(2024, 1, 11), date(2024, 1, 21), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			recurring := &models.RecurringInvoice{
+				ID:               3,
+				UserID:           1,
+				CustomerID:       2,
+				Currency:         "USD",
+				PaymentTermsDays: 14,
+				Frequency:        tt.frequency,
+				IntervalDays:     tt.intervalDays,
+				StartDate:        tt.startDate,
+				NextRunDate:      tt.runDate,
+				Status:           models.RecurringStatusActive,
+				Items:            []*models.InvoiceItem{{Description: "Retainer", Quantity: 1, UnitPrice: 50000}},
+			}
+			mockRepo.On("CreateRecurringInvoiceOccurrence", mock.Anything, recurring, mock.Anything, tt.runDate).Return(nil)
+
+			invoice, err := svc.GenerateRecurringInvoice(context.Background(), recurring)
+
+			assert.NoError(t, err)
+			assert.Equal(t, models.StatusDraft, invoice.Status)
+			assert.Equal(t, int64(3), invoice.RecurringInvoiceID)
+			assert.Equal(t, tt.runDate, invoice.IssueDate)
+			assert.Equal(t, tt.runDate.AddDate(0, 0, 14), invoice.DueDate)
+			assert.Equal(t, int64(50000), invoice.Total)
+			assert.Equal(t, tt.wantNextRun, recurring.NextRunDate)
+			assert.Equal(t, int32(1), recurring.Occurrences)
+			assert.Equal(t, models.RecurringStatusActive, recurring.Status)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGenerateRecurringInvoiceCompletes(t *testing.T) {
+	endDate := date(2024, 3, 15)
+	tests := []struct {
+		name           string
+		maxOccurrences int32
+		occurrences    int32
+		endDate        *time.Time
+	}{
+		{"last of max occurrences", 3, 2, nil},
+		{"next run past end date", 0, 1, &endDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			recurring := &models.RecurringInvoice{
+				ID:             3,
+				UserID:         1,
+				Currency:       "USD",
+				Frequency:      models.FrequencyMonthly,
+				StartDate:      date(2024, 1, 1),
+				EndDate:        tt.endDate,
+				NextRunDate:    date(2024, 3, 1),
+				MaxOccurrences: tt.maxOccurrences,
+				Occurrences:    tt.occurrences,
+				Status:         models.RecurringStatusActive,
+				Items:          []*models.InvoiceItem{{Description: "Retainer", Quantity: 1, UnitPrice: 50000}},
+			}
+			mockRepo.On("CreateRecurringInvoiceOccurrence", mock.Anything, recurring, mock.Anything, date(2024, 3, 1)).Return(nil)
+
+			_, err := svc.GenerateRecurringInvoice(context.Background(), recurring)
+
+			assert.NoError(t, err)
+			assert.Equal(t, models.RecurringStatusCompleted, recurring.Status)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestGenerateRecurringInvoiceConflict(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	recurring := &models.RecurringInvoice{
+		ID:          3,
+		UserID:      1,
+		Currency:    "USD",
+		Frequency:   models.FrequencyWeekly,
+		StartDate:   date(2024, 1, 1),
+		NextRunDate: date(2024, 1, 1),
+		Status:      models.RecurringStatusActive,
+		Items:       []*models.InvoiceItem{{Description: "Retainer", Quantity: 1, UnitPrice: 50000}},
+	}
+	mockRepo.On("CreateRecurringInvoiceOccurrence", mock.Anything, recurring, mock.Anything, date(2024, 1, 1)).Return(sql.ErrNoRows)
+
+	// Another instance already generated this run
+	_, err := svc.GenerateRecurringInvoice(context.Background(), recurring)
+
+	assert.ErrorIs(t, err, service.ErrConflict)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestCreateRecurringInvoiceValidation(t *testing.T) {
+	startDate := date(2024, 6, 1)
+	endDate := date(2024, 5, 1)
+	tests := []struct {
+		name      string
+		recurring *models.RecurringInvoice
+	}{
+		{"no items", &models.RecurringInvoice{Currency: "USD", Frequency: models.FrequencyMonthly}},
+		{"unknown frequency", &models.RecurringInvoice{Currency: "USD", Frequency: "daily"}},
+		{"custom without interval", &models.RecurringInvoice{Currency: "USD", Frequency: models.FrequencyCustom}},
+		{"end before start", &models.RecurringInvoice{Currency: "USD", Frequency: models.FrequencyMonthly, StartDate: startDate, EndDate: &endDate}},
+		{"auto-send without email", &models.RecurringInvoice{Currency: "USD", Frequency: models.FrequencyMonthly, AutoSend: true}},
+		{"unknown currency", &models.RecurringInvoice{Currency: "XYZ", Frequency: models.FrequencyMonthly}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			if tt.name != "no items" {
+				tt.recurring.Items = []*models.InvoiceItem{{Description: "Retainer", Quantity: 1, UnitPrice: 50000}}
+			}
+
+			_, err := svc.CreateRecurringInvoice(context.Background(), tt.recurring)
+
+			assert.ErrorIs(t, err, service.ErrInvalidRequest)
+			mockRepo.AssertNotCalled(t, "CreateRecurringInvoice", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestCreateRecurringInvoice(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	recurring := &models.RecurringInvoice{
+		UserID:    1,
+		Currency:  "usd",
+		Frequency: models.FrequencyWeekly,
+		StartDate: today.AddDate(0, 0, -10),
+		Items:     []*models.InvoiceItem{{Description: "Retainer", Quantity: 1, UnitPrice: 50000}},
+	}
+	mockRepo.On("CreateRecurringInvoice", mock.Anything, recurring).Return(nil)
+
+	result, err := svc.CreateRecurringInvoice(context.Background(), recurring)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "USD", result.Currency)
+	assert.Equal(t, models.RecurringStatusActive, result.Status)
+	// A start date in the past begins with the next weekly occurrence instead of back-filling
+	assert.Equal(t, today.AddDate(0, 0, 4), result.NextRunDate)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRecurringInvoiceTransitions(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     string
+		action     func(svc *service.InvoiceService) (*models.RecurringInvoice, error)
+		wantStatus string
+		wantErr    error
+	}{
+		{"pause active", models.RecurringStatusActive, func(svc *service.InvoiceService) (*models.RecurringInvoice, error) {
+			return svc.PauseRecurringInvoice(context.Background(), 3, 1)
+		}, models.RecurringStatusPaused, nil},
+		{"pause paused", models.RecurringStatusPaused, func(svc *service.InvoiceService) (*models.RecurringInvoice, error) {
+			return svc.PauseRecurringInvoice(context.Background(), 3, 1)
+		}, "", service.ErrInvalidTransition},
+		{"resume paused", models.RecurringStatusPaused, func(svc *service.InvoiceService) (*models.RecurringInvoice, error) {
+			return svc.ResumeRecurringInvoice(context.Background(), 3, 1)
+		}, models.RecurringStatusActive, nil},
+		{"resume cancelled", models.RecurringStatusCancelled, func(svc *service.InvoiceService) (*models.RecurringInvoice, error) {
+			return svc.ResumeRecurringInvoice(context.Background(), 3, 1)
+		}, "", service.ErrInvalidTransition},
+		{"cancel paused", models.RecurringStatusPaused, func(svc *service.InvoiceService) (*models.RecurringInvoice, error) {
+			return svc.CancelRecurringInvoice(context.Background(), 3, 1)
+		}, models.RecurringStatusCancelled, nil},
+		{"cancel completed", models.RecurringStatusCompleted, func(svc *service.InvoiceService) (*models.RecurringInvoice, error) {
+			return svc.CancelRecurringInvoice(context.Background(), 3, 1)
+		}, "", service.ErrInvalidTransition},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			recurring := &models.RecurringInvoice{
+				ID:          3,
+				UserID:      1,
+				Frequency:   models.FrequencyMonthly,
+				StartDate:   date(2024, 1, 1),
+				NextRunDate: date(2024, 2, 1),
+				Status:      tt.status,
+			}
+			mockRepo.On("GetRecurringInvoiceByID", mock.Anything, int64(3), int64(1)).Return(recurring, nil)
+			mockRepo.On("UpdateRecurringInvoiceSchedule", mock.Anything, recurring, tt.status).Return(nil)
+
+			result, err := tt.action(svc)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				mockRepo.AssertNotCalled(t, "UpdateRecurringInvoiceSchedule", mock.Anything, mock.Anything, mock.Anything)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, result.Status)
+		})
+	}
+}
+
+func TestResumeRecurringInvoiceSkipsMissedRuns(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	recurring := &models.RecurringInvoice{
+		ID:           3,
+		UserID:       1,
+		Frequency:    models.FrequencyCustom,
+		IntervalDays: 7,
+		StartDate:    today.AddDate(0, 0, -30),
+		NextRunDate:  today.AddDate(0, 0, -20),
+		Status:       models.RecurringStatusPaused,
+	}
+	mockRepo.On("GetRecurringInvoiceByID", mock.Anything, int64(3), int64(1)).Return(recurring, nil)
+	mockRepo.On("UpdateRecurringInvoiceSchedule", mock.Anything, recurring, models.RecurringStatusPaused).Return(nil)
+
+	result, err := svc.ResumeRecurringInvoice(context.Background(), 3, 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, today.AddDate(0, 0, 5), result.NextRunDate)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,34 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/service"
+)
+
+// IdempotencyKeyCleaner deletes idempotency keys once they have expired.
+type IdempotencyKeyCleaner struct {
+	service *service.InvoiceService
+	logger  *slog.Logger
+}
+
+func NewIdempotencyKeyCleaner(service *service.InvoiceService, logger *slog.Logger) *IdempotencyKeyCleaner {
+	return &IdempotencyKeyCleaner{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// Cleanup deletes the idempotency keys that have expired.
+func (c *IdempotencyKeyCleaner) Cleanup(ctx context.Context) {
+	deleted, err := c.service.DeleteExpiredIdempotencyKeys(ctx, time.Now())
+	if err != nil {
+		c.logger.Error("failed to delete expired idempotency keys", slog.Any("error", err))
+		return
+	}
+	if deleted > 0 {
+		c.logger.Info("deleted expired idempotency keys", slog.Int64("count", deleted))
+	}
+}
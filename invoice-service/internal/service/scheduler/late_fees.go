@@ -0,0 +1,60 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/service"
+)
+
+// LateFeeAssessor charges overdue invoices the late fees and interest their owners' policies call for.
+type LateFeeAssessor struct {
+	service   *service.InvoiceService
+	batchSize int
+	logger    *slog.Logger
+}
+
+func NewLateFeeAssessor(service *service.InvoiceService, batchSize int, logger *slog.Logger) *LateFeeAssessor {
+	return &LateFeeAssessor{
+		service:   service,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+// Run assesses every overdue invoice not yet assessed today, batch by batch, recording an activity for each
+// fee charged.
+func (a *LateFeeAssessor) Run(ctx context.Context) {
+	now := time.Now()
+	total := 0
+	for {
+		invoices, err := a.service.InvoicesDueLateFees(ctx, now, a.batchSize)
+		if err != nil {
+			a.logger.Error("failed to list invoices due late fees", slog.Any("error", err))
+			return
+		}
+
+		assessed := 0
+		for _, due := range invoices {
+			fee, _, err := a.service.AssessLateFee(ctx, due.ID, now)
+			if err != nil {
+				a.logger.Error("failed to assess late fee", slog.Int64("invoice_id", due.ID), slog.Any("error", err))
+				continue
+			}
+			assessed++
+			if fee != nil {
+				total++
+			}
+		}
+
+		// Stop when the batch was short or nothing could be assessed, so a failing invoice can't spin forever
+		if len(invoices) < a.batchSize || assessed == 0 || ctx.Err() != nil {
+			break
+		}
+	}
+
+	if total > 0 {
+		a.logger.Info("charged late fees", slog.Int("count", total))
+	}
+}
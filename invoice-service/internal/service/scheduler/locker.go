@@ -0,0 +1,59 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/go-co-op/gocron"
+)
+
+// PostgresLocker is a gocron.Locker backed by Postgres advisory locks, so that a job scheduled on
+// every invoice-service instance only runs on one of them at a time.
+type PostgresLocker struct {
+	db *sql.DB
+}
+
+func NewPostgresLocker(db *sql.DB) *PostgresLocker {
+	return &PostgresLocker{db: db}
+}
+
+// Lock tries to take the advisory lock for key. Advisory locks belong to a database session, so the
+// connection is held until the lock is released.
+func (l *PostgresLocker) Lock(ctx context.Context, key string) (gocron.Lock, error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var locked bool
+	err = conn.QueryRowContext(ctx, `SELECT pg_try_advisory_lock(hashtext($1))`, key).Scan(&locked)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	if !locked {
+		conn.Close()
+		return nil, gocron.ErrFailedToObtainLock
+	}
+
+	return &postgresLock{conn: conn, key: key}, nil
+}
+
+type postgresLock struct {
+	conn *sql.Conn
+	key  string
+}
+
+func (l *postgresLock) Unlock(ctx context.Context) error {
+	defer l.conn.Close()
+
+	var unlocked bool
+	err := l.conn.QueryRowContext(ctx, `SELECT pg_advisory_unlock(hashtext($1))`, l.key).Scan(&unlocked)
+	if err != nil {
+		return err
+	}
+	if !unlocked {
+		return gocron.ErrFailedToReleaseLock
+	}
+	return nil
+}
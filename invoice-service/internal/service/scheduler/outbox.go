@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+)
+
+// publishTimeout is how long the relay waits for the broker to confirm an event.
+const publishTimeout = 10 * time.Second
+
+// EventPublisher publishes a message and waits for the broker to confirm it.
+type EventPublisher interface {
+	Publish(ctx context.Context, messageID string, body []byte) error
+}
+
+// OutboxRelay publishes the events written to the outbox, in the order they were written, and deletes them
+// once they have been kept long enough after publishing.
+type OutboxRelay struct {
+	service   *service.InvoiceService
+	publisher EventPublisher
+	batchSize int
+	retention time.Duration
+	logger    *slog.Logger
+}
+
+func NewOutboxRelay(service *service.InvoiceService, publisher EventPublisher, batchSize int, retention time.Duration, logger *slog.Logger) *OutboxRelay {
+	return &OutboxRelay{
+		service:   service,
+		publisher: publisher,
+		batchSize: batchSize,
+		retention: retention,
+		logger:    logger,
+	}
+}
+
+// Run publishes due events batch by batch until none are left. A failure almost always means the broker is
+// unreachable, so the run stops at the first one; the event is retried after a backoff, and the events after
+// it wait for the next run.
+func (r *OutboxRelay) Run(ctx context.Context) {
+	total := 0
+	for {
+		events, err := r.service.DueOutboxEvents(ctx, time.Now(), r.batchSize)
+		if err != nil {
+			r.logger.Error("failed to list due outbox events", slog.Any("error", err))
+			return
+		}
+
+		for _, event := range events {
+			if !r.publish(ctx, event) {
+				r.logPublished(total)
+				return
+			}
+			total++
+		}
+
+		if len(events) < r.batchSize || ctx.Err() != nil {
+			break
+		}
+	}
+	r.logPublished(total)
+}
+
+// publish publishes an event and records the outcome, reporting whether it was published.
+func (r *OutboxRelay) publish(ctx context.Context, event *models.OutboxEvent) bool {
+	publishCtx, cancel := context.WithTimeout(ctx, publishTimeout)
+	err := r.publisher.Publish(publishCtx, strconv.FormatInt(event.ID, 10), event.Payload)
+	cancel()
+	if err != nil {
+		r.logger.Warn("failed to publish outbox event", slog.Int64("event_id", event.ID), slog.Any("error", err))
+		err = r.service.MarkOutboxEventFailed(ctx, event, err, time.Now())
+		if err != nil {
+			r.logger.Error("failed to record outbox event failure", slog.Int64("event_id", event.ID), slog.Any("error", err))
+		}
+		if event.Status == models.OutboxStatusFailed {
+			r.logger.Error("gave up publishing outbox event", slog.Int64("event_id", event.ID), slog.Int("attempts", int(event.Attempts)))
+		}
+		return false
+	}
+
+	// If this fails the event is published again, and consumers can tell by its message ID
+	err = r.service.MarkOutboxEventPublished(ctx, event)
+	if err != nil {
+		r.logger.Error("failed to mark outbox event published", slog.Int64("event_id", event.ID), slog.Any("error", err))
+		return false
+	}
+	return true
+}
+
+func (r *OutboxRelay) logPublished(count int) {
+	if count > 0 {
+		r.logger.Info("published outbox events", slog.Int("count", count))
+	}
+}
+
+// Cleanup deletes the events published longer ago than the retention period.
+func (r *OutboxRelay) Cleanup(ctx context.Context) {
+	deleted, err := r.service.DeletePublishedOutboxEvents(ctx, time.Now().Add(-r.retention))
+	if err != nil {
+		r.logger.Error("failed to delete published outbox events", slog.Any("error", err))
+		return
+	}
+	if deleted > 0 {
+		r.logger.Info("deleted published outbox events", slog.Int64("count", deleted))
+	}
+}
@@ -0,0 +1,46 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/service"
+)
+
+// OverdueSweeper moves unpaid invoices that are past their due date to overdue.
+type OverdueSweeper struct {
+	service   *service.InvoiceService
+	batchSize int
+	logger    *slog.Logger
+}
+
+func NewOverdueSweeper(service *service.InvoiceService, batchSize int, logger *slog.Logger) *OverdueSweeper {
+	return &OverdueSweeper{
+		service:   service,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+// Sweep marks overdue invoices batch by batch until none are left, recording an activity for each one.
+func (s *OverdueSweeper) Sweep(ctx context.Context) {
+	now := time.Now()
+	total := 0
+	for {
+		invoices, err := s.service.MarkOverdueInvoices(ctx, now, s.batchSize)
+		if err != nil {
+			s.logger.Error("failed to mark overdue invoices", slog.Any("error", err))
+			return
+		}
+
+		total += len(invoices)
+		if len(invoices) < s.batchSize || ctx.Err() != nil {
+			break
+		}
+	}
+
+	if total > 0 {
+		s.logger.Info("marked invoices as overdue", slog.Int("count", total))
+	}
+}
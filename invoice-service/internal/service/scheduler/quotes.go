@@ -0,0 +1,46 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/service"
+)
+
+// QuoteExpirySweeper moves draft and sent quotes that are past their expiry date to expired.
+type QuoteExpirySweeper struct {
+	service   *service.InvoiceService
+	batchSize int
+	logger    *slog.Logger
+}
+
+func NewQuoteExpirySweeper(service *service.InvoiceService, batchSize int, logger *slog.Logger) *QuoteExpirySweeper {
+	return &QuoteExpirySweeper{
+		service:   service,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+// Sweep expires quotes batch by batch until none are left.
+func (s *QuoteExpirySweeper) Sweep(ctx context.Context) {
+	now := time.Now()
+	total := 0
+	for {
+		quotes, err := s.service.ExpireQuotes(ctx, now, s.batchSize)
+		if err != nil {
+			s.logger.Error("failed to expire quotes", slog.Any("error", err))
+			return
+		}
+
+		total += len(quotes)
+		if len(quotes) < s.batchSize || ctx.Err() != nil {
+			break
+		}
+	}
+
+	if total > 0 {
+		s.logger.Info("marked quotes as expired", slog.Int("count", total))
+	}
+}
@@ -0,0 +1,95 @@
+package scheduler
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+// InvoiceSender emails an invoice to a customer.
+type InvoiceSender interface {
+	DeliverInvoice(ctx context.Context, invoice *models.Invoice, email string) error
+}
+
+// RecurringInvoiceService generates and finalizes the invoices of recurring invoices.
+type RecurringInvoiceService interface {
+	DueRecurringInvoices(ctx context.Context, now time.Time, limit int) ([]*models.RecurringInvoice, error)
+	GenerateRecurringInvoice(ctx context.Context, recurring *models.RecurringInvoice) (*models.Invoice, error)
+	FinalizeInvoice(ctx context.Context, invoiceID, userID int64) (*models.Invoice, error)
+}
+
+// RecurringInvoiceRunner generates the invoices of recurring invoices that are due.
+type RecurringInvoiceRunner struct {
+	service   RecurringInvoiceService
+	sender    InvoiceSender
+	batchSize int
+	logger    *slog.Logger
+}
+
+func NewRecurringInvoiceRunner(service RecurringInvoiceService, sender InvoiceSender, batchSize int, logger *slog.Logger) *RecurringInvoiceRunner {
+	return &RecurringInvoiceRunner{
+		service:   service,
+		sender:    sender,
+		batchSize: batchSize,
+		logger:    logger,
+	}
+}
+
+// Run generates an invoice for every due recurring invoice, batch by batch. A recurring invoice that fell
+// behind gets one invoice per missed run, each dated on its run date.
+func (r *RecurringInvoiceRunner) Run(ctx context.Context) {
+	now := time.Now()
+	total := 0
+	for {
+		recurringInvoices, err := r.service.DueRecurringInvoices(ctx, now, r.batchSize)
+		if err != nil {
+			r.logger.Error("failed to list due recurring invoices", slog.Any("error", err))
+			return
+		}
+
+		generated := 0
+		for _, recurring := range recurringInvoices {
+			if r.generate(ctx, recurring) {
+				generated++
+			}
+		}
+
+		total += generated
+		// Stop when the batch was short or nothing could be generated, so a failing template can't spin forever
+		if len(recurringInvoices) < r.batchSize || generated == 0 || ctx.Err() != nil {
+			break
+		}
+	}
+
+	if total > 0 {
+		r.logger.Info("generated recurring invoices", slog.Int("count", total))
+	}
+}
+
+// generate creates the next invoice of a recurring invoice and, if requested, finalizes and sends it.
+func (r *RecurringInvoiceRunner) generate(ctx context.Context, recurring *models.RecurringInvoice) bool {
+	invoice, err := r.service.GenerateRecurringInvoice(ctx, recurring)
+	if err != nil {
+		r.logger.Error("failed to generate recurring invoice", slog.Int64("recurring_invoice_id", recurring.ID), slog.Any("error", err))
+		return false
+	}
+
+	if !recurring.AutoSend {
+		return true
+	}
+
+	// The draft stays behind if it can't be finalized, for example when there is no exchange rate for its currency
+	finalized, err := r.service.FinalizeInvoice(ctx, invoice.ID, invoice.UserID)
+	if err != nil {
+		r.logger.Error("failed to finalize recurring invoice", slog.Int64("invoice_id", invoice.ID), slog.Any("error", err))
+		return true
+	}
+
+	err = r.sender.DeliverInvoice(ctx, finalized, recurring.CustomerEmail)
+	if err != nil {
+		r.logger.Error("failed to send recurring invoice", slog.Int64("invoice_id", finalized.ID), slog.Any("error", err))
+	}
+	return true
+}
@@ -0,0 +1,83 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service/scheduler"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRecurringInvoiceService struct {
+	mock.Mock
+}
+
+func (m *MockRecurringInvoiceService) DueRecurringInvoices(ctx context.Context, now time.Time, limit int) ([]*models.RecurringInvoice, error) {
+	args := m.Called(ctx, now, limit)
+	return args.Get(0).([]*models.RecurringInvoice), args.Error(1)
+}
+
+func (m *MockRecurringInvoiceService) GenerateRecurringInvoice(ctx context.Context, recurring *models.RecurringInvoice) (*models.Invoice, error) {
+	args := m.Called(ctx, recurring)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Invoice), args.Error(1)
+}
+
+func (m *MockRecurringInvoiceService) FinalizeInvoice(ctx context.Context, invoiceID, userID int64) (*models.Invoice, error) {
+	args := m.Called(ctx, invoiceID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*models.Invoice), args.Error(1)
+}
+
+type MockInvoiceSender struct {
+	mock.Mock
+}
+
+func (m *MockInvoiceSender) DeliverInvoice(ctx context.Context, invoice *models.Invoice, email string) error {
+	args := m.Called(ctx, invoice, email)
+	return args.Error(0)
+}
+
+func TestRecurringInvoiceRunner(t *testing.T) {
+	recurring := &models.RecurringInvoice{ID: 3, AutoSend: true, CustomerEmail: "billing@example.com"}
+	draft := &models.Invoice{ID: 10, UserID: 2, Status: models.StatusDraft}
+
+	t.Run("sends the finalized invoice", func(t *testing.T) {
+		svc := new(MockRecurringInvoiceService)
+		sender := new(MockInvoiceSender)
+		finalized := &models.Invoice{ID: 10, Status: models.StatusUnpaid}
+		svc.On("DueRecurringInvoices", mock.Anything, mock.Anything, 10).Return([]*models.RecurringInvoice{recurring}, nil)
+		svc.On("GenerateRecurringInvoice", mock.Anything, recurring).Return(draft, nil)
+		svc.On("FinalizeInvoice", mock.Anything, int64(10), int64(2)).Return(finalized, nil)
+		sender.On("DeliverInvoice", mock.Anything, finalized, "billing@example.com").Return(nil)
+
+		scheduler.NewRecurringInvoiceRunner(svc, sender, 10, slog.New(slog.NewTextHandler(io.Discard, nil))).Run(context.Background())
+
+		svc.AssertExpectations(t)
+		sender.AssertExpectations(t)
+	})
+
+	t.Run("keeps the draft when finalizing fails", func(t *testing.T) {
+		svc := new(MockRecurringInvoiceService)
+		sender := new(MockInvoiceSender)
+		svc.On("DueRecurringInvoices", mock.Anything, mock.Anything, 10).Return([]*models.RecurringInvoice{recurring}, nil)
+		svc.On("GenerateRecurringInvoice", mock.Anything, recurring).Return(draft, nil)
+		svc.On("FinalizeInvoice", mock.Anything, int64(10), int64(2)).Return(nil, errors.New("no exchange rate for EUR"))
+
+		runner := scheduler.NewRecurringInvoiceRunner(svc, sender, 10, slog.New(slog.NewTextHandler(io.Discard, nil)))
+		assert.NotPanics(t, func() { runner.Run(context.Background()) })
+
+		svc.AssertExpectations(t)
+		sender.AssertNotCalled(t, "DeliverInvoice", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
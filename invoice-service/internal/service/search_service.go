@@ -0,0 +1,164 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+const (
+	defaultSearchPageSize = 20
+	maxSearchPageSize     = 50
+
+	// A search query is cut down to this many words, each at most maxSearchTermLength letters long
+	maxSearchTerms      = 10
+	maxSearchTermLength = 50
+
+	// maxSearchCustomers is the most matching customers a search can be given
+	maxSearchCustomers = 100
+)
+
+// searchCursor is where the next page of search results starts. It keeps the query it belongs to, so a page
+// token can't be used with a different search.
+type searchCursor struct {
+	Query  string `json:"query"`
+	Offset int    `json:"offset"`
+}
+
+// SearchInvoices returns a page of a user's invoices matching a full-text query, best matches first. The query
+// matches invoice numbers, item descriptions and notes, in that order of weight, and any of its words may be
+// cut short, so "inv 20" finds INV-2024-0001. Invoices for the given customers, found by the user service, match
+// too. The highlights of each result are HTML-escaped, with the matching words wrapped in <mark> tags.
+func (s *InvoiceService) SearchInvoices(ctx context.Context, userID int64, query string, customers []models.CustomerMatch, includeArchived bool, pageSize int, pageToken string) ([]*models.InvoiceSearchResult, string, error) {
+	tsQuery, err := searchQuery(query)
+	if err != nil {
+		return nil, "", err
+	}
+	customers, err = uniqueCustomerMatches(customers)
+	if err != nil {
+		return nil, "", err
+	}
+
+	cursor := searchCursor{Query: tsQuery}
+	if pageToken != "" {
+		cursor, err = decodeSearchCursor(pageToken)
+		if err != nil || cursor.Query != tsQuery || cursor.Offset < 0 {
+			return nil, "", fmt.Errorf("%w: invalid page token", ErrInvalidRequest)
+		}
+	}
+
+	if pageSize <= 0 {
+		pageSize = defaultSearchPageSize
+	}
+	pageSize = min(pageSize, maxSearchPageSize)
+
+	// Fetch one result more than the page holds to find out whether there is another page
+	results, err := s.repo.SearchInvoices(ctx, models.InvoiceSearch{
+		UserID:          userID,
+		Query:           tsQuery,
+		Customers:       customers,
+		IncludeArchived: includeArchived,
+		Offset:          cursor.Offset,
+		Limit:           pageSize + 1,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	for _, result := range results {
+		result.Highlights = models.InvoiceHighlights{
+			InvoiceNumber: markHighlight(result.Highlights.InvoiceNumber),
+			Note:          markHighlight(result.Highlights.Note),
+			Items:         markHighlight(result.Highlights.Items),
+		}
+	}
+	if len(results) <= pageSize {
+		return results, "", nil
+	}
+
+	cursor.Offset += pageSize
+	nextPageToken, err := encodeSearchCursor(cursor)
+	if err != nil {
+		return nil, "", err
+	}
+	return results[:pageSize], nextPageToken, nil
+}
+
+// searchQuery turns the words of a search into a tsquery that matches documents containing all of them, each
+// as a whole word or the start of one. Anything but letters and digits separates words, so the query can't
+// carry tsquery operators.
+func searchQuery(query string) (string, error) {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return "", fmt.Errorf("%w: the search must contain a letter or digit", ErrInvalidRequest)
+	}
+
+	terms := make([]string, 0, min(len(words), maxSearchTerms))
+	for _, word := range words[:min(len(words), maxSearchTerms)] {
+		if runes := []rune(word); len(runes) > maxSearchTermLength {
+			word = string(runes[:maxSearchTermLength])
+		}
+		terms = append(terms, word+":*")
+	}
+	return strings.Join(terms, " & "), nil
+}
+
+// uniqueCustomerMatches checks the customers a search was given, keeping the best rank of any that are repeated.
+func uniqueCustomerMatches(customers []models.CustomerMatch) ([]models.CustomerMatch, error) {
+	if len(customers) > maxSearchCustomers {
+		return nil, fmt.Errorf("%w: a search can be given at most %d customers", ErrInvalidRequest, maxSearchCustomers)
+	}
+
+	var unique []models.CustomerMatch
+	positions := map[int64]int{}
+	for _, customer := range customers {
+		if customer.CustomerID <= 0 || customer.Rank < 0 {
+			return nil, fmt.Errorf("%w: invalid customer match", ErrInvalidRequest)
+		}
+		i, ok := positions[customer.CustomerID]
+		if !ok {
+			positions[customer.CustomerID] = len(unique)
+			unique = append(unique, customer)
+			continue
+		}
+		unique[i].Rank = max(unique[i].Rank, customer.Rank)
+	}
+	return unique, nil
+}
+
+// markHighlight escapes a highlight from the repository and marks its matches with <mark> tags. A highlight
+// without any matches is left out.
+func markHighlight(highlight string) string {
+	if !strings.Contains(highlight, models.HighlightStart) {
+		return ""
+	}
+	highlight = html.EscapeString(highlight)
+	return strings.NewReplacer(models.HighlightStart, "<mark>", models.HighlightStop, "</mark>").Replace(highlight)
+}
+
+// encodeSearchCursor turns a search cursor into an opaque page token.
+func encodeSearchCursor(cursor searchCursor) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeSearchCursor(pageToken string) (searchCursor, error) {
+	var cursor searchCursor
+	data, err := base64.RawURLEncoding.DecodeString(pageToken)
+	if err != nil {
+		return cursor, err
+	}
+	err = json.Unmarshal(data, &cursor)
+	return cursor, err
+}
@@ -0,0 +1,111 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSearchInvoices(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	search := models.InvoiceSearch{
+		UserID:    1,
+		Query:     "inv:* & 2024:* & café:*",
+		Customers: []models.CustomerMatch{{CustomerID: 2, Rank: 0.5}, {CustomerID: 3, Rank: 0.1}},
+		Limit:     21,
+	}
+	mockRepo.On("SearchInvoices", mock.Anything, search).Return([]*models.InvoiceSearchResult{
+		{
+			Invoice: &models.Invoice{ID: 7, UserID: 1, InvoiceNumber: "INV-2024-0007"},
+			Rank:    0.8,
+			Highlights: models.InvoiceHighlights{
+				InvoiceNumber: "\x02INV\x03-\x022024\x03-0007",
+				Note:          "Thanks for your business",
+				Items:         "<b>Café</b> \x02Café\x03 catering",
+			},
+		},
+	}, nil)
+
+	// Operators and punctuation only separate words, and repeated customers keep their best rank
+	results, nextPageToken, err := svc.SearchInvoices(context.Background(), 1, "  INV-2024 | !Café ", []models.CustomerMatch{
+		{CustomerID: 2, Rank: 0.2}, {CustomerID: 3, Rank: 0.1}, {CustomerID: 2, Rank: 0.5},
+	}, false, 0, "")
+
+	assert.NoError(t, err)
+	assert.Empty(t, nextPageToken)
+	if assert.Len(t, results, 1) {
+		assert.Equal(t, models.InvoiceHighlights{
+			InvoiceNumber: "<mark>INV</mark>-<mark>2024</mark>-0007",
+			Note:          "",
+			Items:         "&lt;b&gt;Café&lt;/b&gt; <mark>Café</mark> catering",
+		}, results[0].Highlights)
+	}
+}
+
+func TestSearchInvoicesPages(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	page := func(ids ...int64) []*models.InvoiceSearchResult {
+		var results []*models.InvoiceSearchResult
+		for _, id := range ids {
+			results = append(results, &models.InvoiceSearchResult{Invoice: &models.Invoice{ID: id}})
+		}
+		return results
+	}
+	mockRepo.On("SearchInvoices", mock.Anything, models.InvoiceSearch{UserID: 1, Query: "design:*", Limit: 3}).Return(page(1, 2, 3), nil)
+	mockRepo.On("SearchInvoices", mock.Anything, models.InvoiceSearch{UserID: 1, Query: "design:*", Offset: 2, Limit: 3}).Return(page(3), nil)
+
+	results, pageToken, err := svc.SearchInvoices(context.Background(), 1, "design", nil, false, 2, "")
+	assert.NoError(t, err)
+	assert.Len(t, results, 2)
+	assert.NotEmpty(t, pageToken)
+
+	results, nextPageToken, err := svc.SearchInvoices(context.Background(), 1, "Design", nil, false, 2, pageToken)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Empty(t, nextPageToken)
+
+	// A page token only continues the search it came from
+	_, _, err = svc.SearchInvoices(context.Background(), 1, "hosting", nil, false, 2, pageToken)
+	assert.ErrorIs(t, err, service.ErrInvalidRequest)
+	mockRepo.AssertNumberOfCalls(t, "SearchInvoices", 2)
+}
+
+func TestSearchInvoicesInvalid(t *testing.T) {
+	tooManyCustomers := make([]models.CustomerMatch, 101)
+	for i := range tooManyCustomers {
+		tooManyCustomers[i] = models.CustomerMatch{CustomerID: int64(i + 1)}
+	}
+
+	tests := []struct {
+		name      string
+		query     string
+		customers []models.CustomerMatch
+		pageToken string
+	}{
+		{"empty query", "", nil, ""},
+		{"no words", " -&|!:* ", nil, ""},
+		{"invalid customer", "design", []models.CustomerMatch{{CustomerID: 0, Rank: 1}}, ""},
+		{"too many customers", "design", tooManyCustomers, ""},
+		{"invalid page token", "design", nil, "not a token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			_, _, err := svc.SearchInvoices(context.Background(), 1, tt.query, tt.customers, false, 0, tt.pageToken)
+
+			assert.ErrorIs(t, err, service.ErrInvalidRequest)
+			mockRepo.AssertNotCalled(t, "SearchInvoices", mock.Anything, mock.Anything)
+		})
+	}
+}
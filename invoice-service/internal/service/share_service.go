@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+const (
+	// DefaultShareValidity is how long a share link works for when it has no expiry time.
+	DefaultShareValidity = 30 * 24 * time.Hour
+	// MaxShareValidity is the furthest ahead a share link can expire.
+	MaxShareValidity = 365 * 24 * time.Hour
+)
+
+var errShareSecretMissing = errors.New("invoice share links are not configured")
+
+// CreateInvoiceShare creates a link to one of the user's issued invoices that works without signing in until
+// expiresAt, or for DefaultShareValidity if expiresAt is zero.
+func (s *InvoiceService) CreateInvoiceShare(ctx context.Context, invoiceID, userID int64, expiresAt time.Time) (*models.InvoiceShare, error) {
+	if len(s.shareSecret) == 0 {
+		return nil, errShareSecretMissing
+	}
+
+	invoice, err := s.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.UserID != userID {
+		return nil, ErrNotFound
+	}
+	if invoice.Status == models.StatusDraft {
+		return nil, fmt.Errorf("%w: draft invoices cannot be shared", ErrInvalidTransition)
+	}
+
+	now := time.Now()
+	if expiresAt.IsZero() {
+		expiresAt = now.Add(DefaultShareValidity)
+	}
+	switch {
+	case !expiresAt.After(now):
+		return nil, fmt.Errorf("%w: the expiry time has passed", ErrInvalidRequest)
+	case expiresAt.After(now.Add(MaxShareValidity)):
+		return nil, fmt.Errorf("%w: share links expire within %d days", ErrInvalidRequest, int(MaxShareValidity.Hours()/24))
+	}
+
+	share := &models.InvoiceShare{
+		InvoiceID: invoice.ID,
+		UserID:    userID,
+		// Tokens carry the expiry in whole seconds
+		ExpiresAt: expiresAt.Truncate(time.Second),
+	}
+	err = s.repo.CreateInvoiceShare(ctx, share)
+	if err != nil {
+		return nil, err
+	}
+	share.Token = s.signShareToken(share)
+	return share, nil
+}
+
+// ListInvoiceShares returns the share links of one of the user's invoices, including revoked and expired ones.
+func (s *InvoiceService) ListInvoiceShares(ctx context.Context, invoiceID, userID int64) ([]*models.InvoiceShare, error) {
+	invoice, err := s.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if invoice.UserID != userID {
+		return nil, ErrNotFound
+	}
+
+	shares, err := s.repo.ListInvoiceSharesByInvoiceID(ctx, invoiceID)
+	if err != nil {
+		return nil, err
+	}
+	if len(s.shareSecret) > 0 {
+		for _, share := range shares {
+			share.Token = s.signShareToken(share)
+		}
+	}
+	return shares, nil
+}
+
+// RevokeInvoiceShare stops a share link from working. Revoking a revoked link has no effect.
+func (s *InvoiceService) RevokeInvoiceShare(ctx context.Context, shareID, userID int64) (*models.InvoiceShare, error) {
+	share, err := s.getInvoiceShare(ctx, shareID)
+	if err != nil {
+		return nil, err
+	}
+	if share.UserID != userID {
+		return nil, ErrNotFound
+	}
+	if share.RevokedAt != nil {
+		return share, nil
+	}
+
+	err = s.repo.RevokeInvoiceShare(ctx, share)
+	if err != nil {
+		// Another request revoked the link first
+		if errors.Is(err, sql.ErrNoRows) {
+			return s.getInvoiceShare(ctx, shareID)
+		}
+		return nil, err
+	}
+	return share, nil
+}
+
+// ViewSharedInvoice returns the invoice a share link token points to and records the view.
+func (s *InvoiceService) ViewSharedInvoice(ctx context.Context, token string) (*models.Invoice, *models.InvoiceShare, error) {
+	invoice, share, err := s.GetSharedInvoice(ctx, token)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	invoice.RecordActivity(models.ActivityInvoiceViewed,
+		fmt.Sprintf("Invoice %s viewed through share link %d", invoice.InvoiceNumber, share.ID))
+	err = s.repo.RecordInvoiceShareView(ctx, share, invoice)
+	if err != nil {
+		return nil, nil, err
+	}
+	return invoice, share, nil
+}
+
+// GetSharedInvoice returns the invoice a share link token points to without recording a view. Tokens that are
+// forged, expired or revoked are all reported as not found, so a link reveals nothing once it stops working.
+func (s *InvoiceService) GetSharedInvoice(ctx context.Context, token string) (*models.Invoice, *models.InvoiceShare, error) {
+	now := time.Now()
+	shareID, expiresAt, ok := s.verifyShareToken(token)
+	if !ok || !now.Before(expiresAt) {
+		return nil, nil, ErrNotFound
+	}
+
+	share, err := s.getInvoiceShare(ctx, shareID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !share.ExpiresAt.Equal(expiresAt) || !share.Active(now) {
+		return nil, nil, ErrNotFound
+	}
+
+	invoice, err := s.GetInvoice(ctx, share.InvoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	share.Token = token
+	return invoice, share, nil
+}
+
+func (s *InvoiceService) getInvoiceShare(ctx context.Context, shareID int64) (*models.InvoiceShare, error) {
+	share, err := s.repo.GetInvoiceShareByID(ctx, shareID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return share, nil
+}
+
+// signShareToken returns the token of a share link: its ID and expiry time, followed by an HMAC-SHA256 of both
+// under the share secret.
+func (s *InvoiceService) signShareToken(share *models.InvoiceShare) string {
+	payload := fmt.Sprintf("%d.%d", share.ID, share.ExpiresAt.Unix())
+	return payload + "." + base64.RawURLEncoding.EncodeToString(s.shareSignature(payload))
+}
+
+// verifyShareToken checks the signature of a share link token and returns the share ID and expiry time it
+// carries.
+func (s *InvoiceService) verifyShareToken(token string) (int64, time.Time, bool) {
+	if len(s.shareSecret) == 0 {
+		return 0, time.Time{}, false
+	}
+
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return 0, time.Time{}, false
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || !hmac.Equal(signature, s.shareSignature(parts[0]+"."+parts[1])) {
+		return 0, time.Time{}, false
+	}
+
+	shareID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, time.Time{}, false
+	}
+	seconds, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return 0, time.Time{}, false
+	}
+	return shareID, time.Unix(seconds, 0), true
+}
+
+func (s *InvoiceService) shareSignature(payload string) []byte {
+	mac := hmac.New(sha256.New, s.shareSecret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,233 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var shareSecret = service.WithShareSecret([]byte("test-secret"))
+
+// createShare creates a share link to an unpaid invoice and returns it with the share as the repository
+// stored it.
+func createShare(t *testing.T, svc *service.InvoiceService, mockRepo *MockInvoiceRepository) (*models.InvoiceShare, *models.InvoiceShare) {
+	t.Helper()
+
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(&models.Invoice{ID: 1, UserID: 1, Status: models.StatusUnpaid}, nil)
+	mockRepo.On("CreateInvoiceShare", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		args.Get(1).(*models.InvoiceShare).ID = 7
+	}).Return(nil).Once()
+
+	share, err := svc.CreateInvoiceShare(context.Background(), 1, 1, time.Time{})
+	assert.NoError(t, err)
+
+	stored := *share
+	stored.Token = ""
+	return share, &stored
+}
+
+func TestCreateInvoiceShare(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo, shareSecret)
+
+	share, stored := createShare(t, svc, mockRepo)
+
+	assert.Equal(t, int64(1), share.InvoiceID)
+	assert.WithinDuration(t, time.Now().Add(service.DefaultShareValidity), share.ExpiresAt, time.Minute)
+	assert.True(t, strings.HasPrefix(share.Token, "7."))
+
+	// The token opens the invoice and counts the view
+	mockRepo.On("GetInvoiceShareByID", mock.Anything, int64(7)).Return(stored, nil)
+	mockRepo.On("RecordInvoiceShareView", mock.Anything, stored, mock.Anything).Return(nil)
+
+	invoice, viewed, err := svc.ViewSharedInvoice(context.Background(), share.Token)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), invoice.ID)
+	assert.Equal(t, int64(7), viewed.ID)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestGetSharedInvoiceRecordsNoView(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo, shareSecret)
+	share, stored := createShare(t, svc, mockRepo)
+
+	// Downloading the PDF checks the token without counting another view
+	mockRepo.On("GetInvoiceShareByID", mock.Anything, int64(7)).Return(stored, nil)
+
+	invoice, _, err := svc.GetSharedInvoice(context.Background(), share.Token)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), invoice.ID)
+	mockRepo.AssertNotCalled(t, "RecordInvoiceShareView", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestCreateInvoiceShareInvalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      []service.Option
+		invoice   *models.Invoice
+		expiresAt time.Time
+		wantErr   error
+	}{
+		{
+			name:    "draft invoice",
+			opts:    []service.Option{shareSecret},
+			invoice: &models.Invoice{ID: 1, UserID: 1, Status: models.StatusDraft},
+			wantErr: service.ErrInvalidTransition,
+		},
+		{
+			name:    "other user's invoice",
+			opts:    []service.Option{shareSecret},
+			invoice: &models.Invoice{ID: 1, UserID: 2, Status: models.StatusUnpaid},
+			wantErr: service.ErrNotFound,
+		},
+		{
+			name:      "expiry in the past",
+			opts:      []service.Option{shareSecret},
+			invoice:   &models.Invoice{ID: 1, UserID: 1, Status: models.StatusUnpaid},
+			expiresAt: time.Now().Add(-time.Hour),
+			wantErr:   service.ErrInvalidRequest,
+		},
+		{
+			name:      "expiry too far ahead",
+			opts:      []service.Option{shareSecret},
+			invoice:   &models.Invoice{ID: 1, UserID: 1, Status: models.StatusUnpaid},
+			expiresAt: time.Now().Add(service.MaxShareValidity + time.Hour),
+			wantErr:   service.ErrInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo, tt.opts...)
+
+			mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(tt.invoice, nil)
+
+			_, err := svc.CreateInvoiceShare(context.Background(), 1, 1, tt.expiresAt)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+			mockRepo.AssertNotCalled(t, "CreateInvoiceShare", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestCreateInvoiceShareWithoutSecret(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	_, err := svc.CreateInvoiceShare(context.Background(), 1, 1, time.Time{})
+
+	assert.Error(t, err)
+	mockRepo.AssertNotCalled(t, "CreateInvoiceShare", mock.Anything, mock.Anything)
+}
+
+func TestViewSharedInvoiceRejected(t *testing.T) {
+	revokedAt := time.Now()
+	tests := []struct {
+		name   string
+		token  func(token string) string
+		stored func(share *models.InvoiceShare)
+	}{
+		{
+			name: "tampered expiry",
+			token: func(token string) string {
+				parts := strings.Split(token, ".")
+				parts[1] += "0"
+				return strings.Join(parts, ".")
+			},
+		},
+		{
+			name:  "tampered share ID",
+			token: func(token string) string { return "8" + strings.TrimPrefix(token, "7") },
+		},
+		{
+			name:  "malformed token",
+			token: func(token string) string { return "not-a-token" },
+		},
+		{
+			name:   "revoked link",
+			stored: func(share *models.InvoiceShare) { share.RevokedAt = &revokedAt },
+		},
+		{
+			name:   "expiry changed since signing",
+			stored: func(share *models.InvoiceShare) { share.ExpiresAt = share.ExpiresAt.Add(-time.Hour) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo, shareSecret)
+			share, stored := createShare(t, svc, mockRepo)
+
+			token := share.Token
+			if tt.token != nil {
+				token = tt.token(token)
+			}
+			if tt.stored != nil {
+				tt.stored(stored)
+			}
+			mockRepo.On("GetInvoiceShareByID", mock.Anything, int64(7)).Return(stored, nil).Maybe()
+
+			_, _, err := svc.ViewSharedInvoice(context.Background(), token)
+
+			assert.ErrorIs(t, err, service.ErrNotFound)
+			mockRepo.AssertNotCalled(t, "RecordInvoiceShareView", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestViewSharedInvoiceSignedWithOtherSecret(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo, shareSecret)
+	share, _ := createShare(t, svc, mockRepo)
+
+	other := service.NewInvoiceService(mockRepo, service.WithShareSecret([]byte("other-secret")))
+	_, _, err := other.ViewSharedInvoice(context.Background(), share.Token)
+
+	assert.ErrorIs(t, err, service.ErrNotFound)
+	mockRepo.AssertNotCalled(t, "GetInvoiceShareByID", mock.Anything, mock.Anything)
+}
+
+func TestRevokeInvoiceShare(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo, shareSecret)
+
+	share := &models.InvoiceShare{ID: 7, InvoiceID: 1, UserID: 1, ExpiresAt: time.Now().Add(time.Hour)}
+	mockRepo.On("GetInvoiceShareByID", mock.Anything, int64(7)).Return(share, nil)
+	mockRepo.On("RevokeInvoiceShare", mock.Anything, share).Run(func(args mock.Arguments) {
+		revokedAt := time.Now()
+		args.Get(1).(*models.InvoiceShare).RevokedAt = &revokedAt
+	}).Return(nil)
+
+	revoked, err := svc.RevokeInvoiceShare(context.Background(), 7, 1)
+
+	assert.NoError(t, err)
+	assert.NotNil(t, revoked.RevokedAt)
+	mockRepo.AssertExpectations(t)
+
+	// Another user's link is not found
+	_, err = svc.RevokeInvoiceShare(context.Background(), 7, 2)
+	assert.ErrorIs(t, err, service.ErrNotFound)
+}
+
+func TestRevokeInvoiceShareNotFound(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo, shareSecret)
+
+	mockRepo.On("GetInvoiceShareByID", mock.Anything, int64(7)).Return((*models.InvoiceShare)(nil), sql.ErrNoRows)
+
+	_, err := svc.RevokeInvoiceShare(context.Background(), 7, 1)
+
+	assert.ErrorIs(t, err, service.ErrNotFound)
+}
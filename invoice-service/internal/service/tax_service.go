@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+)
+
+func (s *InvoiceService) CreateTaxRate(ctx context.Context, taxRate *models.TaxRate) (*models.TaxRate, error) {
+	err := validateTaxRate(taxRate)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.CreateTaxRate(ctx, taxRate)
+	if err != nil {
+		return nil, err
+	}
+	return taxRate, nil
+}
+
+func (s *InvoiceService) ListTaxRates(ctx context.Context, userID int64) ([]*models.TaxRate, error) {
+	return s.repo.ListTaxRatesByUserID(ctx, userID)
+}
+
+// UpdateTaxRate changes a tax rate. Invoices keep a snapshot of the rates applied to them, so only
+// invoices calculated afterwards pick up the change.
+func (s *InvoiceService) UpdateTaxRate(ctx context.Context, taxRate *models.TaxRate) (*models.TaxRate, error) {
+	err := validateTaxRate(taxRate)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repo.UpdateTaxRate(ctx, taxRate)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return taxRate, nil
+}
+
+func (s *InvoiceService) DeleteTaxRate(ctx context.Context, taxRateID, userID int64) error {
+	err := s.repo.DeleteTaxRate(ctx, taxRateID, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return ErrNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+// resolveItemTaxes loads the tax rates referenced by each item and snapshots them onto the item.
+func (s *InvoiceService) resolveItemTaxes(ctx context.Context, userID int64, items []*models.InvoiceItem) error {
+	return s.resolveTaxes(ctx, userID, items, nil)
+}
+
+// resolveTaxes loads the tax rates referenced by each item and charge and snapshots them onto it.
+func (s *InvoiceService) resolveTaxes(ctx context.Context, userID int64, items []*models.InvoiceItem, charges []*models.InvoiceCharge) error {
+	var ids []int64
+	for _, item := range items {
+		ids = append(ids, item.TaxRateIDs...)
+	}
+	for _, charge := range charges {
+		ids = append(ids, charge.TaxRateIDs...)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	taxRates, err := s.repo.GetTaxRatesByIDs(ctx, userID, ids)
+	if err != nil {
+		return err
+	}
+	byID := make(map[int64]*models.TaxRate, len(taxRates))
+	for _, taxRate := range taxRates {
+		byID[taxRate.ID] = taxRate
+	}
+
+	for _, item := range items {
+		item.Taxes, err = lookupTaxRates(byID, item.TaxRateIDs, "an item")
+		if err != nil {
+			return err
+		}
+	}
+	for _, charge := range charges {
+		charge.Taxes, err = lookupTaxRates(byID, charge.TaxRateIDs, "a charge")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// lookupTaxRates returns the tax rates with the given IDs, in order, from the loaded rates.
+func lookupTaxRates(byID map[int64]*models.TaxRate, ids []int64, line string) ([]*models.TaxRate, error) {
+	var taxes []*models.TaxRate
+	seen := map[int64]bool{}
+	for _, id := range ids {
+		taxRate, ok := byID[id]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown tax rate %d", ErrInvalidRequest, id)
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("%w: tax rate %d is applied to %s more than once", ErrInvalidRequest, id, line)
+		}
+		seen[id] = true
+		taxes = append(taxes, taxRate)
+	}
+	return taxes, nil
+}
+
+func validateTaxRate(taxRate *models.TaxRate) error {
+	taxRate.Name = strings.TrimSpace(taxRate.Name)
+	switch {
+	case taxRate.Name == "":
+		return fmt.Errorf("%w: tax rate name is required", ErrInvalidRequest)
+	case taxRate.Rate < 0 || taxRate.Rate > 10000:
+		return fmt.Errorf("%w: tax rate must be between 0 and 10000 hundredths of a percent", ErrInvalidRequest)
+	case taxRate.Inclusive && taxRate.Compound:
+		return fmt.Errorf("%w: a compound tax cannot be inclusive", ErrInvalidRequest)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+var (
+	vat = &models.TaxRate{ID: 1, UserID: 1, Name: "VAT", Rate: 1000}                        // 10% exclusive
+	gst = &models.TaxRate{ID: 2, UserID: 1, Name: "GST", Rate: 500}                         // 5% exclusive
+	qst = &models.TaxRate{ID: 3, UserID: 1, Name: "QST", Rate: 998, Compound: true}         // 9.98% on top of GST
+	inc = &models.TaxRate{ID: 4, UserID: 1, Name: "VAT incl.", Rate: 2000, Inclusive: true} // 20% inclusive
+)
+
+func TestCreateInvoiceWithTaxes(t *testing.T) {
+	tests := []struct {
+		name               string
+		discountPercentage int64
+		items              []*models.InvoiceItem
+		taxRates           []*models.TaxRate
+		wantSubtotal       int64
+		wantDiscount       int64
+		wantTaxTotal       int64
+		wantTotal          int64
+		wantTaxes          []*models.InvoiceTax
+	}{
+		{
+			name:               "exclusive tax after discount",
+			discountPercentage: 1000,
+			items:              []*models.InvoiceItem{{Description: "Item 1", Quantity: 2, UnitPrice: 10000, TaxRateIDs: []int64{1}}},
+			taxRates:           []*models.TaxRate{vat},
+			wantSubtotal:       20000,
+			wantDiscount:       2000,
+			wantTaxTotal:       1800,
+			wantTotal:          19800,
+			wantTaxes: []*models.InvoiceTax{
+				{TaxRateID: 1, Name: "VAT", Rate: 1000, TaxableAmount: 18000, Amount: 1800},
+			},
+		},
+		{
+			name:         "inclusive tax",
+			items:        []*models.InvoiceItem{{Description: "Item 1", Quantity: 1, UnitPrice: 12000, TaxRateIDs: []int64{4}}},
+			taxRates:     []*models.TaxRate{inc},
+			wantSubtotal: 12000,
+			wantTaxTotal: 2000,
+			wantTotal:    12000,
+			wantTaxes: []*models.InvoiceTax{
+				{TaxRateID: 4, Name: "VAT incl.", Rate: 2000, Inclusive: true, TaxableAmount: 10000, Amount: 2000},
+			},
+		},
+		{
+			name:         "compound tax",
+			items:        []*models.InvoiceItem{{Description: "Item 1", Quantity: 1, UnitPrice: 10000, TaxRateIDs: []int64{3, 2}}},
+			taxRates:     []*models.TaxRate{gst, qst},
+			wantSubtotal: 10000,
+			wantTaxTotal: 1548,
+			wantTotal:    11548,
+			wantTaxes: []*models.InvoiceTax{
+				{TaxRateID: 2, Name: "GST", Rate: 500, TaxableAmount: 10000, Amount: 500},
+				{TaxRateID: 3, Name: "QST", Rate: 998, Compound: true, TaxableAmount: 10500, Amount: 1048},
+			},
+		},
+		{
+			name: "breakdown across items",
+			items: []*models.InvoiceItem{
+				{Description: "Item 1", Quantity: 1, UnitPrice: 5000, TaxRateIDs: []int64{1}},
+				{Description: "Item 2", Quantity: 3, UnitPrice: 1000, TaxRateIDs: []int64{1, 2}},
+				{Description: "Item 3", Quantity: 1, UnitPrice: 2000},
+			},
+			taxRates:     []*models.TaxRate{vat, gst},
+			wantSubtotal: 10000,
+			wantTaxTotal: 950,
+			wantTotal:    10950,
+			wantTaxes: []*models.InvoiceTax{
+				{TaxRateID: 1, Name: "VAT", Rate: 1000, TaxableAmount: 8000, Amount: 800},
+				{TaxRateID: 2, Name: "GST", Rate: 500, TaxableAmount: 3000, Amount: 150},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+
+			mockRepo.On("GetBrandingProfile", mock.Anything, mock.Anything, int64(0)).Return(nil, sql.ErrNoRows).Maybe()
+			mockRepo.On("GetTaxRatesByIDs", mock.Anything, int64(1), mock.Anything).Return(tt.taxRates, nil)
+			mockRepo.On("CreateInvoice", mock.Anything, mock.Anything).Return(nil)
+
+			invoice, err := svc.CreateInvoice(context.Background(), &models.Invoice{
+				UserID:             1,
+				Currency:           "USD",
+				DiscountPercentage: tt.discountPercentage,
+				Items:              tt.items,
+			})
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantSubtotal, invoice.Subtotal)
+			assert.Equal(t, tt.wantDiscount, invoice.DiscountAmount)
+			assert.Equal(t, tt.wantTaxTotal, invoice.TaxTotal)
+			assert.Equal(t, tt.wantTotal, invoice.Total)
+			assert.Equal(t, tt.wantTaxes, invoice.Taxes)
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestCreateInvoiceUnknownTaxRate(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	mockRepo.On("GetBrandingProfile", mock.Anything, mock.Anything, int64(0)).Return(nil, sql.ErrNoRows).Maybe()
+	mockRepo.On("GetTaxRatesByIDs", mock.Anything, int64(1), []int64{9}).Return([]*models.TaxRate{}, nil)
+
+	_, err := svc.CreateInvoice(context.Background(), &models.Invoice{
+		UserID:   1,
+		Currency: "USD",
+		Items:    []*models.InvoiceItem{{Description: "Item 1", Quantity: 1, UnitPrice: 100, TaxRateIDs: []int64{9}}},
+	})
+
+	assert.ErrorIs(t, err, service.ErrInvalidRequest)
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "CreateInvoice", mock.Anything, mock.Anything)
+}
+
+func TestCreateTaxRate(t *testing.T) {
+	tests := []struct {
+		name    string
+		taxRate *models.TaxRate
+		wantErr error
+	}{
+		{"valid", &models.TaxRate{UserID: 1, Name: "VAT", Rate: 2000}, nil},
+		{"missing name", &models.TaxRate{UserID: 1, Name: " ", Rate: 2000}, service.ErrInvalidRequest},
+		{"negative rate", &models.TaxRate{UserID: 1, Name: "VAT", Rate: -1}, service.ErrInvalidRequest},
+		{"rate above 100%", &models.TaxRate{UserID: 1, Name: "VAT", Rate: 10001}, service.ErrInvalidRequest},
+		{"inclusive compound", &models.TaxRate{UserID: 1, Name: "VAT", Rate: 2000, Inclusive: true, Compound: true}, service.ErrInvalidRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+			if tt.wantErr == nil {
+				mockRepo.On("CreateTaxRate", mock.Anything, tt.taxRate).Return(nil)
+			}
+
+			_, err := svc.CreateTaxRate(context.Background(), tt.taxRate)
+
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockRepo.AssertExpectations(t)
+		})
+	}
+}
+
+func TestDeleteTaxRateNotFound(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	mockRepo.On("DeleteTaxRate", mock.Anything, int64(1), int64(2)).Return(sql.ErrNoRows)
+
+	err := svc.DeleteTaxRate(context.Background(), 1, 2)
+
+	assert.ErrorIs(t, err, service.ErrNotFound)
+	mockRepo.AssertExpectations(t)
+}
@@ -0,0 +1,183 @@
+package ubl
+
+import (
+	"encoding/xml"
+
+	"github.com/shopspring/decimal"
+)
+
+// The identifiers that mark a document as a Peppol BIS Billing 3.0 invoice.
+const (
+	customizationID = "urn:cen.eu:en16931:2017#compliant#urn:fdc:peppol.eu:2017:poacc:billing:3.0"
+	profileID       = "urn:fdc:peppol.eu:2017:poacc:billing:01:1.0"
+
+	invoiceNamespace   = "urn:oasis:names:specification:ubl:schema:xsd:Invoice-2"
+	aggregateNamespace = "urn:oasis:names:specification:ubl:schema:xsd:CommonAggregateComponents-2"
+	basicNamespace     = "urn:oasis:names:specification:ubl:schema:xsd:CommonBasicComponents-2"
+)
+
+// Codes used in the document, from the code lists Peppol BIS Billing 3.0 refers to.
+const (
+	commercialInvoice = "380" // UNTDID 1001 document type
+	creditTransfer    = "30"  // UNTDID 4461 payment means
+	discountReason    = "95"  // UNTDID 5189 allowance reason
+	unitCodeOne       = "C62" // UN/ECE Recommendation 20 unit of measure
+	emailScheme       = "EM"  // Electronic address scheme for an email address
+	vatScheme         = "VAT"
+)
+
+// VAT category codes, from UNCL 5305.
+const (
+	standardRated = "S"
+	zeroRated     = "Z"
+	exempt        = "E"
+	outOfScope    = "O"
+)
+
+// The types below mirror the parts of a UBL 2.1 Invoice that Peppol BIS Billing 3.0 uses. Their fields are in
+// schema order, which UBL requires. Unexported fields keep the numbers behind the formatted values for validation.
+
+type invoice struct {
+	XMLName              xml.Name          `xml:"Invoice"`
+	Namespace            string            `xml:"xmlns,attr"`
+	AggregateNamespace   string            `xml:"xmlns:cac,attr"`
+	BasicNamespace       string            `xml:"xmlns:cbc,attr"`
+	CustomizationID      string            `xml:"cbc:CustomizationID"`
+	ProfileID            string            `xml:"cbc:ProfileID"`
+	ID                   string            `xml:"cbc:ID"`
+	IssueDate            string            `xml:"cbc:IssueDate"`
+	DueDate              string            `xml:"cbc:DueDate,omitempty"`
+	InvoiceTypeCode      string            `xml:"cbc:InvoiceTypeCode"`
+	Note                 string            `xml:"cbc:Note,omitempty"`
+	DocumentCurrencyCode string            `xml:"cbc:DocumentCurrencyCode"`
+	BuyerReference       string            `xml:"cbc:BuyerReference,omitempty"`
+	Supplier             party             `xml:"cac:AccountingSupplierParty>cac:Party"`
+	Customer             party             `xml:"cac:AccountingCustomerParty>cac:Party"`
+	PaymentMeans         *paymentMeans     `xml:"cac:PaymentMeans,omitempty"`
+	AllowanceCharges     []allowanceCharge `xml:"cac:AllowanceCharge"`
+	TaxTotal             taxTotal          `xml:"cac:TaxTotal"`
+	LegalMonetaryTotal   monetaryTotal     `xml:"cac:LegalMonetaryTotal"`
+	Lines                []invoiceLine     `xml:"cac:InvoiceLine"`
+}
+
+type party struct {
+	EndpointID    identifier      `xml:"cbc:EndpointID"`
+	PartyName     *partyName      `xml:"cac:PartyName,omitempty"`
+	PostalAddress address         `xml:"cac:PostalAddress"`
+	TaxScheme     *partyTaxScheme `xml:"cac:PartyTaxScheme,omitempty"`
+	LegalName     string          `xml:"cac:PartyLegalEntity>cbc:RegistrationName"`
+	Contact       *contact        `xml:"cac:Contact,omitempty"`
+}
+
+type partyName struct {
+	Name string `xml:"cbc:Name"`
+}
+
+type contact struct {
+	Email string `xml:"cbc:ElectronicMail"`
+}
+
+type identifier struct {
+	SchemeID string `xml:"schemeID,attr"`
+	Value    string `xml:",chardata"`
+}
+
+type address struct {
+	StreetName           string       `xml:"cbc:StreetName,omitempty"`
+	AdditionalStreetName string       `xml:"cbc:AdditionalStreetName,omitempty"`
+	AddressLine          *addressLine `xml:"cac:AddressLine,omitempty"`
+	Country              string       `xml:"cac:Country>cbc:IdentificationCode"`
+}
+
+type addressLine struct {
+	Line string `xml:"cbc:Line"`
+}
+
+type partyTaxScheme struct {
+	CompanyID string `xml:"cbc:CompanyID"`
+	TaxScheme string `xml:"cac:TaxScheme>cbc:ID"`
+}
+
+type paymentMeans struct {
+	Code      string            `xml:"cbc:PaymentMeansCode"`
+	PaymentID string            `xml:"cbc:PaymentID,omitempty"`
+	Account   *financialAccount `xml:"cac:PayeeFinancialAccount,omitempty"`
+}
+
+type financialAccount struct {
+	ID     string  `xml:"cbc:ID"`
+	Name   string  `xml:"cbc:Name,omitempty"`
+	Branch *branch `xml:"cac:FinancialInstitutionBranch,omitempty"`
+}
+
+type branch struct {
+	ID string `xml:"cbc:ID"`
+}
+
+type allowanceCharge struct {
+	ChargeIndicator bool         `xml:"cbc:ChargeIndicator"`
+	ReasonCode      string       `xml:"cbc:AllowanceChargeReasonCode,omitempty"`
+	Reason          string       `xml:"cbc:AllowanceChargeReason,omitempty"`
+	Amount          amount       `xml:"cbc:Amount"`
+	TaxCategory     *taxCategory `xml:"cac:TaxCategory,omitempty"` // Only on document level allowances and charges
+}
+
+type taxTotal struct {
+	TaxAmount amount        `xml:"cbc:TaxAmount"`
+	Subtotals []taxSubtotal `xml:"cac:TaxSubtotal"`
+}
+
+type taxSubtotal struct {
+	TaxableAmount amount      `xml:"cbc:TaxableAmount"`
+	TaxAmount     amount      `xml:"cbc:TaxAmount"`
+	Category      taxCategory `xml:"cac:TaxCategory"`
+}
+
+type taxCategory struct {
+	ID              string `xml:"cbc:ID"`
+	Percent         string `xml:"cbc:Percent,omitempty"`
+	ExemptionReason string `xml:"cbc:TaxExemptionReason,omitempty"`
+	TaxScheme       string `xml:"cac:TaxScheme>cbc:ID"`
+
+	rate int64 // Hundredths of a percent
+}
+
+type monetaryTotal struct {
+	LineExtensionAmount  amount  `xml:"cbc:LineExtensionAmount"`
+	TaxExclusiveAmount   amount  `xml:"cbc:TaxExclusiveAmount"`
+	TaxInclusiveAmount   amount  `xml:"cbc:TaxInclusiveAmount"`
+	AllowanceTotalAmount *amount `xml:"cbc:AllowanceTotalAmount,omitempty"`
+	ChargeTotalAmount    *amount `xml:"cbc:ChargeTotalAmount,omitempty"`
+	PrepaidAmount        *amount `xml:"cbc:PrepaidAmount,omitempty"`
+	PayableAmount        amount  `xml:"cbc:PayableAmount"`
+}
+
+type invoiceLine struct {
+	ID                  string            `xml:"cbc:ID"`
+	InvoicedQuantity    quantity          `xml:"cbc:InvoicedQuantity"`
+	LineExtensionAmount amount            `xml:"cbc:LineExtensionAmount"`
+	AllowanceCharges    []allowanceCharge `xml:"cac:AllowanceCharge"`
+	Item                item              `xml:"cac:Item"`
+	Price               amount            `xml:"cac:Price>cbc:PriceAmount"`
+}
+
+type item struct {
+	Name     string      `xml:"cbc:Name"`
+	Category taxCategory `xml:"cac:ClassifiedTaxCategory"`
+}
+
+type quantity struct {
+	UnitCode string `xml:"unitCode,attr"`
+	Value    int64  `xml:",chardata"`
+}
+
+// amount is a sum of money in cents, written in major units with the currency as an attribute.
+type amount struct {
+	currency string
+	cents    int64
+}
+
+func (a amount) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	start.Attr = append(start.Attr, xml.Attr{Name: xml.Name{Local: "currencyID"}, Value: a.currency})
+	return e.EncodeElement(decimal.New(a.cents, -2).StringFixed(2), start)
+}
@@ -0,0 +1,334 @@
+package ubl
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/shopspring/decimal"
+)
+
+// exemptionReasons explain the VAT categories that charge no tax, as the VAT breakdown must.
+var exemptionReasons = map[string]string{
+	exempt:     "Exempt from VAT",
+	outOfScope: "Not subject to VAT",
+}
+
+// RenderInvoice renders an invoice issued by issuer to customer as a UBL 2.1 invoice following Peppol BIS Billing
+// 3.0, after checking it against the rules that can be checked offline. If the invoice or the parties can't make a
+// valid document, it returns a *ValidationError listing every rule broken.
+//
+// UBL prices exclude VAT and carry a single VAT rate, so invoices with an inclusive or compound tax, or more than one
+// tax on a line or charge, can't be rendered. Untaxed lines are exempt, or not subject to VAT when nothing on the
+// invoice is taxed. Late fees that haven't been reversed are untaxed charges, so the amount payable is the balance
+// due.
+func RenderInvoice(inv *models.Invoice, issuer, customer models.Party) ([]byte, error) {
+	doc, problems := buildInvoice(inv, issuer, customer)
+	if len(problems) == 0 {
+		problems = doc.validate()
+	}
+	if len(problems) > 0 {
+		return nil, &ValidationError{Problems: problems}
+	}
+
+	out, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte(xml.Header), out...), '\n'), nil
+}
+
+// categoryTotals adds up the amounts in one VAT category, in cents.
+type categoryTotals struct {
+	category  taxCategory
+	base      int64 // Line amounts after line discounts, plus charges
+	allowance int64 // The category's share of the invoice discounts
+	taxable   int64
+	tax       int64
+}
+
+// buildInvoice maps an invoice onto a UBL document, returning the parts of the invoice UBL can't express.
+func buildInvoice(inv *models.Invoice, issuer, customer models.Party) (*invoice, []string) {
+	money := func(cents int64) amount { return amount{currency: inv.Currency, cents: cents} }
+	optionalMoney := func(cents int64) *amount {
+		if cents == 0 {
+			return nil
+		}
+		a := money(cents)
+		return &a
+	}
+
+	doc := &invoice{
+		Namespace:            invoiceNamespace,
+		AggregateNamespace:   aggregateNamespace,
+		BasicNamespace:       basicNamespace,
+		CustomizationID:      customizationID,
+		ProfileID:            profileID,
+		ID:                   inv.InvoiceNumber,
+		IssueDate:            formatDate(inv.IssueDate),
+		DueDate:              formatDate(inv.DueDate),
+		InvoiceTypeCode:      commercialInvoice,
+		Note:                 inv.Note,
+		DocumentCurrencyCode: inv.Currency,
+		// Peppol requires a buyer or order reference and the invoice has neither, so the buyer is given the invoice
+		// number to quote back
+		BuyerReference: inv.InvoiceNumber,
+		Supplier:       buildParty(issuer),
+		Customer:       buildParty(customer),
+	}
+
+	untaxed := taxCategory{ID: exempt, Percent: formatPercent(0), TaxScheme: vatScheme}
+	if !hasTaxes(inv) {
+		untaxed = taxCategory{ID: outOfScope, TaxScheme: vatScheme}
+		// Invoices outside the scope of VAT must not name VAT identifiers (BR-O-02, BR-O-04)
+		doc.Supplier.TaxScheme = nil
+		doc.Customer.TaxScheme = nil
+	}
+
+	var problems []string
+	categoryOf := func(what string, taxes []*models.TaxRate) taxCategory {
+		if len(taxes) == 0 {
+			return untaxed
+		}
+		switch tax := taxes[0]; {
+		case len(taxes) > 1:
+			problems = append(problems, fmt.Sprintf("%s has more than one tax, but UBL allows a single VAT rate", what))
+		case tax.Inclusive:
+			problems = append(problems, fmt.Sprintf("%s has tax %q included in its price, but UBL prices exclude VAT", what, tax.Name))
+		case tax.Compound:
+			problems = append(problems, fmt.Sprintf("%s has compound tax %q, which UBL can't express", what, tax.Name))
+		}
+		return vatCategory(taxes[0].Rate)
+	}
+
+	var categories []*categoryTotals
+	totalsFor := func(category taxCategory) *categoryTotals {
+		for _, totals := range categories {
+			if totals.category.ID == category.ID && totals.category.rate == category.rate {
+				return totals
+			}
+		}
+		totals := &categoryTotals{category: category}
+		categories = append(categories, totals)
+		return totals
+	}
+
+	var lineDiscounts int64
+	for i, invoiceItem := range inv.Items {
+		category := categoryOf(fmt.Sprintf("line %d", i+1), invoiceItem.Taxes)
+		line := invoiceLine{
+			ID:                  strconv.Itoa(i + 1),
+			InvoicedQuantity:    quantity{UnitCode: unitCodeOne, Value: int64(invoiceItem.Quantity)},
+			LineExtensionAmount: money(invoiceItem.NetAmount()),
+			Item:                item{Name: invoiceItem.Description, Category: category},
+			Price:               money(invoiceItem.UnitPrice),
+		}
+		if discount := invoiceItem.DiscountAmount(); discount != 0 {
+			line.AllowanceCharges = []allowanceCharge{{ReasonCode: discountReason, Reason: "Discount", Amount: money(discount)}}
+			lineDiscounts += discount
+		}
+		doc.Lines = append(doc.Lines, line)
+		totalsFor(category).base += invoiceItem.NetAmount()
+	}
+
+	var charges []allowanceCharge
+	for _, charge := range inv.Charges {
+		category := categoryOf(fmt.Sprintf("charge %q", charge.Description), charge.Taxes)
+		charges = append(charges, allowanceCharge{ChargeIndicator: true, Reason: charge.Description, Amount: money(charge.Amount), TaxCategory: &category})
+		totalsFor(category).base += charge.Amount
+	}
+
+	if len(problems) > 0 {
+		return doc, problems
+	}
+
+	for _, tax := range inv.Taxes {
+		totals := totalsFor(vatCategory(tax.Rate))
+		totals.taxable += tax.TaxableAmount
+		totals.tax += tax.Amount
+	}
+
+	// The invoice discounts were spread over the taxed lines in proportion to their amounts, so a taxed category's
+	// share is whatever its taxable amount falls short of its lines and charges. Untaxed lines take the rest, or
+	// without any the rounding left over goes to the largest category, within the tolerance of BR-CO-17.
+	invoiceDiscount := inv.DiscountAmount - lineDiscounts
+	var allocated int64
+	var untaxedTotals, largest *categoryTotals
+	for _, totals := range categories {
+		if totals.category.ID == untaxed.ID {
+			untaxedTotals = totals
+			continue
+		}
+		totals.allowance = totals.base - totals.taxable
+		allocated += totals.allowance
+		if largest == nil || totals.base > largest.base {
+			largest = totals
+		}
+	}
+	switch {
+	case untaxedTotals != nil:
+		untaxedTotals.allowance = invoiceDiscount - allocated
+		untaxedTotals.taxable = untaxedTotals.base - untaxedTotals.allowance
+	case largest != nil:
+		largest.allowance += invoiceDiscount - allocated
+		largest.taxable -= invoiceDiscount - allocated
+	}
+
+	// Late fees are charged after the invoice is issued and carry no tax, so they go in the untaxed category after
+	// the invoice discounts have been spread over the rest
+	var lateFees []allowanceCharge
+	var lateFeeTotal int64
+	for _, fee := range inv.LateFees {
+		if fee.ReversedAt != nil {
+			continue
+		}
+		category := untaxed
+		lateFees = append(lateFees, allowanceCharge{ChargeIndicator: true, Reason: fee.Description, Amount: money(fee.Amount), TaxCategory: &category})
+		lateFeeTotal += fee.Amount
+		totals := totalsFor(untaxed)
+		totals.base += fee.Amount
+		totals.taxable += fee.Amount
+	}
+
+	for _, totals := range categories {
+		if totals.allowance != 0 {
+			category := totals.category
+			doc.AllowanceCharges = append(doc.AllowanceCharges, allowanceCharge{
+				ReasonCode:  discountReason,
+				Reason:      "Discount",
+				Amount:      money(totals.allowance),
+				TaxCategory: &category,
+			})
+		}
+	}
+	doc.AllowanceCharges = append(doc.AllowanceCharges, charges...)
+	doc.AllowanceCharges = append(doc.AllowanceCharges, lateFees...)
+
+	doc.TaxTotal.TaxAmount = money(inv.TaxTotal)
+	for _, totals := range categories {
+		category := totals.category
+		category.ExemptionReason = exemptionReasons[category.ID]
+		doc.TaxTotal.Subtotals = append(doc.TaxTotal.Subtotals, taxSubtotal{
+			TaxableAmount: money(totals.taxable),
+			TaxAmount:     money(totals.tax),
+			Category:      category,
+		})
+	}
+
+	lineTotal := inv.Subtotal - lineDiscounts
+	chargeTotal := inv.ChargeTotal + lateFeeTotal
+	taxExclusive := lineTotal - invoiceDiscount + chargeTotal
+	taxInclusive := taxExclusive + inv.TaxTotal
+	prepaid := inv.AmountPaid + inv.AmountCredited
+	doc.LegalMonetaryTotal = monetaryTotal{
+		LineExtensionAmount:  money(lineTotal),
+		TaxExclusiveAmount:   money(taxExclusive),
+		TaxInclusiveAmount:   money(taxInclusive),
+		AllowanceTotalAmount: optionalMoney(invoiceDiscount),
+		ChargeTotalAmount:    optionalMoney(chargeTotal),
+		PrepaidAmount:        optionalMoney(prepaid),
+		PayableAmount:        money(taxInclusive - prepaid),
+	}
+	if taxInclusive != inv.Total+inv.LateFeeTotal {
+		problems = append(problems, "the invoice total doesn't match its lines, discounts, charges, late fees and taxes")
+	}
+
+	if inv.AccountNumber != "" {
+		doc.PaymentMeans = &paymentMeans{
+			Code:      creditTransfer,
+			PaymentID: inv.InvoiceNumber,
+			Account:   &financialAccount{ID: inv.AccountNumber, Name: inv.AccountName},
+		}
+		if inv.RoutingNumber != "" {
+			doc.PaymentMeans.Account.Branch = &branch{ID: inv.RoutingNumber}
+		}
+	}
+
+	return doc, problems
+}
+
+func buildParty(p models.Party) party {
+	result := party{
+		EndpointID:    endpointID(p),
+		PostalAddress: postalAddress(p.Address, p.Country),
+		LegalName:     p.Name,
+	}
+	if p.Name != "" {
+		result.PartyName = &partyName{Name: p.Name}
+	}
+	if p.Email != "" {
+		result.Contact = &contact{Email: p.Email}
+	}
+	if p.TaxID != "" {
+		result.TaxScheme = &partyTaxScheme{CompanyID: p.TaxID, TaxScheme: vatScheme}
+	}
+	return result
+}
+
+// endpointID returns the address a party receives e-invoices at: its Peppol ID, or failing that its email address.
+func endpointID(p models.Party) identifier {
+	if scheme, id, ok := strings.Cut(p.PeppolID, ":"); ok {
+		return identifier{SchemeID: scheme, Value: id}
+	}
+	return identifier{SchemeID: emailScheme, Value: p.Email}
+}
+
+// postalAddress splits a free-form address over the address lines UBL has room for.
+func postalAddress(text, country string) address {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	result := address{Country: country}
+	if len(lines) > 0 {
+		result.StreetName = lines[0]
+	}
+	if len(lines) > 1 {
+		result.AdditionalStreetName = lines[1]
+	}
+	if len(lines) > 2 {
+		result.AddressLine = &addressLine{Line: strings.Join(lines[2:], ", ")}
+	}
+	return result
+}
+
+// vatCategory returns the VAT category of a tax rate in hundredths of a percent.
+func vatCategory(rate int64) taxCategory {
+	id := standardRated
+	if rate == 0 {
+		id = zeroRated
+	}
+	return taxCategory{ID: id, Percent: formatPercent(rate), TaxScheme: vatScheme, rate: rate}
+}
+
+// hasTaxes reports whether any line or charge of an invoice is taxed.
+func hasTaxes(inv *models.Invoice) bool {
+	for _, invoiceItem := range inv.Items {
+		if len(invoiceItem.Taxes) > 0 {
+			return true
+		}
+	}
+	for _, charge := range inv.Charges {
+		if len(charge.Taxes) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func formatPercent(hundredths int64) string {
+	return decimal.New(hundredths, -2).String()
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.DateOnly)
+}
@@ -0,0 +1,252 @@
+package ubl_test
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service/ubl"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+var (
+	issuer = models.Party{
+		Name:     "Acme Studio GmbH",
+		Email:    "billing@acme.test",
+		Address:  "Hafenstraße 12\n20457 Hamburg",
+		Country:  "DE",
+		TaxID:    "DE123456789",
+		PeppolID: "9930:DE123456789",
+	}
+	customer = models.Party{
+		Name:     "Stadt Musterstadt",
+		Email:    "rechnungen@musterstadt.test",
+		Address:  "Rathausplatz 1\n12345 Musterstadt\nBuchhaltung",
+		Country:  "DE",
+		PeppolID: "0204:991-12345-67",
+	}
+)
+
+func TestRenderInvoice(t *testing.T) {
+	tests := []struct {
+		name     string
+		invoice  *models.Invoice
+		customer models.Party
+	}{
+		{
+			name:     "standard_rated",
+			invoice:  standardRatedInvoice(),
+			customer: customer,
+		},
+		{
+			name:     "late_fees",
+			invoice:  lateFeeInvoice(),
+			customer: customer,
+		},
+		{
+			name:    "not_subject_to_vat",
+			invoice: untaxedInvoice(),
+			// Without a Peppol ID the invoice is addressed to the customer's email
+			customer: models.Party{Name: "Jane Doe", Email: "jane@example.test", Country: "AT", TaxID: "ATU12345678"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ubl.RenderInvoice(tt.invoice, issuer, tt.customer)
+			require.NoError(t, err)
+			assertWellFormed(t, got)
+
+			golden := filepath.Join("testdata", tt.name+".golden.xml")
+			if *update {
+				require.NoError(t, os.WriteFile(golden, got, 0o644))
+			}
+			want, err := os.ReadFile(golden)
+			require.NoError(t, err)
+			assert.Equal(t, string(want), string(got), "rendered XML differs from %s, run the tests with -update to accept the change", golden)
+		})
+	}
+}
+
+func TestRenderInvoice_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		invoice  func() *models.Invoice
+		issuer   models.Party
+		customer models.Party
+		want     []string
+	}{
+		{
+			name:     "missing party details",
+			invoice:  standardRatedInvoice,
+			issuer:   models.Party{Email: "billing@acme.test", TaxID: "123456789"},
+			customer: models.Party{Name: "Stadt Musterstadt", Country: "Germany"},
+			want: []string{
+				"the seller name is required (BR-06)",
+				"the seller country is required (BR-09)",
+				`the seller VAT identifier "123456789" must start with a country code (BR-CO-09)`,
+				`the buyer country "Germany" is not an ISO 3166-1 alpha-2 code (BR-11)`,
+				"the buyer needs a Peppol ID or an email address (PEPPOL-EN16931-R010)",
+			},
+		},
+		{
+			name:     "missing seller VAT identifier",
+			invoice:  standardRatedInvoice,
+			issuer:   models.Party{Name: "Acme Studio GmbH", Email: "billing@acme.test", Country: "DE"},
+			customer: customer,
+			want:     []string{"the seller VAT identifier is required (BR-S-02)"},
+		},
+		{
+			name: "compound tax",
+			invoice: func() *models.Invoice {
+				invoice := standardRatedInvoice()
+				invoice.Items[2].Taxes = []*models.TaxRate{{ID: 3, Name: "Levy", Rate: 100, Compound: true}}
+				return invoice
+			},
+			issuer:   issuer,
+			customer: customer,
+			want:     []string{`line 3 has compound tax "Levy", which UBL can't express`},
+		},
+		{
+			name: "inclusive and several taxes",
+			invoice: func() *models.Invoice {
+				invoice := standardRatedInvoice()
+				invoice.Items[0].Taxes = append(invoice.Items[0].Taxes, &models.TaxRate{ID: 3, Name: "Levy", Rate: 100})
+				invoice.Charges[0].Taxes = []*models.TaxRate{{ID: 4, Name: "VAT incl.", Rate: 1900, Inclusive: true}}
+				return invoice
+			},
+			issuer:   issuer,
+			customer: customer,
+			want: []string{
+				"line 1 has more than one tax, but UBL allows a single VAT rate",
+				`charge "Shipping" has tax "VAT incl." included in its price, but UBL prices exclude VAT`,
+			},
+		},
+		{
+			name: "amounts that don't add up",
+			invoice: func() *models.Invoice {
+				invoice := untaxedInvoice()
+				invoice.Items[0].Description = ""
+				invoice.Subtotal += 100
+				invoice.Total += 100
+				return invoice
+			},
+			issuer:   issuer,
+			customer: customer,
+			want: []string{
+				"line 1 needs a description (BR-25)",
+				"the line total doesn't match the sum of the lines (BR-CO-10)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ubl.RenderInvoice(tt.invoice(), tt.issuer, tt.customer)
+
+			var validationErr *ubl.ValidationError
+			require.True(t, errors.As(err, &validationErr), "got %v", err)
+			assert.Equal(t, tt.want, validationErr.Problems)
+		})
+	}
+}
+
+// assertWellFormed checks that a document parses as XML and is a UBL invoice.
+func assertWellFormed(t *testing.T, doc []byte) {
+	t.Helper()
+
+	decoder := xml.NewDecoder(bytes.NewReader(doc))
+	var root *xml.StartElement
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if start, ok := token.(xml.StartElement); ok && root == nil {
+			root = &start
+		}
+	}
+	require.NotNil(t, root)
+	assert.Equal(t, xml.Name{Space: "urn:oasis:names:specification:ubl:schema:xsd:Invoice-2", Local: "Invoice"}, root.Name)
+}
+
+// standardRatedInvoice is an invoice at two VAT rates, with line and invoice discounts and a taxed charge. Its
+// amounts are those the invoice service calculates.
+func standardRatedInvoice() *models.Invoice {
+	vat := &models.TaxRate{ID: 1, Name: "MwSt.", Rate: 1900}
+	reduced := &models.TaxRate{ID: 2, Name: "MwSt. ermäßigt", Rate: 700}
+
+	return &models.Invoice{
+		ID:            1,
+		InvoiceNumber: "INV-000042",
+		Status:        models.StatusPartiallyPaid,
+		IssueDate:     time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		DueDate:       time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC),
+		Currency:      "EUR",
+		Items: []*models.InvoiceItem{
+			{Description: "Website redesign", Quantity: 1, UnitPrice: 250000, Taxes: []*models.TaxRate{vat}},
+			{Description: "Hosting (monthly)", Quantity: 3, UnitPrice: 1999, DiscountPercentage: 1000, Taxes: []*models.TaxRate{vat}},
+			{Description: "Printed brochures", Quantity: 100, UnitPrice: 150, Taxes: []*models.TaxRate{reduced}},
+		},
+		DiscountPercentage: 500,
+		Charges:            []*models.InvoiceCharge{{Description: "Shipping", Amount: 1500, Taxes: []*models.TaxRate{vat}}},
+		Subtotal:           270997,
+		DiscountAmount:     14120,
+		ChargeTotal:        1500,
+		Taxes: []*models.InvoiceTax{
+			{TaxRateID: 1, Name: "MwSt.", Rate: 1900, TaxableAmount: 244127, Amount: 46384},
+			{TaxRateID: 2, Name: "MwSt. ermäßigt", Rate: 700, TaxableAmount: 14250, Amount: 997},
+		},
+		TaxTotal:      47381,
+		Total:         305758,
+		AmountPaid:    50000,
+		AccountName:   "Acme Studio GmbH",
+		AccountNumber: "DE89370400440532013000",
+		RoutingNumber: "COBADEFFXXX",
+		Note:          "Thank you for your business.",
+	}
+}
+
+// lateFeeInvoice is standardRatedInvoice gone overdue, with a late fee and a reversed interest charge.
+func lateFeeInvoice() *models.Invoice {
+	reversedAt := time.Date(2024, time.April, 20, 0, 0, 0, 0, time.UTC)
+
+	invoice := standardRatedInvoice()
+	invoice.Status = models.StatusOverdue
+	invoice.LateFees = []*models.LateFee{
+		{ID: 1, Kind: models.LateFeeFlat, Description: "Late fee", Amount: 2500},
+		{ID: 2, Kind: models.LateFeeInterest, Description: "Interest", Amount: 1200, ReversedAt: &reversedAt},
+	}
+	invoice.LateFeeTotal = 2500
+	return invoice
+}
+
+func untaxedInvoice() *models.Invoice {
+	return &models.Invoice{
+		ID:            2,
+		InvoiceNumber: "INV-000043",
+		Status:        models.StatusUnpaid,
+		IssueDate:     time.Date(2024, time.April, 2, 0, 0, 0, 0, time.UTC),
+		DueDate:       time.Date(2024, time.May, 2, 0, 0, 0, 0, time.UTC),
+		Currency:      "EUR",
+		Items: []*models.InvoiceItem{
+			{Description: "Logo design", Quantity: 1, UnitPrice: 80000},
+			{Description: "Business cards", Quantity: 2, UnitPrice: 4500, FixedDiscount: 500},
+		},
+		FixedDiscount:  1000,
+		Subtotal:       89000,
+		DiscountAmount: 1500,
+		Total:          87500,
+	}
+}
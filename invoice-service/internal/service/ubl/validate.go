@@ -0,0 +1,205 @@
+package ubl
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/shopspring/decimal"
+)
+
+// vatTolerance is how far, in cents, a VAT category's tax may be from its taxable amount times its rate (BR-CO-17).
+const vatTolerance = 100
+
+// ValidationError lists why an invoice can't be rendered as a valid Peppol BIS Billing 3.0 invoice.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "the invoice can't be exported as a Peppol invoice: " + strings.Join(e.Problems, "; ")
+}
+
+// validate checks a document against the elements the UBL schema requires and the EN 16931 and Peppol business
+// rules that don't need the official validation artefacts: mandatory parties and identifiers, code lists, and that
+// the lines, allowances, charges, VAT breakdown and totals add up. Each problem names the rule it breaks.
+func (doc *invoice) validate() []string {
+	var problems []string
+	check := func(ok bool, rule, format string, args ...any) {
+		if !ok {
+			problems = append(problems, fmt.Sprintf(format, args...)+" ("+rule+")")
+		}
+	}
+
+	check(doc.ID != "", "BR-02", "the invoice number is required")
+	check(doc.IssueDate != "", "BR-03", "the issue date is required")
+	check(models.IsCurrencyCode(doc.DocumentCurrencyCode), "BR-05", "%q is not an ISO 4217 currency code", doc.DocumentCurrencyCode)
+	check(doc.BuyerReference != "", "PEPPOL-EN16931-R003", "a buyer reference is required")
+
+	checkParty := func(p party, role, nameRule, countryRule, endpointRule string) {
+		check(p.LegalName != "", nameRule, "the %s name is required", role)
+		if p.PostalAddress.Country == "" {
+			check(false, countryRule, "the %s country is required", role)
+		} else {
+			check(isCountryCode(p.PostalAddress.Country), countryRule, "the %s country %q is not an ISO 3166-1 alpha-2 code", role, p.PostalAddress.Country)
+		}
+		if p.EndpointID.Value == "" {
+			check(false, endpointRule, "the %s needs a Peppol ID or an email address", role)
+		} else {
+			check(isEndpointScheme(p.EndpointID.SchemeID), "PEPPOL-EN16931-CL008", "the %s Peppol ID scheme %q is not an electronic address scheme", role, p.EndpointID.SchemeID)
+		}
+		if p.TaxScheme != nil {
+			check(isCountryCode(prefix(p.TaxScheme.CompanyID, 2)), "BR-CO-09", "the %s VAT identifier %q must start with a country code", role, p.TaxScheme.CompanyID)
+		}
+	}
+	checkParty(doc.Supplier, "seller", "BR-06", "BR-09", "PEPPOL-EN16931-R020")
+	checkParty(doc.Customer, "buyer", "BR-07", "BR-11", "PEPPOL-EN16931-R010")
+
+	// Add up the amounts in each VAT category, which the VAT breakdown must match
+	type categoryKey struct {
+		id   string
+		rate int64
+	}
+	var used []categoryKey
+	bases := map[categoryKey]int64{}
+	addToCategory := func(category taxCategory, cents int64) {
+		key := categoryKey{category.ID, category.rate}
+		if _, ok := bases[key]; !ok {
+			used = append(used, key)
+		}
+		bases[key] += cents
+	}
+
+	check(len(doc.Lines) > 0, "BR-16", "the invoice needs at least one line")
+	var lineTotal int64
+	for _, line := range doc.Lines {
+		check(line.Item.Name != "", "BR-25", "line %s needs a description", line.ID)
+		check(line.Price.cents >= 0, "BR-27", "line %s has a negative price", line.ID)
+		var discount int64
+		for _, allowance := range line.AllowanceCharges {
+			discount += allowance.Amount.cents
+		}
+		check(line.LineExtensionAmount.cents == line.InvoicedQuantity.Value*line.Price.cents-discount, "PEPPOL-EN16931-R120",
+			"line %s amount is not its quantity times its price less its discount", line.ID)
+		lineTotal += line.LineExtensionAmount.cents
+		addToCategory(line.Item.Category, line.LineExtensionAmount.cents)
+	}
+
+	var allowanceTotal, chargeTotal int64
+	for _, ac := range doc.AllowanceCharges {
+		kind, categoryRule, reasonRule := "allowance", "BR-32", "BR-33"
+		if ac.ChargeIndicator {
+			kind, categoryRule, reasonRule = "charge", "BR-37", "BR-38"
+		}
+		check(ac.Reason != "" || ac.ReasonCode != "", reasonRule, "every %s needs a reason", kind)
+		if ac.TaxCategory == nil {
+			check(false, categoryRule, "every %s needs a VAT category", kind)
+			continue
+		}
+		if ac.ChargeIndicator {
+			chargeTotal += ac.Amount.cents
+			addToCategory(*ac.TaxCategory, ac.Amount.cents)
+		} else {
+			allowanceTotal += ac.Amount.cents
+			addToCategory(*ac.TaxCategory, -ac.Amount.cents)
+		}
+	}
+
+	total := doc.LegalMonetaryTotal
+	check(total.LineExtensionAmount.cents == lineTotal, "BR-CO-10", "the line total doesn't match the sum of the lines")
+	check(centsOf(total.AllowanceTotalAmount) == allowanceTotal, "BR-CO-11", "the allowance total doesn't match the sum of the allowances")
+	check(centsOf(total.ChargeTotalAmount) == chargeTotal, "BR-CO-12", "the charge total doesn't match the sum of the charges")
+	check(total.TaxExclusiveAmount.cents == total.LineExtensionAmount.cents-centsOf(total.AllowanceTotalAmount)+centsOf(total.ChargeTotalAmount),
+		"BR-CO-13", "the total without VAT doesn't match the line total less allowances plus charges")
+	check(total.TaxInclusiveAmount.cents == total.TaxExclusiveAmount.cents+doc.TaxTotal.TaxAmount.cents,
+		"BR-CO-15", "the total with VAT doesn't match the total without VAT plus the VAT")
+	check(total.PayableAmount.cents == total.TaxInclusiveAmount.cents-centsOf(total.PrepaidAmount),
+		"BR-CO-16", "the amount due doesn't match the total with VAT less the amount paid")
+	check(total.PayableAmount.cents <= 0 || doc.DueDate != "", "BR-CO-25", "a due date is required when an amount is due")
+
+	// Check the VAT breakdown
+	var taxTotal int64
+	broken := map[categoryKey]bool{}
+	for _, subtotal := range doc.TaxTotal.Subtotals {
+		category := subtotal.Category
+		key := categoryKey{category.ID, category.rate}
+		taxTotal += subtotal.TaxAmount.cents
+		check(!broken[key], "BR-CO-18", "VAT category %s at %s%% is broken down more than once", category.ID, category.Percent)
+		broken[key] = true
+
+		check(subtotal.TaxableAmount.cents == bases[key], "BR-"+category.ID+"-08",
+			"the taxable amount of VAT category %s doesn't match its lines, allowances and charges", category.ID)
+		switch category.ID {
+		case standardRated:
+			check(category.rate > 0, "BR-S-05", "standard rated VAT must have a rate above zero")
+			expected := decimal.NewFromInt(subtotal.TaxableAmount.cents).Mul(decimal.New(category.rate, -4)).Round(0).IntPart()
+			check(abs(subtotal.TaxAmount.cents-expected) <= vatTolerance, "BR-CO-17",
+				"the VAT at %s%% is not its taxable amount times its rate", category.Percent)
+		default:
+			check(subtotal.TaxAmount.cents == 0, "BR-"+category.ID+"-09", "VAT category %s must not charge tax", category.ID)
+		}
+		if category.ID == exempt || category.ID == outOfScope {
+			check(category.ExemptionReason != "", "BR-"+category.ID+"-10", "VAT category %s needs an exemption reason", category.ID)
+		}
+	}
+	check(taxTotal == doc.TaxTotal.TaxAmount.cents, "BR-CO-14", "the VAT total doesn't match the sum of the VAT breakdown")
+
+	sellerVATRule := ""
+	for _, key := range used {
+		check(broken[key], "BR-"+key.id+"-01", "VAT category %s used on the invoice is missing from the VAT breakdown", key.id)
+		if key.id == outOfScope {
+			check(len(used) == 1, "BR-O-11", "an invoice not subject to VAT can't have other VAT categories")
+			check(doc.Supplier.TaxScheme == nil && doc.Customer.TaxScheme == nil, "BR-O-02", "an invoice not subject to VAT can't name VAT identifiers")
+		} else if sellerVATRule == "" {
+			sellerVATRule = "BR-" + key.id + "-02"
+		}
+	}
+	if sellerVATRule != "" {
+		check(doc.Supplier.TaxScheme != nil, sellerVATRule, "the seller VAT identifier is required")
+	}
+
+	return problems
+}
+
+// isCountryCode reports whether code is shaped like an ISO 3166-1 alpha-2 code.
+func isCountryCode(code string) bool {
+	return len(code) == 2 && code[0] >= 'A' && code[0] <= 'Z' && code[1] >= 'A' && code[1] <= 'Z'
+}
+
+// isEndpointScheme reports whether scheme is shaped like a code from the Peppol electronic address scheme list:
+// four digits, or EM for email.
+func isEndpointScheme(scheme string) bool {
+	if scheme == emailScheme {
+		return true
+	}
+	if len(scheme) != 4 {
+		return false
+	}
+	for _, c := range scheme {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+func centsOf(a *amount) int64 {
+	if a == nil {
+		return 0
+	}
+	return a.cents
+}
+
+func prefix(s string, n int) string {
+	if len(s) < n {
+		return s
+	}
+	return s[:n]
+}
+
+func abs(n int64) int64 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
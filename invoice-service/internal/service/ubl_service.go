@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service/ubl"
+)
+
+// RenderInvoiceUBL renders one of the user's invoices as a Peppol BIS Billing 3.0 UBL invoice issued by issuer to
+// customer. Drafts and void invoices can't be exported, and invoices that would make an invalid e-invoice are
+// rejected as ErrInvalidRequest with every problem found.
+func (s *InvoiceService) RenderInvoiceUBL(ctx context.Context, invoiceID, userID int64, issuer, customer models.Party) ([]byte, *models.Invoice, error) {
+	invoice, err := s.GetInvoice(ctx, invoiceID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if invoice.UserID != userID {
+		return nil, nil, ErrNotFound
+	}
+	if invoice.Status == models.StatusDraft || invoice.Status == models.StatusVoid {
+		return nil, nil, fmt.Errorf("%w: %s invoices can't be exported as e-invoices", ErrInvalidRequest, invoice.Status)
+	}
+
+	doc, err := ubl.RenderInvoice(invoice, issuer, customer)
+	if err != nil {
+		var validationErr *ubl.ValidationError
+		if errors.As(err, &validationErr) {
+			return nil, nil, fmt.Errorf("%w: %v", ErrInvalidRequest, err)
+		}
+		return nil, nil, err
+	}
+	return doc, invoice, nil
+}
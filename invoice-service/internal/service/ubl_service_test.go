@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/internal/models"
+	"github.com/emzola/numer/invoice-service/internal/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func ublTestInvoice(status string) *models.Invoice {
+	return &models.Invoice{
+		ID:            1,
+		UserID:        7,
+		InvoiceNumber: "INV-000001",
+		Status:        status,
+		IssueDate:     time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+		DueDate:       time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC),
+		Currency:      "EUR",
+		Items:         []*models.InvoiceItem{{Description: "Consulting", Quantity: 2, UnitPrice: 5000}},
+		Subtotal:      10000,
+		Total:         10000,
+	}
+}
+
+func TestRenderInvoiceUBL(t *testing.T) {
+	mockRepo := new(MockInvoiceRepository)
+	svc := service.NewInvoiceService(mockRepo)
+
+	invoice := ublTestInvoice(models.StatusUnpaid)
+	mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(invoice, nil)
+
+	issuer := models.Party{Name: "Issuer", Email: "issuer@example.test", Country: "NL"}
+	customer := models.Party{Name: "Customer", Email: "customer@example.test", Country: "BE", PeppolID: "0208:0123456789"}
+	doc, got, err := svc.RenderInvoiceUBL(context.Background(), 1, 7, issuer, customer)
+
+	assert.NoError(t, err)
+	assert.Equal(t, invoice, got)
+	assert.True(t, bytes.Contains(doc, []byte(`<cbc:EndpointID schemeID="0208">0123456789</cbc:EndpointID>`)))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestRenderInvoiceUBL_Rejected(t *testing.T) {
+	valid := models.Party{Name: "Party", Email: "party@example.test", Country: "NL"}
+
+	tests := []struct {
+		name    string
+		invoice *models.Invoice
+		issuer  models.Party
+		wantErr error
+	}{
+		{"other user's invoice", &models.Invoice{ID: 1, UserID: 8}, valid, service.ErrNotFound},
+		{"draft", ublTestInvoice(models.StatusDraft), valid, service.ErrInvalidRequest},
+		{"void", ublTestInvoice(models.StatusVoid), valid, service.ErrInvalidRequest},
+		{"issuer without a country", ublTestInvoice(models.StatusUnpaid), models.Party{Name: "Party", Email: "party@example.test"}, service.ErrInvalidRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockInvoiceRepository)
+			svc := service.NewInvoiceService(mockRepo)
+			mockRepo.On("GetInvoiceByID", mock.Anything, int64(1)).Return(tt.invoice, nil)
+
+			_, _, err := svc.RenderInvoiceUBL(context.Background(), 1, 7, tt.issuer, valid)
+
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
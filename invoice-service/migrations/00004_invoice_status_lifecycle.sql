@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE invoices DROP CONSTRAINT IF EXISTS invoices_status_check;
+ALTER TABLE invoices ADD CONSTRAINT invoices_status_check
+    CHECK (status IN ('draft', 'unpaid', 'partially_paid', 'paid', 'overdue', 'void'));
+
+-- +goose Down
+ALTER TABLE invoices DROP CONSTRAINT IF EXISTS invoices_status_check;
+ALTER TABLE invoices ADD CONSTRAINT invoices_status_check
+    CHECK (status IN ('draft', 'paid', 'overdue', 'unpaid'));
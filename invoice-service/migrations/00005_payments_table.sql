@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS amount_paid INT NOT NULL DEFAULT 0;
+
+CREATE TABLE IF NOT EXISTS payments (
+    id SERIAL PRIMARY KEY,
+    invoice_id BIGINT NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+    amount INT NOT NULL CHECK (amount > 0),
+    method VARCHAR(20) NOT NULL CHECK (method IN ('bank_transfer', 'card', 'cash', 'cheque', 'other')),
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    payment_date TIMESTAMPTZ NOT NULL,
+    refunded_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS payments_invoice_id_idx ON payments (invoice_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS payments;
+ALTER TABLE invoices DROP COLUMN IF EXISTS amount_paid;
@@ -0,0 +1,5 @@
+-- +goose Up
+CREATE INDEX IF NOT EXISTS invoices_status_due_date_idx ON invoices (status, due_date);
+
+-- +goose Down
+DROP INDEX IF EXISTS invoices_status_due_date_idx;
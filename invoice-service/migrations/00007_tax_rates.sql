@@ -0,0 +1,51 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS tax_rates (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    rate INT NOT NULL CHECK (rate >= 0 AND rate <= 10000),
+    inclusive BOOLEAN NOT NULL DEFAULT FALSE,
+    compound BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    CHECK (NOT (inclusive AND compound))
+);
+
+CREATE INDEX IF NOT EXISTS tax_rates_user_id_idx ON tax_rates (user_id);
+
+-- Taxes applied to each invoice item, copied from tax_rates so later edits don't change issued invoices
+CREATE TABLE IF NOT EXISTS invoice_item_taxes (
+    id SERIAL PRIMARY KEY,
+    invoice_item_id BIGINT NOT NULL REFERENCES invoice_items(id) ON DELETE CASCADE,
+    tax_rate_id BIGINT NOT NULL,
+    position INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    rate INT NOT NULL,
+    inclusive BOOLEAN NOT NULL,
+    compound BOOLEAN NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS invoice_item_taxes_invoice_item_id_idx ON invoice_item_taxes (invoice_item_id);
+
+-- Per-rate tax breakdown of each invoice
+CREATE TABLE IF NOT EXISTS invoice_taxes (
+    id SERIAL PRIMARY KEY,
+    invoice_id BIGINT NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+    tax_rate_id BIGINT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    rate INT NOT NULL,
+    inclusive BOOLEAN NOT NULL,
+    compound BOOLEAN NOT NULL,
+    taxable_amount INT NOT NULL,
+    amount INT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS invoice_taxes_invoice_id_idx ON invoice_taxes (invoice_id);
+
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS tax_total INT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE invoices DROP COLUMN IF EXISTS tax_total;
+DROP TABLE IF EXISTS invoice_taxes;
+DROP TABLE IF EXISTS invoice_item_taxes;
+DROP TABLE IF EXISTS tax_rates;
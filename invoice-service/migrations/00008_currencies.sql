@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS currency_settings (
+    user_id BIGINT PRIMARY KEY,
+    base_currency VARCHAR(3) NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Units of base_currency per unit of currency, in force from effective_date
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    base_currency VARCHAR(3) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    rate NUMERIC(20, 10) NOT NULL CHECK (rate > 0),
+    effective_date DATE NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (user_id, base_currency, currency, effective_date)
+);
+
+-- The base currency and rate in force when the invoice was issued; empty and zero while a draft
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS base_currency VARCHAR(3) NOT NULL DEFAULT '';
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS exchange_rate NUMERIC(20, 10) NOT NULL DEFAULT 0;
+
+UPDATE invoices SET currency = UPPER(currency);
+
+-- +goose Down
+ALTER TABLE invoices DROP COLUMN IF EXISTS exchange_rate;
+ALTER TABLE invoices DROP COLUMN IF EXISTS base_currency;
+DROP TABLE IF EXISTS exchange_rates;
+DROP TABLE IF EXISTS currency_settings;
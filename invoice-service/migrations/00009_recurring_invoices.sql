@@ -0,0 +1,57 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS recurring_invoices (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    customer_id BIGINT NOT NULL,
+    customer_email VARCHAR(255) NOT NULL DEFAULT '',
+    currency VARCHAR(3) NOT NULL,
+    discount_percentage INT NOT NULL DEFAULT 0,
+    account_name VARCHAR(255) NOT NULL DEFAULT '',
+    account_number VARCHAR(255) NOT NULL DEFAULT '',
+    bank_name VARCHAR(255) NOT NULL DEFAULT '',
+    routing_number VARCHAR(255) NOT NULL DEFAULT '',
+    note TEXT NOT NULL DEFAULT '',
+    payment_terms_days INT NOT NULL DEFAULT 0 CHECK (payment_terms_days >= 0),
+    frequency VARCHAR(20) NOT NULL CHECK (frequency IN ('weekly', 'monthly', 'quarterly', 'yearly', 'custom')),
+    interval_days INT NOT NULL DEFAULT 0 CHECK (interval_days >= 0),
+    start_date DATE NOT NULL,
+    end_date DATE,
+    max_occurrences INT NOT NULL DEFAULT 0 CHECK (max_occurrences >= 0),
+    occurrences INT NOT NULL DEFAULT 0,
+    next_run_date DATE NOT NULL,
+    auto_send BOOLEAN NOT NULL DEFAULT FALSE,
+    status VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (status IN ('active', 'paused', 'cancelled', 'completed')),
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS recurring_invoices_user_id_idx ON recurring_invoices (user_id);
+CREATE INDEX IF NOT EXISTS recurring_invoices_status_next_run_date_idx ON recurring_invoices (status, next_run_date);
+
+CREATE TABLE IF NOT EXISTS recurring_invoice_items (
+    id SERIAL PRIMARY KEY,
+    recurring_invoice_id BIGINT NOT NULL REFERENCES recurring_invoices(id) ON DELETE CASCADE,
+    description TEXT NOT NULL,
+    quantity INT NOT NULL,
+    unit_price INT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS recurring_invoice_items_recurring_invoice_id_idx ON recurring_invoice_items (recurring_invoice_id);
+
+CREATE TABLE IF NOT EXISTS recurring_invoice_item_taxes (
+    recurring_invoice_item_id BIGINT NOT NULL REFERENCES recurring_invoice_items(id) ON DELETE CASCADE,
+    tax_rate_id BIGINT NOT NULL,
+    position INT NOT NULL,
+    PRIMARY KEY (recurring_invoice_item_id, position)
+);
+
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS recurring_invoice_id BIGINT REFERENCES recurring_invoices(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS invoices_recurring_invoice_id_idx ON invoices (recurring_invoice_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS invoices_recurring_invoice_id_idx;
+ALTER TABLE invoices DROP COLUMN IF EXISTS recurring_invoice_id;
+DROP TABLE IF EXISTS recurring_invoice_item_taxes;
+DROP TABLE IF EXISTS recurring_invoice_items;
+DROP TABLE IF EXISTS recurring_invoices;
@@ -0,0 +1,102 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS credit_note_number_sequence (
+    id SERIAL PRIMARY KEY,
+    current_value INT NOT NULL
+);
+
+INSERT INTO credit_note_number_sequence (current_value) VALUES (100000);
+
+CREATE TABLE IF NOT EXISTS credit_notes (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    invoice_id BIGINT NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+    customer_id BIGINT NOT NULL,
+    credit_note_number VARCHAR(50) UNIQUE NOT NULL,
+    issue_date TIMESTAMPTZ NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    discount_percentage INT NOT NULL DEFAULT 0,
+    subtotal INT NOT NULL,
+    discount_amount INT NOT NULL,
+    tax_total INT NOT NULL,
+    total INT NOT NULL CHECK (total > 0),
+    amount_applied INT NOT NULL DEFAULT 0,
+    amount_refunded INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    CHECK (amount_applied + amount_refunded <= total)
+);
+
+CREATE INDEX IF NOT EXISTS credit_notes_user_id_idx ON credit_notes (user_id);
+CREATE INDEX IF NOT EXISTS credit_notes_invoice_id_idx ON credit_notes (invoice_id);
+
+-- Lines of each credit note, each crediting some quantity of a line of the original invoice
+CREATE TABLE IF NOT EXISTS credit_note_items (
+    id SERIAL PRIMARY KEY,
+    credit_note_id BIGINT NOT NULL REFERENCES credit_notes(id) ON DELETE CASCADE,
+    invoice_item_id BIGINT NOT NULL,
+    description TEXT NOT NULL,
+    quantity INT NOT NULL CHECK (quantity > 0),
+    unit_price INT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS credit_note_items_credit_note_id_idx ON credit_note_items (credit_note_id);
+
+-- Taxes applied to each credit note item, copied from the original invoice item
+CREATE TABLE IF NOT EXISTS credit_note_item_taxes (
+    id SERIAL PRIMARY KEY,
+    credit_note_item_id BIGINT NOT NULL REFERENCES credit_note_items(id) ON DELETE CASCADE,
+    tax_rate_id BIGINT NOT NULL,
+    position INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    rate INT NOT NULL,
+    inclusive BOOLEAN NOT NULL,
+    compound BOOLEAN NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS credit_note_item_taxes_credit_note_item_id_idx ON credit_note_item_taxes (credit_note_item_id);
+
+-- Per-rate tax breakdown of each credit note
+CREATE TABLE IF NOT EXISTS credit_note_taxes (
+    id SERIAL PRIMARY KEY,
+    credit_note_id BIGINT NOT NULL REFERENCES credit_notes(id) ON DELETE CASCADE,
+    tax_rate_id BIGINT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    rate INT NOT NULL,
+    inclusive BOOLEAN NOT NULL,
+    compound BOOLEAN NOT NULL,
+    taxable_amount INT NOT NULL,
+    amount INT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS credit_note_taxes_credit_note_id_idx ON credit_note_taxes (credit_note_id);
+
+-- Credit applied to an invoice's balance, or refunded to the customer
+CREATE TABLE IF NOT EXISTS credit_note_allocations (
+    id SERIAL PRIMARY KEY,
+    credit_note_id BIGINT NOT NULL REFERENCES credit_notes(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('invoice', 'refund')),
+    invoice_id BIGINT REFERENCES invoices(id) ON DELETE CASCADE,
+    amount INT NOT NULL CHECK (amount > 0),
+    method VARCHAR(20) NOT NULL DEFAULT '',
+    reference VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    CHECK ((kind = 'invoice') = (invoice_id IS NOT NULL))
+);
+
+CREATE INDEX IF NOT EXISTS credit_note_allocations_credit_note_id_idx ON credit_note_allocations (credit_note_id);
+
+-- credit_note_total is the total of the credit notes issued against an invoice; amount_credited is the
+-- credit applied to its balance, from its own credit notes or others
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS amount_credited INT NOT NULL DEFAULT 0;
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS credit_note_total INT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE invoices DROP COLUMN IF EXISTS credit_note_total;
+ALTER TABLE invoices DROP COLUMN IF EXISTS amount_credited;
+DROP TABLE IF EXISTS credit_note_allocations;
+DROP TABLE IF EXISTS credit_note_taxes;
+DROP TABLE IF EXISTS credit_note_item_taxes;
+DROP TABLE IF EXISTS credit_note_items;
+DROP TABLE IF EXISTS credit_notes;
+DROP TABLE IF EXISTS credit_note_number_sequence;
@@ -0,0 +1,41 @@
+-- +goose Up
+-- A user's invoice number format; users without a row get the default format
+CREATE TABLE IF NOT EXISTS invoice_numbering_schemes (
+    user_id BIGINT PRIMARY KEY,
+    format VARCHAR(32) NOT NULL,
+    yearly_reset BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Each user's last allocated sequence number, per year when the sequence resets yearly and under year 0 otherwise
+CREATE TABLE IF NOT EXISTS invoice_number_counters (
+    user_id BIGINT NOT NULL,
+    year INT NOT NULL,
+    current_value BIGINT NOT NULL,
+    PRIMARY KEY (user_id, year)
+);
+
+-- Carry on from the highest number each user already has under the old global sequence
+INSERT INTO invoice_number_counters (user_id, year, current_value)
+SELECT user_id, 0, MAX(invoice_number::BIGINT)
+FROM invoices
+WHERE invoice_number ~ '^[0-9]{1,18}$'
+GROUP BY user_id;
+
+ALTER TABLE invoices ALTER COLUMN invoice_number TYPE VARCHAR(64);
+CREATE UNIQUE INDEX IF NOT EXISTS invoices_user_id_invoice_number_idx ON invoices (user_id, invoice_number);
+
+DROP TABLE IF EXISTS invoice_number_sequence;
+
+-- +goose Down
+CREATE TABLE IF NOT EXISTS invoice_number_sequence (
+    id SERIAL PRIMARY KEY,
+    current_value INT NOT NULL
+);
+INSERT INTO invoice_number_sequence (current_value)
+SELECT GREATEST(100000, COALESCE(MAX(current_value), 0)) FROM invoice_number_counters WHERE year = 0;
+
+DROP INDEX IF EXISTS invoices_user_id_invoice_number_idx;
+ALTER TABLE invoices ALTER COLUMN invoice_number TYPE VARCHAR(20);
+DROP TABLE IF EXISTS invoice_number_counters;
+DROP TABLE IF EXISTS invoice_numbering_schemes;
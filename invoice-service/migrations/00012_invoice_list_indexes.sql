@@ -0,0 +1,12 @@
+-- +goose Up
+-- Keyset pagination walks these in (sort column, id) order for a single user
+CREATE INDEX IF NOT EXISTS invoices_user_id_issue_date_idx ON invoices (user_id, issue_date, id);
+CREATE INDEX IF NOT EXISTS invoices_user_id_due_date_idx ON invoices (user_id, due_date, id);
+CREATE INDEX IF NOT EXISTS invoices_user_id_created_at_idx ON invoices (user_id, created_at, id);
+CREATE INDEX IF NOT EXISTS invoices_user_id_total_idx ON invoices (user_id, total, id);
+
+-- +goose Down
+DROP INDEX IF EXISTS invoices_user_id_total_idx;
+DROP INDEX IF EXISTS invoices_user_id_created_at_idx;
+DROP INDEX IF EXISTS invoices_user_id_due_date_idx;
+DROP INDEX IF EXISTS invoices_user_id_issue_date_idx;
@@ -0,0 +1,6 @@
+-- +goose Up
+-- Incremented on every change to an invoice so updates can detect that it moved underneath them
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE invoices DROP COLUMN IF EXISTS version;
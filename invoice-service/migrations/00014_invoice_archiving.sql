@@ -0,0 +1,6 @@
+-- +goose Up
+-- Archived invoices are hidden from default listings but otherwise kept as they are
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS archived_at TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE invoices DROP COLUMN IF EXISTS archived_at;
@@ -0,0 +1,85 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS quotes (
+    id SERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    customer_id BIGINT NOT NULL,
+    quote_number VARCHAR(64) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'sent', 'accepted', 'declined', 'expired')),
+    issue_date TIMESTAMPTZ NOT NULL,
+    expiry_date TIMESTAMPTZ NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    discount_percentage INT NOT NULL DEFAULT 0,
+    subtotal INT NOT NULL,
+    discount_amount INT NOT NULL,
+    tax_total INT NOT NULL,
+    total INT NOT NULL,
+    payment_terms_days INT NOT NULL DEFAULT 0 CHECK (payment_terms_days >= 0),
+    note TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ DEFAULT NOW(),
+    updated_at TIMESTAMPTZ DEFAULT NOW(),
+    UNIQUE (user_id, quote_number),
+    CHECK (expiry_date >= issue_date)
+);
+
+CREATE INDEX IF NOT EXISTS quotes_user_id_idx ON quotes (user_id, id);
+CREATE INDEX IF NOT EXISTS quotes_status_expiry_date_idx ON quotes (status, expiry_date);
+
+-- Quotes are numbered from a series of their own, one per user
+CREATE TABLE IF NOT EXISTS quote_number_counters (
+    user_id BIGINT PRIMARY KEY,
+    current_value BIGINT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS quote_items (
+    id SERIAL PRIMARY KEY,
+    quote_id BIGINT NOT NULL REFERENCES quotes(id) ON DELETE CASCADE,
+    description TEXT NOT NULL,
+    quantity INT NOT NULL,
+    unit_price INT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS quote_items_quote_id_idx ON quote_items (quote_id);
+
+-- Taxes applied to each quote item, copied from tax_rates so the quoted amounts hold when it is converted
+CREATE TABLE IF NOT EXISTS quote_item_taxes (
+    id SERIAL PRIMARY KEY,
+    quote_item_id BIGINT NOT NULL REFERENCES quote_items(id) ON DELETE CASCADE,
+    tax_rate_id BIGINT NOT NULL,
+    position INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    rate INT NOT NULL,
+    inclusive BOOLEAN NOT NULL,
+    compound BOOLEAN NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS quote_item_taxes_quote_item_id_idx ON quote_item_taxes (quote_item_id);
+
+-- Per-rate tax breakdown of each quote
+CREATE TABLE IF NOT EXISTS quote_taxes (
+    id SERIAL PRIMARY KEY,
+    quote_id BIGINT NOT NULL REFERENCES quotes(id) ON DELETE CASCADE,
+    tax_rate_id BIGINT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    rate INT NOT NULL,
+    inclusive BOOLEAN NOT NULL,
+    compound BOOLEAN NOT NULL,
+    taxable_amount INT NOT NULL,
+    amount INT NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS quote_taxes_quote_id_idx ON quote_taxes (quote_id);
+
+-- The invoice a quote was converted into. A quote converts into one invoice at most; deleting that
+-- invoice while it is still a draft frees the quote to be converted again.
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS quote_id BIGINT REFERENCES quotes(id) ON DELETE SET NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS invoices_quote_id_idx ON invoices (quote_id);
+
+-- +goose Down
+DROP INDEX IF EXISTS invoices_quote_id_idx;
+ALTER TABLE invoices DROP COLUMN IF EXISTS quote_id;
+DROP TABLE IF EXISTS quote_taxes;
+DROP TABLE IF EXISTS quote_item_taxes;
+DROP TABLE IF EXISTS quote_items;
+DROP TABLE IF EXISTS quote_number_counters;
+DROP TABLE IF EXISTS quotes;
@@ -0,0 +1,51 @@
+-- +goose Up
+-- Line discounts, taken before the invoice discounts. Quotes and recurring invoices carry them over to invoices.
+ALTER TABLE invoice_items
+    ADD COLUMN IF NOT EXISTS discount_percentage INT NOT NULL DEFAULT 0 CHECK (discount_percentage >= 0 AND discount_percentage <= 10000),
+    ADD COLUMN IF NOT EXISTS fixed_discount INT NOT NULL DEFAULT 0 CHECK (fixed_discount >= 0);
+ALTER TABLE quote_items
+    ADD COLUMN IF NOT EXISTS discount_percentage INT NOT NULL DEFAULT 0 CHECK (discount_percentage >= 0 AND discount_percentage <= 10000),
+    ADD COLUMN IF NOT EXISTS fixed_discount INT NOT NULL DEFAULT 0 CHECK (fixed_discount >= 0);
+ALTER TABLE recurring_invoice_items
+    ADD COLUMN IF NOT EXISTS discount_percentage INT NOT NULL DEFAULT 0 CHECK (discount_percentage >= 0 AND discount_percentage <= 10000),
+    ADD COLUMN IF NOT EXISTS fixed_discount INT NOT NULL DEFAULT 0 CHECK (fixed_discount >= 0);
+
+-- The share of the invoice line's discount each credit note line credits
+ALTER TABLE credit_note_items ADD COLUMN IF NOT EXISTS discount_amount INT NOT NULL DEFAULT 0;
+
+ALTER TABLE invoices
+    ADD COLUMN IF NOT EXISTS fixed_discount INT NOT NULL DEFAULT 0 CHECK (fixed_discount >= 0),
+    ADD COLUMN IF NOT EXISTS charge_total INT NOT NULL DEFAULT 0;
+
+-- Extra charges such as shipping, added after the discounts
+CREATE TABLE IF NOT EXISTS invoice_charges (
+    id SERIAL PRIMARY KEY,
+    invoice_id BIGINT NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+    description TEXT NOT NULL,
+    amount INT NOT NULL CHECK (amount > 0)
+);
+
+CREATE INDEX IF NOT EXISTS invoice_charges_invoice_id_idx ON invoice_charges (invoice_id);
+
+-- Taxes applied to each charge, copied from tax_rates like invoice_item_taxes
+CREATE TABLE IF NOT EXISTS invoice_charge_taxes (
+    id SERIAL PRIMARY KEY,
+    invoice_charge_id BIGINT NOT NULL REFERENCES invoice_charges(id) ON DELETE CASCADE,
+    tax_rate_id BIGINT NOT NULL,
+    position INT NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    rate INT NOT NULL,
+    inclusive BOOLEAN NOT NULL,
+    compound BOOLEAN NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS invoice_charge_taxes_invoice_charge_id_idx ON invoice_charge_taxes (invoice_charge_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS invoice_charge_taxes;
+DROP TABLE IF EXISTS invoice_charges;
+ALTER TABLE invoices DROP COLUMN IF EXISTS charge_total, DROP COLUMN IF EXISTS fixed_discount;
+ALTER TABLE credit_note_items DROP COLUMN IF EXISTS discount_amount;
+ALTER TABLE recurring_invoice_items DROP COLUMN IF EXISTS fixed_discount, DROP COLUMN IF EXISTS discount_percentage;
+ALTER TABLE quote_items DROP COLUMN IF EXISTS fixed_discount, DROP COLUMN IF EXISTS discount_percentage;
+ALTER TABLE invoice_items DROP COLUMN IF EXISTS fixed_discount, DROP COLUMN IF EXISTS discount_percentage;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Share links let customers view an invoice without signing in. The link token is signed rather than stored,
+-- so a row only records when the link expires and whether it was revoked.
+CREATE TABLE IF NOT EXISTS invoice_shares (
+    id SERIAL PRIMARY KEY,
+    invoice_id BIGINT NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    revoked_at TIMESTAMPTZ,
+    view_count INT NOT NULL DEFAULT 0,
+    last_viewed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS invoice_shares_invoice_id_idx ON invoice_shares (invoice_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS invoice_shares;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- Attachment contents live in the blob store under storage_key; the table keeps their metadata and checksum
+CREATE TABLE IF NOT EXISTS invoice_attachments (
+    id SERIAL PRIMARY KEY,
+    invoice_id BIGINT NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    filename VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL CHECK (size > 0),
+    checksum VARCHAR(64) NOT NULL, -- Hex-encoded SHA-256 of the contents
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS invoice_attachments_invoice_id_idx ON invoice_attachments (invoice_id);
+
+-- +goose Down
+DROP TABLE IF EXISTS invoice_attachments;
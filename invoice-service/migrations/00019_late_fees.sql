@@ -0,0 +1,39 @@
+-- +goose Up
+-- A user's late fee policy; users without a row are never charged late fees
+CREATE TABLE IF NOT EXISTS late_fee_policies (
+    user_id BIGINT PRIMARY KEY,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('flat', 'percentage', 'interest')),
+    amount INT NOT NULL DEFAULT 0 CHECK (amount >= 0),
+    rate INT NOT NULL DEFAULT 0 CHECK (rate >= 0),
+    grace_days INT NOT NULL DEFAULT 0 CHECK (grace_days >= 0),
+    cap INT NOT NULL DEFAULT 0 CHECK (cap >= 0),
+    updated_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+-- Active late fees add to the balance due; the day fees were last assessed keeps the daily run to one pass per invoice
+ALTER TABLE invoices
+    ADD COLUMN IF NOT EXISTS late_fee_total INT NOT NULL DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS late_fees_assessed_on DATE;
+
+-- Late fees and interest charged on overdue invoices, kept apart from the invoice's items and charges
+CREATE TABLE IF NOT EXISTS invoice_late_fees (
+    id SERIAL PRIMARY KEY,
+    invoice_id BIGINT NOT NULL REFERENCES invoices(id) ON DELETE CASCADE,
+    user_id BIGINT NOT NULL,
+    kind VARCHAR(20) NOT NULL,
+    description TEXT NOT NULL,
+    amount INT NOT NULL CHECK (amount > 0),
+    period_start DATE NOT NULL,
+    period_end DATE NOT NULL,
+    reversed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS invoice_late_fees_invoice_id_idx ON invoice_late_fees (invoice_id);
+CREATE INDEX IF NOT EXISTS invoices_overdue_due_date_idx ON invoices (due_date) WHERE status = 'overdue';
+
+-- +goose Down
+DROP INDEX IF EXISTS invoices_overdue_due_date_idx;
+DROP TABLE IF EXISTS invoice_late_fees;
+ALTER TABLE invoices DROP COLUMN IF EXISTS late_fees_assessed_on, DROP COLUMN IF EXISTS late_fee_total;
+DROP TABLE IF EXISTS late_fee_policies;
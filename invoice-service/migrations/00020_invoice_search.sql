@@ -0,0 +1,55 @@
+-- +goose Up
+-- Search documents use the 'simple' configuration, so invoice numbers and names aren't stemmed as English words
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS search_vector tsvector;
+
+-- An invoice's search document: its number, then its item descriptions, then its note, in order of weight
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION invoice_search_vector(BIGINT, TEXT, TEXT) RETURNS tsvector AS $$
+    SELECT setweight(to_tsvector('simple', COALESCE($2, '')), 'A') ||
+        setweight(to_tsvector('simple', COALESCE((
+            SELECT string_agg(description, ' ') FROM invoice_items WHERE invoice_items.invoice_id = $1
+        ), '')), 'B') ||
+        setweight(to_tsvector('simple', COALESCE($3, '')), 'C');
+$$ LANGUAGE SQL STABLE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION invoices_search_vector_update() RETURNS trigger AS $$
+BEGIN
+    NEW.search_vector := invoice_search_vector(NEW.id, NEW.invoice_number, NEW.note);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- Items are saved after their invoice, so changes to them recalculate the invoice's document
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION invoice_items_search_vector_update() RETURNS trigger AS $$
+BEGIN
+    UPDATE invoices SET search_vector = invoice_search_vector(id, invoice_number, note)
+    WHERE id = CASE WHEN TG_OP = 'DELETE' THEN OLD.invoice_id ELSE NEW.invoice_id END;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER invoices_search_vector_update
+    BEFORE INSERT OR UPDATE OF invoice_number, note ON invoices
+    FOR EACH ROW EXECUTE FUNCTION invoices_search_vector_update();
+
+CREATE TRIGGER invoice_items_search_vector_update
+    AFTER INSERT OR UPDATE OF description OR DELETE ON invoice_items
+    FOR EACH ROW EXECUTE FUNCTION invoice_items_search_vector_update();
+
+UPDATE invoices SET search_vector = invoice_search_vector(id, invoice_number, note);
+
+CREATE INDEX IF NOT EXISTS invoices_search_vector_idx ON invoices USING GIN (search_vector);
+
+-- +goose Down
+DROP INDEX IF EXISTS invoices_search_vector_idx;
+DROP TRIGGER IF EXISTS invoice_items_search_vector_update ON invoice_items;
+DROP TRIGGER IF EXISTS invoices_search_vector_update ON invoices;
+DROP FUNCTION IF EXISTS invoice_items_search_vector_update();
+DROP FUNCTION IF EXISTS invoices_search_vector_update();
+DROP FUNCTION IF EXISTS invoice_search_vector(BIGINT, TEXT, TEXT);
+ALTER TABLE invoices DROP COLUMN IF EXISTS search_vector;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- Events are written in the same transaction as the change they describe and published afterwards by the relay.
+-- There is no foreign key to invoices, since deleting a draft invoice writes an event too.
+CREATE TABLE IF NOT EXISTS outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    aggregate_type VARCHAR(50) NOT NULL,
+    aggregate_id BIGINT NOT NULL,
+    event_type VARCHAR(100) NOT NULL,
+    payload JSONB NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'published', 'failed')),
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ
+);
+
+-- The relay looks for due events, and for undelivered events holding back later events of the same aggregate
+CREATE INDEX IF NOT EXISTS outbox_events_due_idx ON outbox_events (next_attempt_at, id) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS outbox_events_undelivered_idx ON outbox_events (aggregate_type, aggregate_id, id)
+    WHERE status <> 'published';
+CREATE INDEX IF NOT EXISTS outbox_events_published_at_idx ON outbox_events (published_at) WHERE status = 'published';
+
+-- +goose Down
+DROP TABLE IF EXISTS outbox_events;
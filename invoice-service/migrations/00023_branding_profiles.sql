@@ -0,0 +1,27 @@
+-- +goose Up
+-- Logos are small, so they are kept with the profile rather than in the attachment store
+CREATE TABLE IF NOT EXISTS branding_profiles (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    name VARCHAR(100) NOT NULL,
+    accent_color VARCHAR(7) NOT NULL DEFAULT '',
+    footer TEXT NOT NULL DEFAULT '',
+    payment_instructions TEXT NOT NULL DEFAULT '',
+    default_note TEXT NOT NULL DEFAULT '',
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    logo_content_type VARCHAR(50) NOT NULL DEFAULT '',
+    logo BYTEA,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS branding_profiles_user_id_idx ON branding_profiles (user_id);
+-- A user has at most one default profile
+CREATE UNIQUE INDEX IF NOT EXISTS branding_profiles_default_idx ON branding_profiles (user_id) WHERE is_default;
+
+-- Invoices without a profile, including those whose profile was deleted, use the user's default profile
+ALTER TABLE invoices ADD COLUMN IF NOT EXISTS branding_profile_id BIGINT REFERENCES branding_profiles (id) ON DELETE SET NULL;
+
+-- +goose Down
+ALTER TABLE invoices DROP COLUMN IF EXISTS branding_profile_id;
+DROP TABLE IF EXISTS branding_profiles;
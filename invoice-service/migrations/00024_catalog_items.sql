@@ -0,0 +1,25 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS catalog_items (
+    id BIGSERIAL PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    sku VARCHAR(64) NOT NULL,
+    description TEXT NOT NULL,
+    unit_price BIGINT NOT NULL CHECK (unit_price >= 0),
+    unit VARCHAR(20) NOT NULL DEFAULT '',
+    tax_rate_id BIGINT REFERENCES tax_rates (id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, sku)
+);
+
+-- Items added from the catalog keep its SKU and unit, like the description and price, so later edits to the
+-- catalog don't change issued invoices
+ALTER TABLE invoice_items ADD COLUMN IF NOT EXISTS catalog_item_id BIGINT REFERENCES catalog_items (id) ON DELETE SET NULL;
+ALTER TABLE invoice_items ADD COLUMN IF NOT EXISTS sku VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE invoice_items ADD COLUMN IF NOT EXISTS unit VARCHAR(20) NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE invoice_items DROP COLUMN IF EXISTS unit;
+ALTER TABLE invoice_items DROP COLUMN IF EXISTS sku;
+ALTER TABLE invoice_items DROP COLUMN IF EXISTS catalog_item_id;
+DROP TABLE IF EXISTS catalog_items;
@@ -0,0 +1,31 @@
+-- +goose Up
+-- Credit notes are numbered from a series of their own, one per user, allocated in the same transaction as the
+-- credit note is inserted so a failed insert gives its number back
+CREATE TABLE IF NOT EXISTS credit_note_number_counters (
+    user_id BIGINT PRIMARY KEY,
+    current_value BIGINT NOT NULL
+);
+
+-- Carry on from the highest number each user already has under the old global sequence
+INSERT INTO credit_note_number_counters (user_id, current_value)
+SELECT user_id, MAX(SUBSTRING(credit_note_number FROM 4)::BIGINT)
+FROM credit_notes
+WHERE credit_note_number ~ '^CN-[0-9]{1,18}$'
+GROUP BY user_id;
+
+ALTER TABLE credit_notes DROP CONSTRAINT IF EXISTS credit_notes_credit_note_number_key;
+CREATE UNIQUE INDEX IF NOT EXISTS credit_notes_user_id_credit_note_number_idx ON credit_notes (user_id, credit_note_number);
+
+DROP TABLE IF EXISTS credit_note_number_sequence;
+
+-- +goose Down
+CREATE TABLE IF NOT EXISTS credit_note_number_sequence (
+    id SERIAL PRIMARY KEY,
+    current_value INT NOT NULL
+);
+INSERT INTO credit_note_number_sequence (current_value)
+SELECT GREATEST(100000, COALESCE(MAX(current_value), 0)) FROM credit_note_number_counters;
+
+DROP INDEX IF EXISTS credit_notes_user_id_credit_note_number_idx;
+ALTER TABLE credit_notes ADD CONSTRAINT credit_notes_credit_note_number_key UNIQUE (credit_note_number);
+DROP TABLE IF EXISTS credit_note_number_counters;
@@ -0,0 +1,37 @@
+// Package format formats amounts and dates the way invoices show them to people, so the invoice PDF, the emails
+// and the public invoice page agree.
+package format
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Money formats an amount in cents with thousands separators, e.g. "USD 1,234.56".
+func Money(currency string, cents int64) string {
+	sign := ""
+	if cents < 0 {
+		sign = "-"
+		cents = -cents
+	}
+
+	units := strconv.FormatInt(cents/100, 10)
+	var grouped strings.Builder
+	for i, digit := range units {
+		if i > 0 && (len(units)-i)%3 == 0 {
+			grouped.WriteByte(',')
+		}
+		grouped.WriteRune(digit)
+	}
+	return fmt.Sprintf("%s%s %s.%02d", sign, currency, grouped.String(), cents%100)
+}
+
+// Date formats a date as e.g. "02 Jan 2006", or "-" if it is not set.
+func Date(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	return t.Format("02 Jan 2006")
+}
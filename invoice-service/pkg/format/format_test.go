@@ -0,0 +1,31 @@
+package format_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/emzola/numer/invoice-service/pkg/format"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney(t *testing.T) {
+	tests := []struct {
+		cents int64
+		want  string
+	}{
+		{0, "USD 0.00"},
+		{5, "USD 0.05"},
+		{123456, "USD 1,234.56"},
+		{123456789, "USD 1,234,567.89"},
+		{-150000, "-USD 1,500.00"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, format.Money("USD", tt.cents))
+	}
+}
+
+func TestDate(t *testing.T) {
+	assert.Equal(t, "05 Mar 2024", format.Date(time.Date(2024, time.March, 5, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "-", format.Date(time.Time{}))
+}
@@ -19,12 +19,83 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	InvoiceService_CreateInvoice_FullMethodName           = "/invoice.InvoiceService/CreateInvoice"
-	InvoiceService_GetInvoice_FullMethodName              = "/invoice.InvoiceService/GetInvoice"
-	InvoiceService_UpdateInvoice_FullMethodName           = "/invoice.InvoiceService/UpdateInvoice"
-	InvoiceService_ListInvoices_FullMethodName            = "/invoice.InvoiceService/ListInvoices"
-	InvoiceService_ScheduleInvoiceReminder_FullMethodName = "/invoice.InvoiceService/ScheduleInvoiceReminder"
-	InvoiceService_SendInvoice_FullMethodName             = "/invoice.InvoiceService/SendInvoice"
+	InvoiceService_CreateInvoice_FullMethodName                = "/invoice.InvoiceService/CreateInvoice"
+	InvoiceService_GetInvoice_FullMethodName                   = "/invoice.InvoiceService/GetInvoice"
+	InvoiceService_UpdateInvoice_FullMethodName                = "/invoice.InvoiceService/UpdateInvoice"
+	InvoiceService_ListInvoices_FullMethodName                 = "/invoice.InvoiceService/ListInvoices"
+	InvoiceService_ScheduleInvoiceReminder_FullMethodName      = "/invoice.InvoiceService/ScheduleInvoiceReminder"
+	InvoiceService_SendInvoice_FullMethodName                  = "/invoice.InvoiceService/SendInvoice"
+	InvoiceService_FinalizeInvoice_FullMethodName              = "/invoice.InvoiceService/FinalizeInvoice"
+	InvoiceService_VoidInvoice_FullMethodName                  = "/invoice.InvoiceService/VoidInvoice"
+	InvoiceService_DeleteInvoice_FullMethodName                = "/invoice.InvoiceService/DeleteInvoice"
+	InvoiceService_DuplicateInvoice_FullMethodName             = "/invoice.InvoiceService/DuplicateInvoice"
+	InvoiceService_ArchiveInvoice_FullMethodName               = "/invoice.InvoiceService/ArchiveInvoice"
+	InvoiceService_UnarchiveInvoice_FullMethodName             = "/invoice.InvoiceService/UnarchiveInvoice"
+	InvoiceService_MarkPaid_FullMethodName                     = "/invoice.InvoiceService/MarkPaid"
+	InvoiceService_RecordPayment_FullMethodName                = "/invoice.InvoiceService/RecordPayment"
+	InvoiceService_ListPayments_FullMethodName                 = "/invoice.InvoiceService/ListPayments"
+	InvoiceService_RefundPayment_FullMethodName                = "/invoice.InvoiceService/RefundPayment"
+	InvoiceService_CreateTaxRate_FullMethodName                = "/invoice.InvoiceService/CreateTaxRate"
+	InvoiceService_ListTaxRates_FullMethodName                 = "/invoice.InvoiceService/ListTaxRates"
+	InvoiceService_UpdateTaxRate_FullMethodName                = "/invoice.InvoiceService/UpdateTaxRate"
+	InvoiceService_DeleteTaxRate_FullMethodName                = "/invoice.InvoiceService/DeleteTaxRate"
+	InvoiceService_GetCurrencySettings_FullMethodName          = "/invoice.InvoiceService/GetCurrencySettings"
+	InvoiceService_UpdateCurrencySettings_FullMethodName       = "/invoice.InvoiceService/UpdateCurrencySettings"
+	InvoiceService_CreateExchangeRate_FullMethodName           = "/invoice.InvoiceService/CreateExchangeRate"
+	InvoiceService_ListExchangeRates_FullMethodName            = "/invoice.InvoiceService/ListExchangeRates"
+	InvoiceService_ImportExchangeRates_FullMethodName          = "/invoice.InvoiceService/ImportExchangeRates"
+	InvoiceService_DeleteExchangeRate_FullMethodName           = "/invoice.InvoiceService/DeleteExchangeRate"
+	InvoiceService_CreateRecurringInvoice_FullMethodName       = "/invoice.InvoiceService/CreateRecurringInvoice"
+	InvoiceService_GetRecurringInvoice_FullMethodName          = "/invoice.InvoiceService/GetRecurringInvoice"
+	InvoiceService_ListRecurringInvoices_FullMethodName        = "/invoice.InvoiceService/ListRecurringInvoices"
+	InvoiceService_PauseRecurringInvoice_FullMethodName        = "/invoice.InvoiceService/PauseRecurringInvoice"
+	InvoiceService_ResumeRecurringInvoice_FullMethodName       = "/invoice.InvoiceService/ResumeRecurringInvoice"
+	InvoiceService_CancelRecurringInvoice_FullMethodName       = "/invoice.InvoiceService/CancelRecurringInvoice"
+	InvoiceService_ListRecurringInvoiceInvoices_FullMethodName = "/invoice.InvoiceService/ListRecurringInvoiceInvoices"
+	InvoiceService_CreateCreditNote_FullMethodName             = "/invoice.InvoiceService/CreateCreditNote"
+	InvoiceService_GetCreditNote_FullMethodName                = "/invoice.InvoiceService/GetCreditNote"
+	InvoiceService_ListCreditNotes_FullMethodName              = "/invoice.InvoiceService/ListCreditNotes"
+	InvoiceService_ApplyCreditNote_FullMethodName              = "/invoice.InvoiceService/ApplyCreditNote"
+	InvoiceService_RefundCreditNote_FullMethodName             = "/invoice.InvoiceService/RefundCreditNote"
+	InvoiceService_RenderInvoicePDF_FullMethodName             = "/invoice.InvoiceService/RenderInvoicePDF"
+	InvoiceService_RenderInvoiceUBL_FullMethodName             = "/invoice.InvoiceService/RenderInvoiceUBL"
+	InvoiceService_GetNumberingScheme_FullMethodName           = "/invoice.InvoiceService/GetNumberingScheme"
+	InvoiceService_UpdateNumberingScheme_FullMethodName        = "/invoice.InvoiceService/UpdateNumberingScheme"
+	InvoiceService_CreateQuote_FullMethodName                  = "/invoice.InvoiceService/CreateQuote"
+	InvoiceService_GetQuote_FullMethodName                     = "/invoice.InvoiceService/GetQuote"
+	InvoiceService_ListQuotes_FullMethodName                   = "/invoice.InvoiceService/ListQuotes"
+	InvoiceService_MarkQuoteSent_FullMethodName                = "/invoice.InvoiceService/MarkQuoteSent"
+	InvoiceService_AcceptQuote_FullMethodName                  = "/invoice.InvoiceService/AcceptQuote"
+	InvoiceService_DeclineQuote_FullMethodName                 = "/invoice.InvoiceService/DeclineQuote"
+	InvoiceService_ConvertQuoteToInvoice_FullMethodName        = "/invoice.InvoiceService/ConvertQuoteToInvoice"
+	InvoiceService_CreateInvoiceShare_FullMethodName           = "/invoice.InvoiceService/CreateInvoiceShare"
+	InvoiceService_ListInvoiceShares_FullMethodName            = "/invoice.InvoiceService/ListInvoiceShares"
+	InvoiceService_RevokeInvoiceShare_FullMethodName           = "/invoice.InvoiceService/RevokeInvoiceShare"
+	InvoiceService_ViewSharedInvoice_FullMethodName            = "/invoice.InvoiceService/ViewSharedInvoice"
+	InvoiceService_UploadAttachment_FullMethodName             = "/invoice.InvoiceService/UploadAttachment"
+	InvoiceService_ListAttachments_FullMethodName              = "/invoice.InvoiceService/ListAttachments"
+	InvoiceService_GetAttachment_FullMethodName                = "/invoice.InvoiceService/GetAttachment"
+	InvoiceService_DeleteAttachment_FullMethodName             = "/invoice.InvoiceService/DeleteAttachment"
+	InvoiceService_GetLateFeePolicy_FullMethodName             = "/invoice.InvoiceService/GetLateFeePolicy"
+	InvoiceService_SetLateFeePolicy_FullMethodName             = "/invoice.InvoiceService/SetLateFeePolicy"
+	InvoiceService_DeleteLateFeePolicy_FullMethodName          = "/invoice.InvoiceService/DeleteLateFeePolicy"
+	InvoiceService_ListLateFees_FullMethodName                 = "/invoice.InvoiceService/ListLateFees"
+	InvoiceService_ReverseLateFee_FullMethodName               = "/invoice.InvoiceService/ReverseLateFee"
+	InvoiceService_ImportInvoices_FullMethodName               = "/invoice.InvoiceService/ImportInvoices"
+	InvoiceService_SearchInvoices_FullMethodName               = "/invoice.InvoiceService/SearchInvoices"
+	InvoiceService_ListOutboxEvents_FullMethodName             = "/invoice.InvoiceService/ListOutboxEvents"
+	InvoiceService_RetryOutboxEvent_FullMethodName             = "/invoice.InvoiceService/RetryOutboxEvent"
+	InvoiceService_CreateBrandingProfile_FullMethodName        = "/invoice.InvoiceService/CreateBrandingProfile"
+	InvoiceService_GetBrandingProfile_FullMethodName           = "/invoice.InvoiceService/GetBrandingProfile"
+	InvoiceService_ListBrandingProfiles_FullMethodName         = "/invoice.InvoiceService/ListBrandingProfiles"
+	InvoiceService_UpdateBrandingProfile_FullMethodName        = "/invoice.InvoiceService/UpdateBrandingProfile"
+	InvoiceService_DeleteBrandingProfile_FullMethodName        = "/invoice.InvoiceService/DeleteBrandingProfile"
+	InvoiceService_SetBrandingProfileLogo_FullMethodName       = "/invoice.InvoiceService/SetBrandingProfileLogo"
+	InvoiceService_CreateCatalogItem_FullMethodName            = "/invoice.InvoiceService/CreateCatalogItem"
+	InvoiceService_GetCatalogItem_FullMethodName               = "/invoice.InvoiceService/GetCatalogItem"
+	InvoiceService_ListCatalogItems_FullMethodName             = "/invoice.InvoiceService/ListCatalogItems"
+	InvoiceService_UpdateCatalogItem_FullMethodName            = "/invoice.InvoiceService/UpdateCatalogItem"
+	InvoiceService_DeleteCatalogItem_FullMethodName            = "/invoice.InvoiceService/DeleteCatalogItem"
 )
 
 // InvoiceServiceClient is the client API for InvoiceService service.
@@ -37,6 +108,77 @@ type InvoiceServiceClient interface {
 	ListInvoices(ctx context.Context, in *ListInvoicesRequest, opts ...grpc.CallOption) (*ListInvoicesResponse, error)
 	ScheduleInvoiceReminder(ctx context.Context, in *ScheduleInvoiceReminderRequest, opts ...grpc.CallOption) (*ScheduleInvoiceReminderResponse, error)
 	SendInvoice(ctx context.Context, in *SendInvoiceRequest, opts ...grpc.CallOption) (*SendInvoiceResponse, error)
+	FinalizeInvoice(ctx context.Context, in *FinalizeInvoiceRequest, opts ...grpc.CallOption) (*FinalizeInvoiceResponse, error)
+	VoidInvoice(ctx context.Context, in *VoidInvoiceRequest, opts ...grpc.CallOption) (*VoidInvoiceResponse, error)
+	DeleteInvoice(ctx context.Context, in *DeleteInvoiceRequest, opts ...grpc.CallOption) (*DeleteInvoiceResponse, error)
+	DuplicateInvoice(ctx context.Context, in *DuplicateInvoiceRequest, opts ...grpc.CallOption) (*DuplicateInvoiceResponse, error)
+	ArchiveInvoice(ctx context.Context, in *ArchiveInvoiceRequest, opts ...grpc.CallOption) (*ArchiveInvoiceResponse, error)
+	UnarchiveInvoice(ctx context.Context, in *ArchiveInvoiceRequest, opts ...grpc.CallOption) (*ArchiveInvoiceResponse, error)
+	MarkPaid(ctx context.Context, in *MarkPaidRequest, opts ...grpc.CallOption) (*MarkPaidResponse, error)
+	RecordPayment(ctx context.Context, in *RecordPaymentRequest, opts ...grpc.CallOption) (*RecordPaymentResponse, error)
+	ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error)
+	RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error)
+	CreateTaxRate(ctx context.Context, in *CreateTaxRateRequest, opts ...grpc.CallOption) (*CreateTaxRateResponse, error)
+	ListTaxRates(ctx context.Context, in *ListTaxRatesRequest, opts ...grpc.CallOption) (*ListTaxRatesResponse, error)
+	UpdateTaxRate(ctx context.Context, in *UpdateTaxRateRequest, opts ...grpc.CallOption) (*UpdateTaxRateResponse, error)
+	DeleteTaxRate(ctx context.Context, in *DeleteTaxRateRequest, opts ...grpc.CallOption) (*DeleteTaxRateResponse, error)
+	GetCurrencySettings(ctx context.Context, in *GetCurrencySettingsRequest, opts ...grpc.CallOption) (*GetCurrencySettingsResponse, error)
+	UpdateCurrencySettings(ctx context.Context, in *UpdateCurrencySettingsRequest, opts ...grpc.CallOption) (*UpdateCurrencySettingsResponse, error)
+	CreateExchangeRate(ctx context.Context, in *CreateExchangeRateRequest, opts ...grpc.CallOption) (*CreateExchangeRateResponse, error)
+	ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error)
+	ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ImportExchangeRatesResponse, error)
+	DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateRequest, opts ...grpc.CallOption) (*DeleteExchangeRateResponse, error)
+	CreateRecurringInvoice(ctx context.Context, in *CreateRecurringInvoiceRequest, opts ...grpc.CallOption) (*RecurringInvoiceResponse, error)
+	GetRecurringInvoice(ctx context.Context, in *RecurringInvoiceRequest, opts ...grpc.CallOption) (*RecurringInvoiceResponse, error)
+	ListRecurringInvoices(ctx context.Context, in *ListRecurringInvoicesRequest, opts ...grpc.CallOption) (*ListRecurringInvoicesResponse, error)
+	PauseRecurringInvoice(ctx context.Context, in *RecurringInvoiceRequest, opts ...grpc.CallOption) (*RecurringInvoiceResponse, error)
+	ResumeRecurringInvoice(ctx context.Context, in *RecurringInvoiceRequest, opts ...grpc.CallOption) (*RecurringInvoiceResponse, error)
+	CancelRecurringInvoice(ctx context.Context, in *RecurringInvoiceRequest, opts ...grpc.CallOption) (*RecurringInvoiceResponse, error)
+	ListRecurringInvoiceInvoices(ctx context.Context, in *RecurringInvoiceRequest, opts ...grpc.CallOption) (*ListInvoicesResponse, error)
+	CreateCreditNote(ctx context.Context, in *CreateCreditNoteRequest, opts ...grpc.CallOption) (*CreditNoteResponse, error)
+	GetCreditNote(ctx context.Context, in *GetCreditNoteRequest, opts ...grpc.CallOption) (*CreditNoteResponse, error)
+	ListCreditNotes(ctx context.Context, in *ListCreditNotesRequest, opts ...grpc.CallOption) (*ListCreditNotesResponse, error)
+	ApplyCreditNote(ctx context.Context, in *ApplyCreditNoteRequest, opts ...grpc.CallOption) (*CreditNoteResponse, error)
+	RefundCreditNote(ctx context.Context, in *RefundCreditNoteRequest, opts ...grpc.CallOption) (*CreditNoteResponse, error)
+	RenderInvoicePDF(ctx context.Context, in *RenderInvoicePDFRequest, opts ...grpc.CallOption) (*RenderInvoicePDFResponse, error)
+	RenderInvoiceUBL(ctx context.Context, in *RenderInvoiceUBLRequest, opts ...grpc.CallOption) (*RenderInvoiceUBLResponse, error)
+	GetNumberingScheme(ctx context.Context, in *GetNumberingSchemeRequest, opts ...grpc.CallOption) (*NumberingSchemeResponse, error)
+	UpdateNumberingScheme(ctx context.Context, in *UpdateNumberingSchemeRequest, opts ...grpc.CallOption) (*NumberingSchemeResponse, error)
+	CreateQuote(ctx context.Context, in *CreateQuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error)
+	GetQuote(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error)
+	ListQuotes(ctx context.Context, in *ListQuotesRequest, opts ...grpc.CallOption) (*ListQuotesResponse, error)
+	MarkQuoteSent(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error)
+	AcceptQuote(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error)
+	DeclineQuote(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error)
+	ConvertQuoteToInvoice(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error)
+	CreateInvoiceShare(ctx context.Context, in *CreateInvoiceShareRequest, opts ...grpc.CallOption) (*InvoiceShareResponse, error)
+	ListInvoiceShares(ctx context.Context, in *ListInvoiceSharesRequest, opts ...grpc.CallOption) (*ListInvoiceSharesResponse, error)
+	RevokeInvoiceShare(ctx context.Context, in *RevokeInvoiceShareRequest, opts ...grpc.CallOption) (*InvoiceShareResponse, error)
+	ViewSharedInvoice(ctx context.Context, in *ViewSharedInvoiceRequest, opts ...grpc.CallOption) (*ViewSharedInvoiceResponse, error)
+	UploadAttachment(ctx context.Context, in *UploadAttachmentRequest, opts ...grpc.CallOption) (*AttachmentResponse, error)
+	ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error)
+	GetAttachment(ctx context.Context, in *AttachmentRequest, opts ...grpc.CallOption) (*AttachmentResponse, error)
+	DeleteAttachment(ctx context.Context, in *AttachmentRequest, opts ...grpc.CallOption) (*AttachmentResponse, error)
+	GetLateFeePolicy(ctx context.Context, in *GetLateFeePolicyRequest, opts ...grpc.CallOption) (*LateFeePolicyResponse, error)
+	SetLateFeePolicy(ctx context.Context, in *SetLateFeePolicyRequest, opts ...grpc.CallOption) (*LateFeePolicyResponse, error)
+	DeleteLateFeePolicy(ctx context.Context, in *DeleteLateFeePolicyRequest, opts ...grpc.CallOption) (*DeleteLateFeePolicyResponse, error)
+	ListLateFees(ctx context.Context, in *ListLateFeesRequest, opts ...grpc.CallOption) (*ListLateFeesResponse, error)
+	ReverseLateFee(ctx context.Context, in *ReverseLateFeeRequest, opts ...grpc.CallOption) (*ReverseLateFeeResponse, error)
+	ImportInvoices(ctx context.Context, in *ImportInvoicesRequest, opts ...grpc.CallOption) (*ImportInvoicesResponse, error)
+	SearchInvoices(ctx context.Context, in *SearchInvoicesRequest, opts ...grpc.CallOption) (*SearchInvoicesResponse, error)
+	ListOutboxEvents(ctx context.Context, in *ListOutboxEventsRequest, opts ...grpc.CallOption) (*ListOutboxEventsResponse, error)
+	RetryOutboxEvent(ctx context.Context, in *RetryOutboxEventRequest, opts ...grpc.CallOption) (*OutboxEventResponse, error)
+	CreateBrandingProfile(ctx context.Context, in *BrandingProfileRequest, opts ...grpc.CallOption) (*BrandingProfileResponse, error)
+	GetBrandingProfile(ctx context.Context, in *GetBrandingProfileRequest, opts ...grpc.CallOption) (*BrandingProfileResponse, error)
+	ListBrandingProfiles(ctx context.Context, in *ListBrandingProfilesRequest, opts ...grpc.CallOption) (*ListBrandingProfilesResponse, error)
+	UpdateBrandingProfile(ctx context.Context, in *BrandingProfileRequest, opts ...grpc.CallOption) (*BrandingProfileResponse, error)
+	DeleteBrandingProfile(ctx context.Context, in *GetBrandingProfileRequest, opts ...grpc.CallOption) (*BrandingProfileResponse, error)
+	SetBrandingProfileLogo(ctx context.Context, in *SetBrandingProfileLogoRequest, opts ...grpc.CallOption) (*BrandingProfileResponse, error)
+	CreateCatalogItem(ctx context.Context, in *CatalogItemRequest, opts ...grpc.CallOption) (*CatalogItemResponse, error)
+	GetCatalogItem(ctx context.Context, in *GetCatalogItemRequest, opts ...grpc.CallOption) (*CatalogItemResponse, error)
+	ListCatalogItems(ctx context.Context, in *ListCatalogItemsRequest, opts ...grpc.CallOption) (*ListCatalogItemsResponse, error)
+	UpdateCatalogItem(ctx context.Context, in *CatalogItemRequest, opts ...grpc.CallOption) (*CatalogItemResponse, error)
+	DeleteCatalogItem(ctx context.Context, in *GetCatalogItemRequest, opts ...grpc.CallOption) (*CatalogItemResponse, error)
 }
 
 type invoiceServiceClient struct {
@@ -101,6 +243,645 @@ func (c *invoiceServiceClient) SendInvoice(ctx context.Context, in *SendInvoiceR
 	return out, nil
 }
 
+func (c *invoiceServiceClient) FinalizeInvoice(ctx context.Context, in *FinalizeInvoiceRequest, opts ...grpc.CallOption) (*FinalizeInvoiceResponse, error) {
+	out := new(FinalizeInvoiceResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_FinalizeInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) VoidInvoice(ctx context.Context, in *VoidInvoiceRequest, opts ...grpc.CallOption) (*VoidInvoiceResponse, error) {
+	out := new(VoidInvoiceResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_VoidInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) DeleteInvoice(ctx context.Context, in *DeleteInvoiceRequest, opts ...grpc.CallOption) (*DeleteInvoiceResponse, error) {
+	out := new(DeleteInvoiceResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_DeleteInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) DuplicateInvoice(ctx context.Context, in *DuplicateInvoiceRequest, opts ...grpc.CallOption) (*DuplicateInvoiceResponse, error) {
+	out := new(DuplicateInvoiceResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_DuplicateInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ArchiveInvoice(ctx context.Context, in *ArchiveInvoiceRequest, opts ...grpc.CallOption) (*ArchiveInvoiceResponse, error) {
+	out := new(ArchiveInvoiceResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ArchiveInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) UnarchiveInvoice(ctx context.Context, in *ArchiveInvoiceRequest, opts ...grpc.CallOption) (*ArchiveInvoiceResponse, error) {
+	out := new(ArchiveInvoiceResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_UnarchiveInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) MarkPaid(ctx context.Context, in *MarkPaidRequest, opts ...grpc.CallOption) (*MarkPaidResponse, error) {
+	out := new(MarkPaidResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_MarkPaid_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) RecordPayment(ctx context.Context, in *RecordPaymentRequest, opts ...grpc.CallOption) (*RecordPaymentResponse, error) {
+	out := new(RecordPaymentResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_RecordPayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ListPayments(ctx context.Context, in *ListPaymentsRequest, opts ...grpc.CallOption) (*ListPaymentsResponse, error) {
+	out := new(ListPaymentsResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ListPayments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) RefundPayment(ctx context.Context, in *RefundPaymentRequest, opts ...grpc.CallOption) (*RefundPaymentResponse, error) {
+	out := new(RefundPaymentResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_RefundPayment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) CreateTaxRate(ctx context.Context, in *CreateTaxRateRequest, opts ...grpc.CallOption) (*CreateTaxRateResponse, error) {
+	out := new(CreateTaxRateResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_CreateTaxRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ListTaxRates(ctx context.Context, in *ListTaxRatesRequest, opts ...grpc.CallOption) (*ListTaxRatesResponse, error) {
+	out := new(ListTaxRatesResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ListTaxRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) UpdateTaxRate(ctx context.Context, in *UpdateTaxRateRequest, opts ...grpc.CallOption) (*UpdateTaxRateResponse, error) {
+	out := new(UpdateTaxRateResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_UpdateTaxRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) DeleteTaxRate(ctx context.Context, in *DeleteTaxRateRequest, opts ...grpc.CallOption) (*DeleteTaxRateResponse, error) {
+	out := new(DeleteTaxRateResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_DeleteTaxRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetCurrencySettings(ctx context.Context, in *GetCurrencySettingsRequest, opts ...grpc.CallOption) (*GetCurrencySettingsResponse, error) {
+	out := new(GetCurrencySettingsResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_GetCurrencySettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) UpdateCurrencySettings(ctx context.Context, in *UpdateCurrencySettingsRequest, opts ...grpc.CallOption) (*UpdateCurrencySettingsResponse, error) {
+	out := new(UpdateCurrencySettingsResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_UpdateCurrencySettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) CreateExchangeRate(ctx context.Context, in *CreateExchangeRateRequest, opts ...grpc.CallOption) (*CreateExchangeRateResponse, error) {
+	out := new(CreateExchangeRateResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_CreateExchangeRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ListExchangeRates(ctx context.Context, in *ListExchangeRatesRequest, opts ...grpc.CallOption) (*ListExchangeRatesResponse, error) {
+	out := new(ListExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ListExchangeRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ImportExchangeRates(ctx context.Context, in *ImportExchangeRatesRequest, opts ...grpc.CallOption) (*ImportExchangeRatesResponse, error) {
+	out := new(ImportExchangeRatesResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ImportExchangeRates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) DeleteExchangeRate(ctx context.Context, in *DeleteExchangeRateRequest, opts ...grpc.CallOption) (*DeleteExchangeRateResponse, error) {
+	out := new(DeleteExchangeRateResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_DeleteExchangeRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) CreateRecurringInvoice(ctx context.Context, in *CreateRecurringInvoiceRequest, opts ...grpc.CallOption) (*RecurringInvoiceResponse, error) {
+	out := new(RecurringInvoiceResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_CreateRecurringInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetRecurringInvoice(ctx context.Context, in *RecurringInvoiceRequest, opts ...grpc.CallOption) (*RecurringInvoiceResponse, error) {
+	out := new(RecurringInvoiceResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_GetRecurringInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ListRecurringInvoices(ctx context.Context, in *ListRecurringInvoicesRequest, opts ...grpc.CallOption) (*ListRecurringInvoicesResponse, error) {
+	out := new(ListRecurringInvoicesResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ListRecurringInvoices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) PauseRecurringInvoice(ctx context.Context, in *RecurringInvoiceRequest, opts ...grpc.CallOption) (*RecurringInvoiceResponse, error) {
+	out := new(RecurringInvoiceResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_PauseRecurringInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ResumeRecurringInvoice(ctx context.Context, in *RecurringInvoiceRequest, opts ...grpc.CallOption) (*RecurringInvoiceResponse, error) {
+	out := new(RecurringInvoiceResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ResumeRecurringInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) CancelRecurringInvoice(ctx context.Context, in *RecurringInvoiceRequest, opts ...grpc.CallOption) (*RecurringInvoiceResponse, error) {
+	out := new(RecurringInvoiceResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_CancelRecurringInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ListRecurringInvoiceInvoices(ctx context.Context, in *RecurringInvoiceRequest, opts ...grpc.CallOption) (*ListInvoicesResponse, error) {
+	out := new(ListInvoicesResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ListRecurringInvoiceInvoices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) CreateCreditNote(ctx context.Context, in *CreateCreditNoteRequest, opts ...grpc.CallOption) (*CreditNoteResponse, error) {
+	out := new(CreditNoteResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_CreateCreditNote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetCreditNote(ctx context.Context, in *GetCreditNoteRequest, opts ...grpc.CallOption) (*CreditNoteResponse, error) {
+	out := new(CreditNoteResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_GetCreditNote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ListCreditNotes(ctx context.Context, in *ListCreditNotesRequest, opts ...grpc.CallOption) (*ListCreditNotesResponse, error) {
+	out := new(ListCreditNotesResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ListCreditNotes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ApplyCreditNote(ctx context.Context, in *ApplyCreditNoteRequest, opts ...grpc.CallOption) (*CreditNoteResponse, error) {
+	out := new(CreditNoteResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ApplyCreditNote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) RefundCreditNote(ctx context.Context, in *RefundCreditNoteRequest, opts ...grpc.CallOption) (*CreditNoteResponse, error) {
+	out := new(CreditNoteResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_RefundCreditNote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) RenderInvoicePDF(ctx context.Context, in *RenderInvoicePDFRequest, opts ...grpc.CallOption) (*RenderInvoicePDFResponse, error) {
+	out := new(RenderInvoicePDFResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_RenderInvoicePDF_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) RenderInvoiceUBL(ctx context.Context, in *RenderInvoiceUBLRequest, opts ...grpc.CallOption) (*RenderInvoiceUBLResponse, error) {
+	out := new(RenderInvoiceUBLResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_RenderInvoiceUBL_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetNumberingScheme(ctx context.Context, in *GetNumberingSchemeRequest, opts ...grpc.CallOption) (*NumberingSchemeResponse, error) {
+	out := new(NumberingSchemeResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_GetNumberingScheme_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) UpdateNumberingScheme(ctx context.Context, in *UpdateNumberingSchemeRequest, opts ...grpc.CallOption) (*NumberingSchemeResponse, error) {
+	out := new(NumberingSchemeResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_UpdateNumberingScheme_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) CreateQuote(ctx context.Context, in *CreateQuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error) {
+	out := new(QuoteResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_CreateQuote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetQuote(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error) {
+	out := new(QuoteResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_GetQuote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ListQuotes(ctx context.Context, in *ListQuotesRequest, opts ...grpc.CallOption) (*ListQuotesResponse, error) {
+	out := new(ListQuotesResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ListQuotes_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) MarkQuoteSent(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error) {
+	out := new(QuoteResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_MarkQuoteSent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) AcceptQuote(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error) {
+	out := new(QuoteResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_AcceptQuote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) DeclineQuote(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error) {
+	out := new(QuoteResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_DeclineQuote_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ConvertQuoteToInvoice(ctx context.Context, in *QuoteRequest, opts ...grpc.CallOption) (*QuoteResponse, error) {
+	out := new(QuoteResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ConvertQuoteToInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) CreateInvoiceShare(ctx context.Context, in *CreateInvoiceShareRequest, opts ...grpc.CallOption) (*InvoiceShareResponse, error) {
+	out := new(InvoiceShareResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_CreateInvoiceShare_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ListInvoiceShares(ctx context.Context, in *ListInvoiceSharesRequest, opts ...grpc.CallOption) (*ListInvoiceSharesResponse, error) {
+	out := new(ListInvoiceSharesResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ListInvoiceShares_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) RevokeInvoiceShare(ctx context.Context, in *RevokeInvoiceShareRequest, opts ...grpc.CallOption) (*InvoiceShareResponse, error) {
+	out := new(InvoiceShareResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_RevokeInvoiceShare_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ViewSharedInvoice(ctx context.Context, in *ViewSharedInvoiceRequest, opts ...grpc.CallOption) (*ViewSharedInvoiceResponse, error) {
+	out := new(ViewSharedInvoiceResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ViewSharedInvoice_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) UploadAttachment(ctx context.Context, in *UploadAttachmentRequest, opts ...grpc.CallOption) (*AttachmentResponse, error) {
+	out := new(AttachmentResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_UploadAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ListAttachments(ctx context.Context, in *ListAttachmentsRequest, opts ...grpc.CallOption) (*ListAttachmentsResponse, error) {
+	out := new(ListAttachmentsResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ListAttachments_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetAttachment(ctx context.Context, in *AttachmentRequest, opts ...grpc.CallOption) (*AttachmentResponse, error) {
+	out := new(AttachmentResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_GetAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) DeleteAttachment(ctx context.Context, in *AttachmentRequest, opts ...grpc.CallOption) (*AttachmentResponse, error) {
+	out := new(AttachmentResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_DeleteAttachment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetLateFeePolicy(ctx context.Context, in *GetLateFeePolicyRequest, opts ...grpc.CallOption) (*LateFeePolicyResponse, error) {
+	out := new(LateFeePolicyResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_GetLateFeePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) SetLateFeePolicy(ctx context.Context, in *SetLateFeePolicyRequest, opts ...grpc.CallOption) (*LateFeePolicyResponse, error) {
+	out := new(LateFeePolicyResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_SetLateFeePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) DeleteLateFeePolicy(ctx context.Context, in *DeleteLateFeePolicyRequest, opts ...grpc.CallOption) (*DeleteLateFeePolicyResponse, error) {
+	out := new(DeleteLateFeePolicyResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_DeleteLateFeePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ListLateFees(ctx context.Context, in *ListLateFeesRequest, opts ...grpc.CallOption) (*ListLateFeesResponse, error) {
+	out := new(ListLateFeesResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ListLateFees_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ReverseLateFee(ctx context.Context, in *ReverseLateFeeRequest, opts ...grpc.CallOption) (*ReverseLateFeeResponse, error) {
+	out := new(ReverseLateFeeResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ReverseLateFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ImportInvoices(ctx context.Context, in *ImportInvoicesRequest, opts ...grpc.CallOption) (*ImportInvoicesResponse, error) {
+	out := new(ImportInvoicesResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ImportInvoices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) SearchInvoices(ctx context.Context, in *SearchInvoicesRequest, opts ...grpc.CallOption) (*SearchInvoicesResponse, error) {
+	out := new(SearchInvoicesResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_SearchInvoices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ListOutboxEvents(ctx context.Context, in *ListOutboxEventsRequest, opts ...grpc.CallOption) (*ListOutboxEventsResponse, error) {
+	out := new(ListOutboxEventsResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ListOutboxEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) RetryOutboxEvent(ctx context.Context, in *RetryOutboxEventRequest, opts ...grpc.CallOption) (*OutboxEventResponse, error) {
+	out := new(OutboxEventResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_RetryOutboxEvent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) CreateBrandingProfile(ctx context.Context, in *BrandingProfileRequest, opts ...grpc.CallOption) (*BrandingProfileResponse, error) {
+	out := new(BrandingProfileResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_CreateBrandingProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetBrandingProfile(ctx context.Context, in *GetBrandingProfileRequest, opts ...grpc.CallOption) (*BrandingProfileResponse, error) {
+	out := new(BrandingProfileResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_GetBrandingProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ListBrandingProfiles(ctx context.Context, in *ListBrandingProfilesRequest, opts ...grpc.CallOption) (*ListBrandingProfilesResponse, error) {
+	out := new(ListBrandingProfilesResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ListBrandingProfiles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) UpdateBrandingProfile(ctx context.Context, in *BrandingProfileRequest, opts ...grpc.CallOption) (*BrandingProfileResponse, error) {
+	out := new(BrandingProfileResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_UpdateBrandingProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) DeleteBrandingProfile(ctx context.Context, in *GetBrandingProfileRequest, opts ...grpc.CallOption) (*BrandingProfileResponse, error) {
+	out := new(BrandingProfileResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_DeleteBrandingProfile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) SetBrandingProfileLogo(ctx context.Context, in *SetBrandingProfileLogoRequest, opts ...grpc.CallOption) (*BrandingProfileResponse, error) {
+	out := new(BrandingProfileResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_SetBrandingProfileLogo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) CreateCatalogItem(ctx context.Context, in *CatalogItemRequest, opts ...grpc.CallOption) (*CatalogItemResponse, error) {
+	out := new(CatalogItemResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_CreateCatalogItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) GetCatalogItem(ctx context.Context, in *GetCatalogItemRequest, opts ...grpc.CallOption) (*CatalogItemResponse, error) {
+	out := new(CatalogItemResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_GetCatalogItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) ListCatalogItems(ctx context.Context, in *ListCatalogItemsRequest, opts ...grpc.CallOption) (*ListCatalogItemsResponse, error) {
+	out := new(ListCatalogItemsResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_ListCatalogItems_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) UpdateCatalogItem(ctx context.Context, in *CatalogItemRequest, opts ...grpc.CallOption) (*CatalogItemResponse, error) {
+	out := new(CatalogItemResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_UpdateCatalogItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invoiceServiceClient) DeleteCatalogItem(ctx context.Context, in *GetCatalogItemRequest, opts ...grpc.CallOption) (*CatalogItemResponse, error) {
+	out := new(CatalogItemResponse)
+	err := c.cc.Invoke(ctx, InvoiceService_DeleteCatalogItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // InvoiceServiceServer is the server API for InvoiceService service.
 // All implementations must embed UnimplementedInvoiceServiceServer
 // for forward compatibility
@@ -111,148 +892,1710 @@ type InvoiceServiceServer interface {
 	ListInvoices(context.Context, *ListInvoicesRequest) (*ListInvoicesResponse, error)
 	ScheduleInvoiceReminder(context.Context, *ScheduleInvoiceReminderRequest) (*ScheduleInvoiceReminderResponse, error)
 	SendInvoice(context.Context, *SendInvoiceRequest) (*SendInvoiceResponse, error)
+	FinalizeInvoice(context.Context, *FinalizeInvoiceRequest) (*FinalizeInvoiceResponse, error)
+	VoidInvoice(context.Context, *VoidInvoiceRequest) (*VoidInvoiceResponse, error)
+	DeleteInvoice(context.Context, *DeleteInvoiceRequest) (*DeleteInvoiceResponse, error)
+	DuplicateInvoice(context.Context, *DuplicateInvoiceRequest) (*DuplicateInvoiceResponse, error)
+	ArchiveInvoice(context.Context, *ArchiveInvoiceRequest) (*ArchiveInvoiceResponse, error)
+	UnarchiveInvoice(context.Context, *ArchiveInvoiceRequest) (*ArchiveInvoiceResponse, error)
+	MarkPaid(context.Context, *MarkPaidRequest) (*MarkPaidResponse, error)
+	RecordPayment(context.Context, *RecordPaymentRequest) (*RecordPaymentResponse, error)
+	ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error)
+	RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error)
+	CreateTaxRate(context.Context, *CreateTaxRateRequest) (*CreateTaxRateResponse, error)
+	ListTaxRates(context.Context, *ListTaxRatesRequest) (*ListTaxRatesResponse, error)
+	UpdateTaxRate(context.Context, *UpdateTaxRateRequest) (*UpdateTaxRateResponse, error)
+	DeleteTaxRate(context.Context, *DeleteTaxRateRequest) (*DeleteTaxRateResponse, error)
+	GetCurrencySettings(context.Context, *GetCurrencySettingsRequest) (*GetCurrencySettingsResponse, error)
+	UpdateCurrencySettings(context.Context, *UpdateCurrencySettingsRequest) (*UpdateCurrencySettingsResponse, error)
+	CreateExchangeRate(context.Context, *CreateExchangeRateRequest) (*CreateExchangeRateResponse, error)
+	ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error)
+	ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesResponse, error)
+	DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*DeleteExchangeRateResponse, error)
+	CreateRecurringInvoice(context.Context, *CreateRecurringInvoiceRequest) (*RecurringInvoiceResponse, error)
+	GetRecurringInvoice(context.Context, *RecurringInvoiceRequest) (*RecurringInvoiceResponse, error)
+	ListRecurringInvoices(context.Context, *ListRecurringInvoicesRequest) (*ListRecurringInvoicesResponse, error)
+	PauseRecurringInvoice(context.Context, *RecurringInvoiceRequest) (*RecurringInvoiceResponse, error)
+	ResumeRecurringInvoice(context.Context, *RecurringInvoiceRequest) (*RecurringInvoiceResponse, error)
+	CancelRecurringInvoice(context.Context, *RecurringInvoiceRequest) (*RecurringInvoiceResponse, error)
+	ListRecurringInvoiceInvoices(context.Context, *RecurringInvoiceRequest) (*ListInvoicesResponse, error)
+	CreateCreditNote(context.Context, *CreateCreditNoteRequest) (*CreditNoteResponse, error)
+	GetCreditNote(context.Context, *GetCreditNoteRequest) (*CreditNoteResponse, error)
+	ListCreditNotes(context.Context, *ListCreditNotesRequest) (*ListCreditNotesResponse, error)
+	ApplyCreditNote(context.Context, *ApplyCreditNoteRequest) (*CreditNoteResponse, error)
+	RefundCreditNote(context.Context, *RefundCreditNoteRequest) (*CreditNoteResponse, error)
+	RenderInvoicePDF(context.Context, *RenderInvoicePDFRequest) (*RenderInvoicePDFResponse, error)
+	RenderInvoiceUBL(context.Context, *RenderInvoiceUBLRequest) (*RenderInvoiceUBLResponse, error)
+	GetNumberingScheme(context.Context, *GetNumberingSchemeRequest) (*NumberingSchemeResponse, error)
+	UpdateNumberingScheme(context.Context, *UpdateNumberingSchemeRequest) (*NumberingSchemeResponse, error)
+	CreateQuote(context.Context, *CreateQuoteRequest) (*QuoteResponse, error)
+	GetQuote(context.Context, *QuoteRequest) (*QuoteResponse, error)
+	ListQuotes(context.Context, *ListQuotesRequest) (*ListQuotesResponse, error)
+	MarkQuoteSent(context.Context, *QuoteRequest) (*QuoteResponse, error)
+	AcceptQuote(context.Context, *QuoteRequest) (*QuoteResponse, error)
+	DeclineQuote(context.Context, *QuoteRequest) (*QuoteResponse, error)
+	ConvertQuoteToInvoice(context.Context, *QuoteRequest) (*QuoteResponse, error)
+	CreateInvoiceShare(context.Context, *CreateInvoiceShareRequest) (*InvoiceShareResponse, error)
+	ListInvoiceShares(context.Context, *ListInvoiceSharesRequest) (*ListInvoiceSharesResponse, error)
+	RevokeInvoiceShare(context.Context, *RevokeInvoiceShareRequest) (*InvoiceShareResponse, error)
+	ViewSharedInvoice(context.Context, *ViewSharedInvoiceRequest) (*ViewSharedInvoiceResponse, error)
+	UploadAttachment(context.Context, *UploadAttachmentRequest) (*AttachmentResponse, error)
+	ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error)
+	GetAttachment(context.Context, *AttachmentRequest) (*AttachmentResponse, error)
+	DeleteAttachment(context.Context, *AttachmentRequest) (*AttachmentResponse, error)
+	GetLateFeePolicy(context.Context, *GetLateFeePolicyRequest) (*LateFeePolicyResponse, error)
+	SetLateFeePolicy(context.Context, *SetLateFeePolicyRequest) (*LateFeePolicyResponse, error)
+	DeleteLateFeePolicy(context.Context, *DeleteLateFeePolicyRequest) (*DeleteLateFeePolicyResponse, error)
+	ListLateFees(context.Context, *ListLateFeesRequest) (*ListLateFeesResponse, error)
+	ReverseLateFee(context.Context, *ReverseLateFeeRequest) (*ReverseLateFeeResponse, error)
+	ImportInvoices(context.Context, *ImportInvoicesRequest) (*ImportInvoicesResponse, error)
+	SearchInvoices(context.Context, *SearchInvoicesRequest) (*SearchInvoicesResponse, error)
+	ListOutboxEvents(context.Context, *ListOutboxEventsRequest) (*ListOutboxEventsResponse, error)
+	RetryOutboxEvent(context.Context, *RetryOutboxEventRequest) (*OutboxEventResponse, error)
+	CreateBrandingProfile(context.Context, *BrandingProfileRequest) (*BrandingProfileResponse, error)
+	GetBrandingProfile(context.Context, *GetBrandingProfileRequest) (*BrandingProfileResponse, error)
+	ListBrandingProfiles(context.Context, *ListBrandingProfilesRequest) (*ListBrandingProfilesResponse, error)
+	UpdateBrandingProfile(context.Context, *BrandingProfileRequest) (*BrandingProfileResponse, error)
+	DeleteBrandingProfile(context.Context, *GetBrandingProfileRequest) (*BrandingProfileResponse, error)
+	SetBrandingProfileLogo(context.Context, *SetBrandingProfileLogoRequest) (*BrandingProfileResponse, error)
+	CreateCatalogItem(context.Context, *CatalogItemRequest) (*CatalogItemResponse, error)
+	GetCatalogItem(context.Context, *GetCatalogItemRequest) (*CatalogItemResponse, error)
+	ListCatalogItems(context.Context, *ListCatalogItemsRequest) (*ListCatalogItemsResponse, error)
+	UpdateCatalogItem(context.Context, *CatalogItemRequest) (*CatalogItemResponse, error)
+	DeleteCatalogItem(context.Context, *GetCatalogItemRequest) (*CatalogItemResponse, error)
+	mustEmbedUnimplementedInvoiceServiceServer()
+}
+
+// UnimplementedInvoiceServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedInvoiceServiceServer struct {
+}
+
+func (UnimplementedInvoiceServiceServer) CreateInvoice(context.Context, *CreateInvoiceRequest) (*CreateInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetInvoice(context.Context, *GetInvoiceRequest) (*GetInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) UpdateInvoice(context.Context, *UpdateInvoiceRequest) (*UpdateInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ListInvoices(context.Context, *ListInvoicesRequest) (*ListInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvoices not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ScheduleInvoiceReminder(context.Context, *ScheduleInvoiceReminderRequest) (*ScheduleInvoiceReminderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleInvoiceReminder not implemented")
+}
+func (UnimplementedInvoiceServiceServer) SendInvoice(context.Context, *SendInvoiceRequest) (*SendInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) FinalizeInvoice(context.Context, *FinalizeInvoiceRequest) (*FinalizeInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) VoidInvoice(context.Context, *VoidInvoiceRequest) (*VoidInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoidInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) DeleteInvoice(context.Context, *DeleteInvoiceRequest) (*DeleteInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) DuplicateInvoice(context.Context, *DuplicateInvoiceRequest) (*DuplicateInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DuplicateInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ArchiveInvoice(context.Context, *ArchiveInvoiceRequest) (*ArchiveInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) UnarchiveInvoice(context.Context, *ArchiveInvoiceRequest) (*ArchiveInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnarchiveInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) MarkPaid(context.Context, *MarkPaidRequest) (*MarkPaidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkPaid not implemented")
+}
+func (UnimplementedInvoiceServiceServer) RecordPayment(context.Context, *RecordPaymentRequest) (*RecordPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordPayment not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ListPayments(context.Context, *ListPaymentsRequest) (*ListPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPayments not implemented")
+}
+func (UnimplementedInvoiceServiceServer) RefundPayment(context.Context, *RefundPaymentRequest) (*RefundPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundPayment not implemented")
+}
+func (UnimplementedInvoiceServiceServer) CreateTaxRate(context.Context, *CreateTaxRateRequest) (*CreateTaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTaxRate not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ListTaxRates(context.Context, *ListTaxRatesRequest) (*ListTaxRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTaxRates not implemented")
+}
+func (UnimplementedInvoiceServiceServer) UpdateTaxRate(context.Context, *UpdateTaxRateRequest) (*UpdateTaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTaxRate not implemented")
+}
+func (UnimplementedInvoiceServiceServer) DeleteTaxRate(context.Context, *DeleteTaxRateRequest) (*DeleteTaxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaxRate not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetCurrencySettings(context.Context, *GetCurrencySettingsRequest) (*GetCurrencySettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencySettings not implemented")
+}
+func (UnimplementedInvoiceServiceServer) UpdateCurrencySettings(context.Context, *UpdateCurrencySettingsRequest) (*UpdateCurrencySettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCurrencySettings not implemented")
+}
+func (UnimplementedInvoiceServiceServer) CreateExchangeRate(context.Context, *CreateExchangeRateRequest) (*CreateExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExchangeRate not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ListExchangeRates(context.Context, *ListExchangeRatesRequest) (*ListExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListExchangeRates not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ImportExchangeRates(context.Context, *ImportExchangeRatesRequest) (*ImportExchangeRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportExchangeRates not implemented")
+}
+func (UnimplementedInvoiceServiceServer) DeleteExchangeRate(context.Context, *DeleteExchangeRateRequest) (*DeleteExchangeRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExchangeRate not implemented")
+}
+func (UnimplementedInvoiceServiceServer) CreateRecurringInvoice(context.Context, *CreateRecurringInvoiceRequest) (*RecurringInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRecurringInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetRecurringInvoice(context.Context, *RecurringInvoiceRequest) (*RecurringInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecurringInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ListRecurringInvoices(context.Context, *ListRecurringInvoicesRequest) (*ListRecurringInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecurringInvoices not implemented")
+}
+func (UnimplementedInvoiceServiceServer) PauseRecurringInvoice(context.Context, *RecurringInvoiceRequest) (*RecurringInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseRecurringInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ResumeRecurringInvoice(context.Context, *RecurringInvoiceRequest) (*RecurringInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeRecurringInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) CancelRecurringInvoice(context.Context, *RecurringInvoiceRequest) (*RecurringInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecurringInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ListRecurringInvoiceInvoices(context.Context, *RecurringInvoiceRequest) (*ListInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRecurringInvoiceInvoices not implemented")
+}
+func (UnimplementedInvoiceServiceServer) CreateCreditNote(context.Context, *CreateCreditNoteRequest) (*CreditNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCreditNote not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetCreditNote(context.Context, *GetCreditNoteRequest) (*CreditNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditNote not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ListCreditNotes(context.Context, *ListCreditNotesRequest) (*ListCreditNotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCreditNotes not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ApplyCreditNote(context.Context, *ApplyCreditNoteRequest) (*CreditNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyCreditNote not implemented")
+}
+func (UnimplementedInvoiceServiceServer) RefundCreditNote(context.Context, *RefundCreditNoteRequest) (*CreditNoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundCreditNote not implemented")
+}
+func (UnimplementedInvoiceServiceServer) RenderInvoicePDF(context.Context, *RenderInvoicePDFRequest) (*RenderInvoicePDFResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderInvoicePDF not implemented")
+}
+func (UnimplementedInvoiceServiceServer) RenderInvoiceUBL(context.Context, *RenderInvoiceUBLRequest) (*RenderInvoiceUBLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderInvoiceUBL not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetNumberingScheme(context.Context, *GetNumberingSchemeRequest) (*NumberingSchemeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNumberingScheme not implemented")
+}
+func (UnimplementedInvoiceServiceServer) UpdateNumberingScheme(context.Context, *UpdateNumberingSchemeRequest) (*NumberingSchemeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNumberingScheme not implemented")
+}
+func (UnimplementedInvoiceServiceServer) CreateQuote(context.Context, *CreateQuoteRequest) (*QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuote not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetQuote(context.Context, *QuoteRequest) (*QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuote not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ListQuotes(context.Context, *ListQuotesRequest) (*ListQuotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuotes not implemented")
+}
+func (UnimplementedInvoiceServiceServer) MarkQuoteSent(context.Context, *QuoteRequest) (*QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkQuoteSent not implemented")
+}
+func (UnimplementedInvoiceServiceServer) AcceptQuote(context.Context, *QuoteRequest) (*QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptQuote not implemented")
+}
+func (UnimplementedInvoiceServiceServer) DeclineQuote(context.Context, *QuoteRequest) (*QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeclineQuote not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ConvertQuoteToInvoice(context.Context, *QuoteRequest) (*QuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertQuoteToInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) CreateInvoiceShare(context.Context, *CreateInvoiceShareRequest) (*InvoiceShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvoiceShare not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ListInvoiceShares(context.Context, *ListInvoiceSharesRequest) (*ListInvoiceSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvoiceShares not implemented")
+}
+func (UnimplementedInvoiceServiceServer) RevokeInvoiceShare(context.Context, *RevokeInvoiceShareRequest) (*InvoiceShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeInvoiceShare not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ViewSharedInvoice(context.Context, *ViewSharedInvoiceRequest) (*ViewSharedInvoiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ViewSharedInvoice not implemented")
+}
+func (UnimplementedInvoiceServiceServer) UploadAttachment(context.Context, *UploadAttachmentRequest) (*AttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadAttachment not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ListAttachments(context.Context, *ListAttachmentsRequest) (*ListAttachmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttachments not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetAttachment(context.Context, *AttachmentRequest) (*AttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttachment not implemented")
+}
+func (UnimplementedInvoiceServiceServer) DeleteAttachment(context.Context, *AttachmentRequest) (*AttachmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAttachment not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetLateFeePolicy(context.Context, *GetLateFeePolicyRequest) (*LateFeePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLateFeePolicy not implemented")
+}
+func (UnimplementedInvoiceServiceServer) SetLateFeePolicy(context.Context, *SetLateFeePolicyRequest) (*LateFeePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLateFeePolicy not implemented")
+}
+func (UnimplementedInvoiceServiceServer) DeleteLateFeePolicy(context.Context, *DeleteLateFeePolicyRequest) (*DeleteLateFeePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLateFeePolicy not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ListLateFees(context.Context, *ListLateFeesRequest) (*ListLateFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLateFees not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ReverseLateFee(context.Context, *ReverseLateFeeRequest) (*ReverseLateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseLateFee not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ImportInvoices(context.Context, *ImportInvoicesRequest) (*ImportInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportInvoices not implemented")
+}
+func (UnimplementedInvoiceServiceServer) SearchInvoices(context.Context, *SearchInvoicesRequest) (*SearchInvoicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchInvoices not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ListOutboxEvents(context.Context, *ListOutboxEventsRequest) (*ListOutboxEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOutboxEvents not implemented")
+}
+func (UnimplementedInvoiceServiceServer) RetryOutboxEvent(context.Context, *RetryOutboxEventRequest) (*OutboxEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryOutboxEvent not implemented")
+}
+func (UnimplementedInvoiceServiceServer) CreateBrandingProfile(context.Context, *BrandingProfileRequest) (*BrandingProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateBrandingProfile not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetBrandingProfile(context.Context, *GetBrandingProfileRequest) (*BrandingProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBrandingProfile not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ListBrandingProfiles(context.Context, *ListBrandingProfilesRequest) (*ListBrandingProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBrandingProfiles not implemented")
+}
+func (UnimplementedInvoiceServiceServer) UpdateBrandingProfile(context.Context, *BrandingProfileRequest) (*BrandingProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBrandingProfile not implemented")
+}
+func (UnimplementedInvoiceServiceServer) DeleteBrandingProfile(context.Context, *GetBrandingProfileRequest) (*BrandingProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBrandingProfile not implemented")
+}
+func (UnimplementedInvoiceServiceServer) SetBrandingProfileLogo(context.Context, *SetBrandingProfileLogoRequest) (*BrandingProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBrandingProfileLogo not implemented")
+}
+func (UnimplementedInvoiceServiceServer) CreateCatalogItem(context.Context, *CatalogItemRequest) (*CatalogItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCatalogItem not implemented")
+}
+func (UnimplementedInvoiceServiceServer) GetCatalogItem(context.Context, *GetCatalogItemRequest) (*CatalogItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCatalogItem not implemented")
+}
+func (UnimplementedInvoiceServiceServer) ListCatalogItems(context.Context, *ListCatalogItemsRequest) (*ListCatalogItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCatalogItems not implemented")
+}
+func (UnimplementedInvoiceServiceServer) UpdateCatalogItem(context.Context, *CatalogItemRequest) (*CatalogItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCatalogItem not implemented")
+}
+func (UnimplementedInvoiceServiceServer) DeleteCatalogItem(context.Context, *GetCatalogItemRequest) (*CatalogItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCatalogItem not implemented")
+}
+func (UnimplementedInvoiceServiceServer) mustEmbedUnimplementedInvoiceServiceServer() {}
+
+// UnsafeInvoiceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InvoiceServiceServer will
+// result in compilation errors.
+type UnsafeInvoiceServiceServer interface {
 	mustEmbedUnimplementedInvoiceServiceServer()
 }
 
-// UnimplementedInvoiceServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedInvoiceServiceServer struct {
+func RegisterInvoiceServiceServer(s grpc.ServiceRegistrar, srv InvoiceServiceServer) {
+	s.RegisterService(&InvoiceService_ServiceDesc, srv)
+}
+
+func _InvoiceService_CreateInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).CreateInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_CreateInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).CreateInvoice(ctx, req.(*CreateInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GetInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetInvoice(ctx, req.(*GetInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_UpdateInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).UpdateInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_UpdateInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).UpdateInvoice(ctx, req.(*UpdateInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ListInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ListInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ListInvoices(ctx, req.(*ListInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ScheduleInvoiceReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleInvoiceReminderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ScheduleInvoiceReminder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ScheduleInvoiceReminder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ScheduleInvoiceReminder(ctx, req.(*ScheduleInvoiceReminderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_SendInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).SendInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_SendInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).SendInvoice(ctx, req.(*SendInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_FinalizeInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FinalizeInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).FinalizeInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_FinalizeInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).FinalizeInvoice(ctx, req.(*FinalizeInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_VoidInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VoidInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).VoidInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_VoidInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).VoidInvoice(ctx, req.(*VoidInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_DeleteInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).DeleteInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_DeleteInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).DeleteInvoice(ctx, req.(*DeleteInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_DuplicateInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).DuplicateInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_DuplicateInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).DuplicateInvoice(ctx, req.(*DuplicateInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ArchiveInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ArchiveInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ArchiveInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ArchiveInvoice(ctx, req.(*ArchiveInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_UnarchiveInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).UnarchiveInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_UnarchiveInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).UnarchiveInvoice(ctx, req.(*ArchiveInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_MarkPaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkPaidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).MarkPaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_MarkPaid_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).MarkPaid(ctx, req.(*MarkPaidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_RecordPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).RecordPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_RecordPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).RecordPayment(ctx, req.(*RecordPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ListPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPaymentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ListPayments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ListPayments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ListPayments(ctx, req.(*ListPaymentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_RefundPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).RefundPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_RefundPayment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).RefundPayment(ctx, req.(*RefundPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_CreateTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).CreateTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_CreateTaxRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).CreateTaxRate(ctx, req.(*CreateTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ListTaxRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTaxRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ListTaxRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ListTaxRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ListTaxRates(ctx, req.(*ListTaxRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_UpdateTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).UpdateTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_UpdateTaxRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).UpdateTaxRate(ctx, req.(*UpdateTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_DeleteTaxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).DeleteTaxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_DeleteTaxRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).DeleteTaxRate(ctx, req.(*DeleteTaxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GetCurrencySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrencySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetCurrencySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetCurrencySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetCurrencySettings(ctx, req.(*GetCurrencySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_UpdateCurrencySettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCurrencySettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).UpdateCurrencySettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_UpdateCurrencySettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).UpdateCurrencySettings(ctx, req.(*UpdateCurrencySettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_CreateExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).CreateExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_CreateExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).CreateExchangeRate(ctx, req.(*CreateExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ListExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ListExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ListExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ListExchangeRates(ctx, req.(*ListExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ImportExchangeRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportExchangeRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ImportExchangeRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ImportExchangeRates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ImportExchangeRates(ctx, req.(*ImportExchangeRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_DeleteExchangeRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExchangeRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).DeleteExchangeRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_DeleteExchangeRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).DeleteExchangeRate(ctx, req.(*DeleteExchangeRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_CreateRecurringInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRecurringInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).CreateRecurringInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_CreateRecurringInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).CreateRecurringInvoice(ctx, req.(*CreateRecurringInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GetRecurringInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecurringInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetRecurringInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetRecurringInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetRecurringInvoice(ctx, req.(*RecurringInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ListRecurringInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRecurringInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ListRecurringInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ListRecurringInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ListRecurringInvoices(ctx, req.(*ListRecurringInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_PauseRecurringInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecurringInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).PauseRecurringInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_PauseRecurringInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).PauseRecurringInvoice(ctx, req.(*RecurringInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ResumeRecurringInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecurringInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ResumeRecurringInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ResumeRecurringInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ResumeRecurringInvoice(ctx, req.(*RecurringInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_CancelRecurringInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecurringInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).CancelRecurringInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_CancelRecurringInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).CancelRecurringInvoice(ctx, req.(*RecurringInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ListRecurringInvoiceInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecurringInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ListRecurringInvoiceInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ListRecurringInvoiceInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ListRecurringInvoiceInvoices(ctx, req.(*RecurringInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_CreateCreditNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCreditNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).CreateCreditNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_CreateCreditNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).CreateCreditNote(ctx, req.(*CreateCreditNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GetCreditNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreditNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetCreditNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetCreditNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetCreditNote(ctx, req.(*GetCreditNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ListCreditNotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCreditNotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ListCreditNotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ListCreditNotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ListCreditNotes(ctx, req.(*ListCreditNotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ApplyCreditNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyCreditNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ApplyCreditNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ApplyCreditNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ApplyCreditNote(ctx, req.(*ApplyCreditNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_RefundCreditNote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundCreditNoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).RefundCreditNote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_RefundCreditNote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).RefundCreditNote(ctx, req.(*RefundCreditNoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_RenderInvoicePDF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderInvoicePDFRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).RenderInvoicePDF(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_RenderInvoicePDF_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).RenderInvoicePDF(ctx, req.(*RenderInvoicePDFRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_RenderInvoiceUBL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderInvoiceUBLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).RenderInvoiceUBL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_RenderInvoiceUBL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).RenderInvoiceUBL(ctx, req.(*RenderInvoiceUBLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GetNumberingScheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNumberingSchemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetNumberingScheme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetNumberingScheme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetNumberingScheme(ctx, req.(*GetNumberingSchemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_UpdateNumberingScheme_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNumberingSchemeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).UpdateNumberingScheme(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_UpdateNumberingScheme_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).UpdateNumberingScheme(ctx, req.(*UpdateNumberingSchemeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_CreateQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).CreateQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_CreateQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).CreateQuote(ctx, req.(*CreateQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GetQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetQuote(ctx, req.(*QuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ListQuotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ListQuotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ListQuotes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ListQuotes(ctx, req.(*ListQuotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_MarkQuoteSent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).MarkQuoteSent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_MarkQuoteSent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).MarkQuoteSent(ctx, req.(*QuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_AcceptQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).AcceptQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_AcceptQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).AcceptQuote(ctx, req.(*QuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_DeclineQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).DeclineQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_DeclineQuote_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).DeclineQuote(ctx, req.(*QuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ConvertQuoteToInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ConvertQuoteToInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ConvertQuoteToInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ConvertQuoteToInvoice(ctx, req.(*QuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_CreateInvoiceShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvoiceShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).CreateInvoiceShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_CreateInvoiceShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).CreateInvoiceShare(ctx, req.(*CreateInvoiceShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ListInvoiceShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvoiceSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ListInvoiceShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ListInvoiceShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ListInvoiceShares(ctx, req.(*ListInvoiceSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_RevokeInvoiceShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeInvoiceShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).RevokeInvoiceShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_RevokeInvoiceShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).RevokeInvoiceShare(ctx, req.(*RevokeInvoiceShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ViewSharedInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ViewSharedInvoiceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ViewSharedInvoice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ViewSharedInvoice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ViewSharedInvoice(ctx, req.(*ViewSharedInvoiceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_UploadAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UploadAttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).UploadAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_UploadAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).UploadAttachment(ctx, req.(*UploadAttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ListAttachments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAttachmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ListAttachments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ListAttachments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ListAttachments(ctx, req.(*ListAttachmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GetAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetAttachment(ctx, req.(*AttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_DeleteAttachment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttachmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).DeleteAttachment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_DeleteAttachment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).DeleteAttachment(ctx, req.(*AttachmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_GetLateFeePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLateFeePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetLateFeePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetLateFeePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetLateFeePolicy(ctx, req.(*GetLateFeePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_SetLateFeePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLateFeePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).SetLateFeePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_SetLateFeePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).SetLateFeePolicy(ctx, req.(*SetLateFeePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_DeleteLateFeePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLateFeePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).DeleteLateFeePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_DeleteLateFeePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).DeleteLateFeePolicy(ctx, req.(*DeleteLateFeePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ListLateFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLateFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ListLateFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ListLateFees_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ListLateFees(ctx, req.(*ListLateFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ReverseLateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseLateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ReverseLateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ReverseLateFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ReverseLateFee(ctx, req.(*ReverseLateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ImportInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ImportInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ImportInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ImportInvoices(ctx, req.(*ImportInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_SearchInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchInvoicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).SearchInvoices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_SearchInvoices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).SearchInvoices(ctx, req.(*SearchInvoicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_ListOutboxEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOutboxEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ListOutboxEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ListOutboxEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ListOutboxEvents(ctx, req.(*ListOutboxEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_RetryOutboxEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetryOutboxEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).RetryOutboxEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_RetryOutboxEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).RetryOutboxEvent(ctx, req.(*RetryOutboxEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InvoiceService_CreateBrandingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrandingProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).CreateBrandingProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_CreateBrandingProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).CreateBrandingProfile(ctx, req.(*BrandingProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (UnimplementedInvoiceServiceServer) CreateInvoice(context.Context, *CreateInvoiceRequest) (*CreateInvoiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateInvoice not implemented")
-}
-func (UnimplementedInvoiceServiceServer) GetInvoice(context.Context, *GetInvoiceRequest) (*GetInvoiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInvoice not implemented")
-}
-func (UnimplementedInvoiceServiceServer) UpdateInvoice(context.Context, *UpdateInvoiceRequest) (*UpdateInvoiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateInvoice not implemented")
-}
-func (UnimplementedInvoiceServiceServer) ListInvoices(context.Context, *ListInvoicesRequest) (*ListInvoicesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListInvoices not implemented")
-}
-func (UnimplementedInvoiceServiceServer) ScheduleInvoiceReminder(context.Context, *ScheduleInvoiceReminderRequest) (*ScheduleInvoiceReminderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScheduleInvoiceReminder not implemented")
+func _InvoiceService_GetBrandingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBrandingProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).GetBrandingProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_GetBrandingProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).GetBrandingProfile(ctx, req.(*GetBrandingProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedInvoiceServiceServer) SendInvoice(context.Context, *SendInvoiceRequest) (*SendInvoiceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendInvoice not implemented")
+
+func _InvoiceService_ListBrandingProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBrandingProfilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).ListBrandingProfiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_ListBrandingProfiles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).ListBrandingProfiles(ctx, req.(*ListBrandingProfilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedInvoiceServiceServer) mustEmbedUnimplementedInvoiceServiceServer() {}
 
-// UnsafeInvoiceServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to InvoiceServiceServer will
-// result in compilation errors.
-type UnsafeInvoiceServiceServer interface {
-	mustEmbedUnimplementedInvoiceServiceServer()
+func _InvoiceService_UpdateBrandingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BrandingProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).UpdateBrandingProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_UpdateBrandingProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).UpdateBrandingProfile(ctx, req.(*BrandingProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterInvoiceServiceServer(s grpc.ServiceRegistrar, srv InvoiceServiceServer) {
-	s.RegisterService(&InvoiceService_ServiceDesc, srv)
+func _InvoiceService_DeleteBrandingProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBrandingProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvoiceServiceServer).DeleteBrandingProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: InvoiceService_DeleteBrandingProfile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvoiceServiceServer).DeleteBrandingProfile(ctx, req.(*GetBrandingProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _InvoiceService_CreateInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateInvoiceRequest)
+func _InvoiceService_SetBrandingProfileLogo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBrandingProfileLogoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InvoiceServiceServer).CreateInvoice(ctx, in)
+		return srv.(InvoiceServiceServer).SetBrandingProfileLogo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InvoiceService_CreateInvoice_FullMethodName,
+		FullMethod: InvoiceService_SetBrandingProfileLogo_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InvoiceServiceServer).CreateInvoice(ctx, req.(*CreateInvoiceRequest))
+		return srv.(InvoiceServiceServer).SetBrandingProfileLogo(ctx, req.(*SetBrandingProfileLogoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InvoiceService_GetInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInvoiceRequest)
+func _InvoiceService_CreateCatalogItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InvoiceServiceServer).GetInvoice(ctx, in)
+		return srv.(InvoiceServiceServer).CreateCatalogItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InvoiceService_GetInvoice_FullMethodName,
+		FullMethod: InvoiceService_CreateCatalogItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InvoiceServiceServer).GetInvoice(ctx, req.(*GetInvoiceRequest))
+		return srv.(InvoiceServiceServer).CreateCatalogItem(ctx, req.(*CatalogItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InvoiceService_UpdateInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateInvoiceRequest)
+func _InvoiceService_GetCatalogItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCatalogItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InvoiceServiceServer).UpdateInvoice(ctx, in)
+		return srv.(InvoiceServiceServer).GetCatalogItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InvoiceService_UpdateInvoice_FullMethodName,
+		FullMethod: InvoiceService_GetCatalogItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InvoiceServiceServer).UpdateInvoice(ctx, req.(*UpdateInvoiceRequest))
+		return srv.(InvoiceServiceServer).GetCatalogItem(ctx, req.(*GetCatalogItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InvoiceService_ListInvoices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListInvoicesRequest)
+func _InvoiceService_ListCatalogItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCatalogItemsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InvoiceServiceServer).ListInvoices(ctx, in)
+		return srv.(InvoiceServiceServer).ListCatalogItems(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InvoiceService_ListInvoices_FullMethodName,
+		FullMethod: InvoiceService_ListCatalogItems_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InvoiceServiceServer).ListInvoices(ctx, req.(*ListInvoicesRequest))
+		return srv.(InvoiceServiceServer).ListCatalogItems(ctx, req.(*ListCatalogItemsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InvoiceService_ScheduleInvoiceReminder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ScheduleInvoiceReminderRequest)
+func _InvoiceService_UpdateCatalogItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InvoiceServiceServer).ScheduleInvoiceReminder(ctx, in)
+		return srv.(InvoiceServiceServer).UpdateCatalogItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InvoiceService_ScheduleInvoiceReminder_FullMethodName,
+		FullMethod: InvoiceService_UpdateCatalogItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InvoiceServiceServer).ScheduleInvoiceReminder(ctx, req.(*ScheduleInvoiceReminderRequest))
+		return srv.(InvoiceServiceServer).UpdateCatalogItem(ctx, req.(*CatalogItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _InvoiceService_SendInvoice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SendInvoiceRequest)
+func _InvoiceService_DeleteCatalogItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCatalogItemRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(InvoiceServiceServer).SendInvoice(ctx, in)
+		return srv.(InvoiceServiceServer).DeleteCatalogItem(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: InvoiceService_SendInvoice_FullMethodName,
+		FullMethod: InvoiceService_DeleteCatalogItem_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(InvoiceServiceServer).SendInvoice(ctx, req.(*SendInvoiceRequest))
+		return srv.(InvoiceServiceServer).DeleteCatalogItem(ctx, req.(*GetCatalogItemRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -288,6 +2631,290 @@ var InvoiceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendInvoice",
 			Handler:    _InvoiceService_SendInvoice_Handler,
 		},
+		{
+			MethodName: "FinalizeInvoice",
+			Handler:    _InvoiceService_FinalizeInvoice_Handler,
+		},
+		{
+			MethodName: "VoidInvoice",
+			Handler:    _InvoiceService_VoidInvoice_Handler,
+		},
+		{
+			MethodName: "DeleteInvoice",
+			Handler:    _InvoiceService_DeleteInvoice_Handler,
+		},
+		{
+			MethodName: "DuplicateInvoice",
+			Handler:    _InvoiceService_DuplicateInvoice_Handler,
+		},
+		{
+			MethodName: "ArchiveInvoice",
+			Handler:    _InvoiceService_ArchiveInvoice_Handler,
+		},
+		{
+			MethodName: "UnarchiveInvoice",
+			Handler:    _InvoiceService_UnarchiveInvoice_Handler,
+		},
+		{
+			MethodName: "MarkPaid",
+			Handler:    _InvoiceService_MarkPaid_Handler,
+		},
+		{
+			MethodName: "RecordPayment",
+			Handler:    _InvoiceService_RecordPayment_Handler,
+		},
+		{
+			MethodName: "ListPayments",
+			Handler:    _InvoiceService_ListPayments_Handler,
+		},
+		{
+			MethodName: "RefundPayment",
+			Handler:    _InvoiceService_RefundPayment_Handler,
+		},
+		{
+			MethodName: "CreateTaxRate",
+			Handler:    _InvoiceService_CreateTaxRate_Handler,
+		},
+		{
+			MethodName: "ListTaxRates",
+			Handler:    _InvoiceService_ListTaxRates_Handler,
+		},
+		{
+			MethodName: "UpdateTaxRate",
+			Handler:    _InvoiceService_UpdateTaxRate_Handler,
+		},
+		{
+			MethodName: "DeleteTaxRate",
+			Handler:    _InvoiceService_DeleteTaxRate_Handler,
+		},
+		{
+			MethodName: "GetCurrencySettings",
+			Handler:    _InvoiceService_GetCurrencySettings_Handler,
+		},
+		{
+			MethodName: "UpdateCurrencySettings",
+			Handler:    _InvoiceService_UpdateCurrencySettings_Handler,
+		},
+		{
+			MethodName: "CreateExchangeRate",
+			Handler:    _InvoiceService_CreateExchangeRate_Handler,
+		},
+		{
+			MethodName: "ListExchangeRates",
+			Handler:    _InvoiceService_ListExchangeRates_Handler,
+		},
+		{
+			MethodName: "ImportExchangeRates",
+			Handler:    _InvoiceService_ImportExchangeRates_Handler,
+		},
+		{
+			MethodName: "DeleteExchangeRate",
+			Handler:    _InvoiceService_DeleteExchangeRate_Handler,
+		},
+		{
+			MethodName: "CreateRecurringInvoice",
+			Handler:    _InvoiceService_CreateRecurringInvoice_Handler,
+		},
+		{
+			MethodName: "GetRecurringInvoice",
+			Handler:    _InvoiceService_GetRecurringInvoice_Handler,
+		},
+		{
+			MethodName: "ListRecurringInvoices",
+			Handler:    _InvoiceService_ListRecurringInvoices_Handler,
+		},
+		{
+			MethodName: "PauseRecurringInvoice",
+			Handler:    _InvoiceService_PauseRecurringInvoice_Handler,
+		},
+		{
+			MethodName: "ResumeRecurringInvoice",
+			Handler:    _InvoiceService_ResumeRecurringInvoice_Handler,
+		},
+		{
+			MethodName: "CancelRecurringInvoice",
+			Handler:    _InvoiceService_CancelRecurringInvoice_Handler,
+		},
+		{
+			MethodName: "ListRecurringInvoiceInvoices",
+			Handler:    _InvoiceService_ListRecurringInvoiceInvoices_Handler,
+		},
+		{
+			MethodName: "CreateCreditNote",
+			Handler:    _InvoiceService_CreateCreditNote_Handler,
+		},
+		{
+			MethodName: "GetCreditNote",
+			Handler:    _InvoiceService_GetCreditNote_Handler,
+		},
+		{
+			MethodName: "ListCreditNotes",
+			Handler:    _InvoiceService_ListCreditNotes_Handler,
+		},
+		{
+			MethodName: "ApplyCreditNote",
+			Handler:    _InvoiceService_ApplyCreditNote_Handler,
+		},
+		{
+			MethodName: "RefundCreditNote",
+			Handler:    _InvoiceService_RefundCreditNote_Handler,
+		},
+		{
+			MethodName: "RenderInvoicePDF",
+			Handler:    _InvoiceService_RenderInvoicePDF_Handler,
+		},
+		{
+			MethodName: "RenderInvoiceUBL",
+			Handler:    _InvoiceService_RenderInvoiceUBL_Handler,
+		},
+		{
+			MethodName: "GetNumberingScheme",
+			Handler:    _InvoiceService_GetNumberingScheme_Handler,
+		},
+		{
+			MethodName: "UpdateNumberingScheme",
+			Handler:    _InvoiceService_UpdateNumberingScheme_Handler,
+		},
+		{
+			MethodName: "CreateQuote",
+			Handler:    _InvoiceService_CreateQuote_Handler,
+		},
+		{
+			MethodName: "GetQuote",
+			Handler:    _InvoiceService_GetQuote_Handler,
+		},
+		{
+			MethodName: "ListQuotes",
+			Handler:    _InvoiceService_ListQuotes_Handler,
+		},
+		{
+			MethodName: "MarkQuoteSent",
+			Handler:    _InvoiceService_MarkQuoteSent_Handler,
+		},
+		{
+			MethodName: "AcceptQuote",
+			Handler:    _InvoiceService_AcceptQuote_Handler,
+		},
+		{
+			MethodName: "DeclineQuote",
+			Handler:    _InvoiceService_DeclineQuote_Handler,
+		},
+		{
+			MethodName: "ConvertQuoteToInvoice",
+			Handler:    _InvoiceService_ConvertQuoteToInvoice_Handler,
+		},
+		{
+			MethodName: "CreateInvoiceShare",
+			Handler:    _InvoiceService_CreateInvoiceShare_Handler,
+		},
+		{
+			MethodName: "ListInvoiceShares",
+			Handler:    _InvoiceService_ListInvoiceShares_Handler,
+		},
+		{
+			MethodName: "RevokeInvoiceShare",
+			Handler:    _InvoiceService_RevokeInvoiceShare_Handler,
+		},
+		{
+			MethodName: "ViewSharedInvoice",
+			Handler:    _InvoiceService_ViewSharedInvoice_Handler,
+		},
+		{
+			MethodName: "UploadAttachment",
+			Handler:    _InvoiceService_UploadAttachment_Handler,
+		},
+		{
+			MethodName: "ListAttachments",
+			Handler:    _InvoiceService_ListAttachments_Handler,
+		},
+		{
+			MethodName: "GetAttachment",
+			Handler:    _InvoiceService_GetAttachment_Handler,
+		},
+		{
+			MethodName: "DeleteAttachment",
+			Handler:    _InvoiceService_DeleteAttachment_Handler,
+		},
+		{
+			MethodName: "GetLateFeePolicy",
+			Handler:    _InvoiceService_GetLateFeePolicy_Handler,
+		},
+		{
+			MethodName: "SetLateFeePolicy",
+			Handler:    _InvoiceService_SetLateFeePolicy_Handler,
+		},
+		{
+			MethodName: "DeleteLateFeePolicy",
+			Handler:    _InvoiceService_DeleteLateFeePolicy_Handler,
+		},
+		{
+			MethodName: "ListLateFees",
+			Handler:    _InvoiceService_ListLateFees_Handler,
+		},
+		{
+			MethodName: "ReverseLateFee",
+			Handler:    _InvoiceService_ReverseLateFee_Handler,
+		},
+		{
+			MethodName: "ImportInvoices",
+			Handler:    _InvoiceService_ImportInvoices_Handler,
+		},
+		{
+			MethodName: "SearchInvoices",
+			Handler:    _InvoiceService_SearchInvoices_Handler,
+		},
+		{
+			MethodName: "ListOutboxEvents",
+			Handler:    _InvoiceService_ListOutboxEvents_Handler,
+		},
+		{
+			MethodName: "RetryOutboxEvent",
+			Handler:    _InvoiceService_RetryOutboxEvent_Handler,
+		},
+		{
+			MethodName: "CreateBrandingProfile",
+			Handler:    _InvoiceService_CreateBrandingProfile_Handler,
+		},
+		{
+			MethodName: "GetBrandingProfile",
+			Handler:    _InvoiceService_GetBrandingProfile_Handler,
+		},
+		{
+			MethodName: "ListBrandingProfiles",
+			Handler:    _InvoiceService_ListBrandingProfiles_Handler,
+		},
+		{
+			MethodName: "UpdateBrandingProfile",
+			Handler:    _InvoiceService_UpdateBrandingProfile_Handler,
+		},
+		{
+			MethodName: "DeleteBrandingProfile",
+			Handler:    _InvoiceService_DeleteBrandingProfile_Handler,
+		},
+		{
+			MethodName: "SetBrandingProfileLogo",
+			Handler:    _InvoiceService_SetBrandingProfileLogo_Handler,
+		},
+		{
+			MethodName: "CreateCatalogItem",
+			Handler:    _InvoiceService_CreateCatalogItem_Handler,
+		},
+		{
+			MethodName: "GetCatalogItem",
+			Handler:    _InvoiceService_GetCatalogItem_Handler,
+		},
+		{
+			MethodName: "ListCatalogItems",
+			Handler:    _InvoiceService_ListCatalogItems_Handler,
+		},
+		{
+			MethodName: "UpdateCatalogItem",
+			Handler:    _InvoiceService_UpdateCatalogItem_Handler,
+		},
+		{
+			MethodName: "DeleteCatalogItem",
+			Handler:    _InvoiceService_DeleteCatalogItem_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "invoice-service/proto/invoice.proto",
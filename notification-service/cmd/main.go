@@ -68,7 +68,8 @@ func main() {
 	)
 	handler := handler.NewNotificationHandler(emailSender)
 
-	grpcServer := grpc.NewServer()
+	// Emails can carry attachments larger than the default 4 MB gRPC message limit
+	grpcServer := grpc.NewServer(grpc.MaxRecvMsgSize(32 << 20))
 	reflection.Register(grpcServer)
 	pb.RegisterNotificationServiceServer(grpcServer, handler)
 
@@ -1,8 +1,15 @@
 package email
 
 import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
 	"log"
+	"mime"
+	"mime/multipart"
 	"net/smtp"
+	"net/textproto"
 )
 
 type EmailSender struct {
@@ -21,7 +28,17 @@ func NewEmailSender(host, port, username, password string) *EmailSender {
 	}
 }
 
-func (e *EmailSender) SendEmail(to string, subject string, body string) error {
+// Attachment is a file sent along with an email. An attachment with a content ID is shown inline in the HTML
+// body, which refers to it as cid:<ContentID>.
+type Attachment struct {
+	Filename    string
+	ContentType string
+	Content     []byte
+	ContentID   string
+}
+
+// SendEmail sends body to a single recipient, along with an HTML version of it if html isn't empty.
+func (e *EmailSender) SendEmail(to string, subject string, body string, html string, attachments ...Attachment) error {
 	from := e.username
 	pass := e.password
 	smtpHost := e.host
@@ -31,6 +48,13 @@ func (e *EmailSender) SendEmail(to string, subject string, body string) error {
 		"To: " + to + "\n" +
 		"Subject: " + subject + "\n\n" +
 		body
+	if html != "" || len(attachments) > 0 {
+		mixed, err := multipartMessage(from, to, subject, body, html, attachments)
+		if err != nil {
+			return err
+		}
+		msg = mixed
+	}
 
 	err := smtp.SendMail(smtpHost+":"+smtpPort,
 		smtp.PlainAuth("", from, pass, smtpHost),
@@ -44,3 +68,149 @@ func (e *EmailSender) SendEmail(to string, subject string, body string) error {
 	log.Print("Email sent successfully to ", to)
 	return nil
 }
+
+// multipartMessage builds a multipart/mixed message with the body as its first part and each attachment
+// base64-encoded after it. With an HTML version, the body is a multipart/alternative of the plain text and the
+// HTML, which is wrapped in a multipart/related along with the inline attachments.
+func multipartMessage(from, to, subject, body, html string, attachments []Attachment) (string, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	fmt.Fprintf(&buf, "From: %s\r\nTo: %s\r\nSubject: %s\r\nMIME-Version: 1.0\r\n", from, to, mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n\r\n", writer.Boundary())
+
+	var inline, attached []Attachment
+	for _, attachment := range attachments {
+		if html != "" && attachment.ContentID != "" {
+			inline = append(inline, attachment)
+		} else {
+			attached = append(attached, attachment)
+		}
+	}
+
+	var err error
+	if html == "" {
+		err = writeTextPart(writer, "text/plain; charset=utf-8", body)
+	} else {
+		err = writeAlternativeParts(writer, body, html, inline)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	for _, attachment := range attached {
+		err = writeAttachmentPart(writer, attachment, "attachment")
+		if err != nil {
+			return "", err
+		}
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// writeAlternativeParts writes a multipart/alternative part holding the plain text body and the HTML body, the
+// latter along with its inline attachments.
+func writeAlternativeParts(writer *multipart.Writer, body, html string, inline []Attachment) error {
+	alternative, err := createNestedPart(writer, "multipart/alternative")
+	if err != nil {
+		return err
+	}
+	err = writeTextPart(alternative, "text/plain; charset=utf-8", body)
+	if err != nil {
+		return err
+	}
+
+	if len(inline) == 0 {
+		err = writeTextPart(alternative, "text/html; charset=utf-8", html)
+	} else {
+		err = writeRelatedParts(alternative, html, inline)
+	}
+	if err != nil {
+		return err
+	}
+
+	return alternative.Close()
+}
+
+// writeRelatedParts writes a multipart/related part holding the HTML body and the attachments it shows inline.
+func writeRelatedParts(writer *multipart.Writer, html string, inline []Attachment) error {
+	related, err := createNestedPart(writer, `multipart/related; type="text/html"`)
+	if err != nil {
+		return err
+	}
+	err = writeTextPart(related, "text/html; charset=utf-8", html)
+	if err != nil {
+		return err
+	}
+	for _, attachment := range inline {
+		err = writeAttachmentPart(related, attachment, "inline")
+		if err != nil {
+			return err
+		}
+	}
+
+	return related.Close()
+}
+
+// createNestedPart creates a multipart part of writer and returns a writer for its own parts.
+func createNestedPart(writer *multipart.Writer, contentType string) (*multipart.Writer, error) {
+	boundary := multipart.NewWriter(io.Discard).Boundary()
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type": {fmt.Sprintf("%s; boundary=%q", contentType, boundary)},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	nested := multipart.NewWriter(part)
+	err = nested.SetBoundary(boundary)
+	if err != nil {
+		return nil, err
+	}
+	return nested, nil
+}
+
+func writeTextPart(writer *multipart.Writer, contentType, text string) error {
+	part, err := writer.CreatePart(textproto.MIMEHeader{"Content-Type": {contentType}})
+	if err != nil {
+		return err
+	}
+	_, err = part.Write([]byte(text))
+	return err
+}
+
+// writeAttachmentPart writes an attachment base64-encoded, with the given disposition.
+func writeAttachmentPart(writer *multipart.Writer, attachment Attachment, disposition string) error {
+	contentType := attachment.ContentType
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	header := textproto.MIMEHeader{
+		"Content-Type":              {contentType},
+		"Content-Transfer-Encoding": {"base64"},
+		"Content-Disposition":       {mime.FormatMediaType(disposition, map[string]string{"filename": attachment.Filename})},
+	}
+	if disposition == "inline" {
+		header.Set("Content-Id", "<"+attachment.ContentID+">")
+	}
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return err
+	}
+
+	// Wrap the encoded content at 76 characters a line, as MIME requires
+	encoded := base64.StdEncoding.EncodeToString(attachment.Content)
+	for len(encoded) > 76 {
+		_, err = part.Write([]byte(encoded[:76] + "\r\n"))
+		if err != nil {
+			return err
+		}
+		encoded = encoded[76:]
+	}
+	_, err = part.Write([]byte(encoded))
+	return err
+}
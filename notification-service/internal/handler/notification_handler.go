@@ -21,8 +21,17 @@ func NewNotificationHandler(emailSender *email.EmailSender) *NotificationHandler
 func (s *NotificationHandler) SendNotification(ctx context.Context, req *pb.SendNotificationRequest) (*pb.SendNotificationResponse, error) {
 	log.Printf("Received request to send notification to: %s", req.Email)
 
-	// Send the email
-	err := s.emailSender.SendEmail(req.Email, req.Subject, req.Message)
+	// Send the email with any HTML version and attachments
+	attachments := make([]email.Attachment, len(req.Attachments))
+	for i, attachment := range req.Attachments {
+		attachments[i] = email.Attachment{
+			Filename:    attachment.Filename,
+			ContentType: attachment.ContentType,
+			Content:     attachment.Content,
+			ContentID:   attachment.ContentId,
+		}
+	}
+	err := s.emailSender.SendEmail(req.Email, req.Subject, req.Message, req.HtmlMessage, attachments...)
 	if err != nil {
 		return nil, err
 	}
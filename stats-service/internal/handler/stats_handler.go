@@ -22,15 +22,38 @@ func (h *StatsHandler) GetStats(ctx context.Context, req *pb.GetStatsRequest) (*
 		return nil, err
 	}
 
+	currencies := make([]*pb.CurrencyStats, len(stats.Currencies))
+	for i, currencyStats := range stats.Currencies {
+		currencies[i] = &pb.CurrencyStats{
+			Currency:                 currencyStats.Currency,
+			TotalInvoices:            currencyStats.TotalInvoices,
+			TotalAmountPaid:          currencyStats.TotalAmountPaid,
+			TotalAmountPartiallyPaid: currencyStats.TotalAmountPartiallyPaid,
+			TotalAmountOverdue:       currencyStats.TotalAmountOverdue,
+			TotalAmountDraft:         currencyStats.TotalAmountDraft,
+			TotalAmountUnpaid:        currencyStats.TotalAmountUnpaid,
+			TotalAmountVoid:          currencyStats.TotalAmountVoid,
+			TotalAmountCollected:     currencyStats.TotalAmountCollected,
+		}
+	}
+
 	return &pb.GetStatsResponse{
-		TotalInvoices:        stats.TotalInvoices,
-		TotalPaidInvoices:    stats.TotalPaidInvoices,
-		TotalOverdueInvoices: stats.TotalOverdueInvoices,
-		TotalDraftInvoices:   stats.TotalDraftInvoices,
-		TotalUnpaidInvoices:  stats.TotalUnpaidInvoices,
-		TotalAmountPaid:      stats.TotalAmountPaid,
-		TotalAmountOverdue:   stats.TotalAmountOverdue,
-		TotalAmountDraft:     stats.TotalAmountDraft,
-		TotalAmountUnpaid:    stats.TotalAmountUnpaid,
+		TotalInvoices:              stats.TotalInvoices,
+		TotalPaidInvoices:          stats.TotalPaidInvoices,
+		TotalPartiallyPaidInvoices: stats.TotalPartiallyPaidInvoices,
+		TotalOverdueInvoices:       stats.TotalOverdueInvoices,
+		TotalDraftInvoices:         stats.TotalDraftInvoices,
+		TotalUnpaidInvoices:        stats.TotalUnpaidInvoices,
+		TotalVoidInvoices:          stats.TotalVoidInvoices,
+		TotalAmountPaid:            stats.TotalAmountPaid,
+		TotalAmountPartiallyPaid:   stats.TotalAmountPartiallyPaid,
+		TotalAmountOverdue:         stats.TotalAmountOverdue,
+		TotalAmountDraft:           stats.TotalAmountDraft,
+		TotalAmountUnpaid:          stats.TotalAmountUnpaid,
+		TotalAmountVoid:            stats.TotalAmountVoid,
+		TotalAmountCollected:       stats.TotalAmountCollected,
+		BaseCurrency:               stats.BaseCurrency,
+		UnconvertedInvoices:        stats.UnconvertedInvoices,
+		Currencies:                 currencies,
 	}, nil
 }
@@ -1,14 +1,40 @@
 package models
 
 type Stats struct {
-	TotalInvoices        int64
-	TotalPaidInvoices    int64
-	TotalOverdueInvoices int64
-	TotalDraftInvoices   int64
-	TotalUnpaidInvoices  int64
-
-	TotalAmountPaid    int64
-	TotalAmountOverdue int64
-	TotalAmountDraft   int64
-	TotalAmountUnpaid  int64
+	TotalInvoices              int64
+	TotalPaidInvoices          int64
+	TotalPartiallyPaidInvoices int64
+	TotalOverdueInvoices       int64
+	TotalDraftInvoices         int64
+	TotalUnpaidInvoices        int64
+	TotalVoidInvoices          int64
+
+	// Amounts in BaseCurrency
+	TotalAmountPaid          int64
+	TotalAmountPartiallyPaid int64
+	TotalAmountOverdue       int64
+	TotalAmountDraft         int64
+	TotalAmountUnpaid        int64
+	TotalAmountVoid          int64
+
+	TotalAmountCollected int64
+
+	BaseCurrency        string
+	UnconvertedInvoices int64
+	Currencies          []*CurrencyStats
+}
+
+// CurrencyStats holds the amounts for the invoices in one currency, in that currency.
+type CurrencyStats struct {
+	Currency      string
+	TotalInvoices int64
+
+	TotalAmountPaid          int64
+	TotalAmountPartiallyPaid int64
+	TotalAmountOverdue       int64
+	TotalAmountDraft         int64
+	TotalAmountUnpaid        int64
+	TotalAmountVoid          int64
+
+	TotalAmountCollected int64
 }
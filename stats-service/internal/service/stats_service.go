@@ -2,12 +2,17 @@ package service
 
 import (
 	"context"
+	"sort"
 
 	invoicepb "github.com/emzola/numer/invoice-service/proto"
 	"github.com/emzola/numer/stats-service/internal/models"
+	"github.com/shopspring/decimal"
 	"google.golang.org/grpc"
 )
 
+// listPageSize is the number of invoices fetched from the invoice service per request.
+const listPageSize = 100
+
 // type statsClient interface {
 // 	GetAllInvoices(ctx context.Context) ([]*invoicepb.Invoice, error)
 // }
@@ -21,49 +26,141 @@ func NewStatsService(conn *grpc.ClientConn) *StatsService {
 }
 
 func (s *StatsService) GetStats(ctx context.Context, userId int64) (*models.Stats, error) {
-	grpcReq := &invoicepb.ListInvoicesRequest{
-		UserId: userId,
-	}
 	client := invoicepb.NewInvoiceServiceClient(s.conn)
-	response, err := client.ListInvoices(ctx, grpcReq)
+
+	settings, err := client.GetCurrencySettings(ctx, &invoicepb.GetCurrencySettingsRequest{UserId: userId})
 	if err != nil {
 		return nil, err
 	}
 
-	invoices := response.Invoices
+	invoices, err := listAllInvoices(ctx, client, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Without a base currency, an account that only bills in one currency reports in that currency
+	baseCurrency := settings.BaseCurrency
+	if baseCurrency == "" {
+		baseCurrency = singleCurrency(invoices)
+	}
 
-	var totalPaid, totalOverdue, totalDraft, totalUnpaid int64
-	var totalAmountPaid, totalAmountOverdue, totalAmountDraft, totalAmountUnpaid int64
+	stats := &models.Stats{
+		TotalInvoices: int64(len(invoices)),
+		BaseCurrency:  baseCurrency,
+	}
+	byCurrency := map[string]*models.CurrencyStats{}
 
 	for _, invoice := range invoices {
+		currencyStats, ok := byCurrency[invoice.Currency]
+		if !ok {
+			currencyStats = &models.CurrencyStats{Currency: invoice.Currency}
+			byCurrency[invoice.Currency] = currencyStats
+			stats.Currencies = append(stats.Currencies, currencyStats)
+		}
+		currencyStats.TotalInvoices++
+		currencyStats.TotalAmountCollected += invoice.AmountPaid
+
+		rate, converted := baseRate(invoice, baseCurrency)
+		if !converted {
+			stats.UnconvertedInvoices++
+		}
+		toBase := func(amount int64) int64 {
+			if !converted {
+				return 0
+			}
+			return decimal.NewFromInt(amount).Mul(rate).Round(0).IntPart()
+		}
+		stats.TotalAmountCollected += toBase(invoice.AmountPaid)
+
+		// Every invoice status has a bucket, so the invoice counts add up to TotalInvoices
 		switch invoice.Status {
 		case "paid":
-			totalPaid++
-			totalAmountPaid += invoice.Total
+			stats.TotalPaidInvoices++
+			stats.TotalAmountPaid += toBase(invoice.Total)
+			currencyStats.TotalAmountPaid += invoice.Total
+		case "partially_paid":
+			stats.TotalPartiallyPaidInvoices++
+			stats.TotalAmountPartiallyPaid += toBase(invoice.Total)
+			currencyStats.TotalAmountPartiallyPaid += invoice.Total
 		case "overdue":
-			totalOverdue++
-			totalAmountOverdue += invoice.Total
+			stats.TotalOverdueInvoices++
+			stats.TotalAmountOverdue += toBase(invoice.Total)
+			currencyStats.TotalAmountOverdue += invoice.Total
 		case "draft":
-			totalDraft++
-			totalAmountDraft += invoice.Total
+			stats.TotalDraftInvoices++
+			stats.TotalAmountDraft += toBase(invoice.Total)
+			currencyStats.TotalAmountDraft += invoice.Total
 		case "unpaid":
-			totalUnpaid++
-			totalAmountUnpaid += invoice.Total
+			stats.TotalUnpaidInvoices++
+			stats.TotalAmountUnpaid += toBase(invoice.Total)
+			currencyStats.TotalAmountUnpaid += invoice.Total
+		case "void":
+			stats.TotalVoidInvoices++
+			stats.TotalAmountVoid += toBase(invoice.Total)
+			currencyStats.TotalAmountVoid += invoice.Total
 		}
 	}
 
-	stats := &models.Stats{
-		TotalInvoices:        int64(len(invoices)),
-		TotalPaidInvoices:    totalPaid,
-		TotalOverdueInvoices: totalOverdue,
-		TotalDraftInvoices:   totalDraft,
-		TotalUnpaidInvoices:  totalUnpaid,
-
-		TotalAmountPaid:    totalAmountPaid,
-		TotalAmountOverdue: totalAmountOverdue,
-		TotalAmountDraft:   totalAmountDraft,
-		TotalAmountUnpaid:  totalAmountUnpaid,
-	}
+	sort.Slice(stats.Currencies, func(i, j int) bool {
+		return stats.Currencies[i].Currency < stats.Currencies[j].Currency
+	})
 
 	return stats, nil
 }
+
+// listAllInvoices pages through every invoice of the user, archived ones included. Stats only need the totals, so
+// items are left out.
+func listAllInvoices(ctx context.Context, client invoicepb.InvoiceServiceClient, userId int64) ([]*invoicepb.Invoice, error) {
+	var invoices []*invoicepb.Invoice
+	pageToken := ""
+	for {
+		response, err := client.ListInvoices(ctx, &invoicepb.ListInvoicesRequest{
+			UserId:          userId,
+			PageSize:        listPageSize,
+			PageToken:       pageToken,
+			WithoutItems:    true,
+			IncludeArchived: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, response.Invoices...)
+
+		if response.NextPageToken == "" {
+			return invoices, nil
+		}
+		pageToken = response.NextPageToken
+	}
+}
+
+// baseRate returns the rate that converts the invoice's amounts into baseCurrency, and false if there is none:
+// the invoice is a draft in another currency or was issued under a different base currency.
+func baseRate(invoice *invoicepb.Invoice, baseCurrency string) (decimal.Decimal, bool) {
+	if baseCurrency == "" {
+		return decimal.Zero, false
+	}
+	if invoice.Currency == baseCurrency {
+		return decimal.NewFromInt(1), true
+	}
+	if invoice.BaseCurrency != baseCurrency || invoice.ExchangeRate == "" {
+		return decimal.Zero, false
+	}
+
+	rate, err := decimal.NewFromString(invoice.ExchangeRate)
+	if err != nil {
+		return decimal.Zero, false
+	}
+	return rate, true
+}
+
+// singleCurrency returns the currency shared by all the invoices, or an empty string if they use several.
+func singleCurrency(invoices []*invoicepb.Invoice) string {
+	var currency string
+	for _, invoice := range invoices {
+		if currency != "" && invoice.Currency != currency {
+			return ""
+		}
+		currency = invoice.Currency
+	}
+	return currency
+}
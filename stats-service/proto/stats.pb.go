@@ -77,10 +77,19 @@ type GetStatsResponse struct {
 	TotalOverdueInvoices int64 `protobuf:"varint,3,opt,name=total_overdue_invoices,json=totalOverdueInvoices,proto3" json:"total_overdue_invoices,omitempty"`
 	TotalDraftInvoices   int64 `protobuf:"varint,4,opt,name=total_draft_invoices,json=totalDraftInvoices,proto3" json:"total_draft_invoices,omitempty"`
 	TotalUnpaidInvoices  int64 `protobuf:"varint,5,opt,name=total_unpaid_invoices,json=totalUnpaidInvoices,proto3" json:"total_unpaid_invoices,omitempty"`
-	TotalAmountPaid      int64 `protobuf:"varint,6,opt,name=total_amount_paid,json=totalAmountPaid,proto3" json:"total_amount_paid,omitempty"`
-	TotalAmountOverdue   int64 `protobuf:"varint,7,opt,name=total_amount_overdue,json=totalAmountOverdue,proto3" json:"total_amount_overdue,omitempty"`
-	TotalAmountDraft     int64 `protobuf:"varint,8,opt,name=total_amount_draft,json=totalAmountDraft,proto3" json:"total_amount_draft,omitempty"`
-	TotalAmountUnpaid    int64 `protobuf:"varint,9,opt,name=total_amount_unpaid,json=totalAmountUnpaid,proto3" json:"total_amount_unpaid,omitempty"`
+	// Amounts are in base_currency, converted at the rate recorded when each invoice was issued
+	TotalAmountPaid            int64            `protobuf:"varint,6,opt,name=total_amount_paid,json=totalAmountPaid,proto3" json:"total_amount_paid,omitempty"`
+	TotalAmountOverdue         int64            `protobuf:"varint,7,opt,name=total_amount_overdue,json=totalAmountOverdue,proto3" json:"total_amount_overdue,omitempty"`
+	TotalAmountDraft           int64            `protobuf:"varint,8,opt,name=total_amount_draft,json=totalAmountDraft,proto3" json:"total_amount_draft,omitempty"`
+	TotalAmountUnpaid          int64            `protobuf:"varint,9,opt,name=total_amount_unpaid,json=totalAmountUnpaid,proto3" json:"total_amount_unpaid,omitempty"`
+	TotalAmountCollected       int64            `protobuf:"varint,10,opt,name=total_amount_collected,json=totalAmountCollected,proto3" json:"total_amount_collected,omitempty"` // Payments received across all invoices, net of refunds
+	BaseCurrency               string           `protobuf:"bytes,11,opt,name=base_currency,json=baseCurrency,proto3" json:"base_currency,omitempty"`
+	UnconvertedInvoices        int64            `protobuf:"varint,12,opt,name=unconverted_invoices,json=unconvertedInvoices,proto3" json:"unconverted_invoices,omitempty"` // Invoices left out of the base currency amounts for lack of a rate
+	Currencies                 []*CurrencyStats `protobuf:"bytes,13,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	TotalPartiallyPaidInvoices int64            `protobuf:"varint,14,opt,name=total_partially_paid_invoices,json=totalPartiallyPaidInvoices,proto3" json:"total_partially_paid_invoices,omitempty"`
+	TotalVoidInvoices          int64            `protobuf:"varint,15,opt,name=total_void_invoices,json=totalVoidInvoices,proto3" json:"total_void_invoices,omitempty"`
+	TotalAmountPartiallyPaid   int64            `protobuf:"varint,16,opt,name=total_amount_partially_paid,json=totalAmountPartiallyPaid,proto3" json:"total_amount_partially_paid,omitempty"`
+	TotalAmountVoid            int64            `protobuf:"varint,17,opt,name=total_amount_void,json=totalAmountVoid,proto3" json:"total_amount_void,omitempty"`
 }
 
 func (x *GetStatsResponse) Reset() {
@@ -178,6 +187,174 @@ func (x *GetStatsResponse) GetTotalAmountUnpaid() int64 {
 	return 0
 }
 
+func (x *GetStatsResponse) GetTotalAmountCollected() int64 {
+	if x != nil {
+		return x.TotalAmountCollected
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetBaseCurrency() string {
+	if x != nil {
+		return x.BaseCurrency
+	}
+	return ""
+}
+
+func (x *GetStatsResponse) GetUnconvertedInvoices() int64 {
+	if x != nil {
+		return x.UnconvertedInvoices
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetCurrencies() []*CurrencyStats {
+	if x != nil {
+		return x.Currencies
+	}
+	return nil
+}
+
+func (x *GetStatsResponse) GetTotalPartiallyPaidInvoices() int64 {
+	if x != nil {
+		return x.TotalPartiallyPaidInvoices
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetTotalVoidInvoices() int64 {
+	if x != nil {
+		return x.TotalVoidInvoices
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetTotalAmountPartiallyPaid() int64 {
+	if x != nil {
+		return x.TotalAmountPartiallyPaid
+	}
+	return 0
+}
+
+func (x *GetStatsResponse) GetTotalAmountVoid() int64 {
+	if x != nil {
+		return x.TotalAmountVoid
+	}
+	return 0
+}
+
+// Amounts for the invoices in one currency, in that currency
+type CurrencyStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Currency                 string `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	TotalInvoices            int64  `protobuf:"varint,2,opt,name=total_invoices,json=totalInvoices,proto3" json:"total_invoices,omitempty"`
+	TotalAmountPaid          int64  `protobuf:"varint,3,opt,name=total_amount_paid,json=totalAmountPaid,proto3" json:"total_amount_paid,omitempty"`
+	TotalAmountOverdue       int64  `protobuf:"varint,4,opt,name=total_amount_overdue,json=totalAmountOverdue,proto3" json:"total_amount_overdue,omitempty"`
+	TotalAmountDraft         int64  `protobuf:"varint,5,opt,name=total_amount_draft,json=totalAmountDraft,proto3" json:"total_amount_draft,omitempty"`
+	TotalAmountUnpaid        int64  `protobuf:"varint,6,opt,name=total_amount_unpaid,json=totalAmountUnpaid,proto3" json:"total_amount_unpaid,omitempty"`
+	TotalAmountCollected     int64  `protobuf:"varint,7,opt,name=total_amount_collected,json=totalAmountCollected,proto3" json:"total_amount_collected,omitempty"`
+	TotalAmountPartiallyPaid int64  `protobuf:"varint,8,opt,name=total_amount_partially_paid,json=totalAmountPartiallyPaid,proto3" json:"total_amount_partially_paid,omitempty"`
+	TotalAmountVoid          int64  `protobuf:"varint,9,opt,name=total_amount_void,json=totalAmountVoid,proto3" json:"total_amount_void,omitempty"`
+}
+
+func (x *CurrencyStats) Reset() {
+	*x = CurrencyStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_stats_service_proto_stats_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CurrencyStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStats) ProtoMessage() {}
+
+func (x *CurrencyStats) ProtoReflect() protoreflect.Message {
+	mi := &file_stats_service_proto_stats_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStats.ProtoReflect.Descriptor instead.
+func (*CurrencyStats) Descriptor() ([]byte, []int) {
+	return file_stats_service_proto_stats_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CurrencyStats) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CurrencyStats) GetTotalInvoices() int64 {
+	if x != nil {
+		return x.TotalInvoices
+	}
+	return 0
+}
+
+func (x *CurrencyStats) GetTotalAmountPaid() int64 {
+	if x != nil {
+		return x.TotalAmountPaid
+	}
+	return 0
+}
+
+func (x *CurrencyStats) GetTotalAmountOverdue() int64 {
+	if x != nil {
+		return x.TotalAmountOverdue
+	}
+	return 0
+}
+
+func (x *CurrencyStats) GetTotalAmountDraft() int64 {
+	if x != nil {
+		return x.TotalAmountDraft
+	}
+	return 0
+}
+
+func (x *CurrencyStats) GetTotalAmountUnpaid() int64 {
+	if x != nil {
+		return x.TotalAmountUnpaid
+	}
+	return 0
+}
+
+func (x *CurrencyStats) GetTotalAmountCollected() int64 {
+	if x != nil {
+		return x.TotalAmountCollected
+	}
+	return 0
+}
+
+func (x *CurrencyStats) GetTotalAmountPartiallyPaid() int64 {
+	if x != nil {
+		return x.TotalAmountPartiallyPaid
+	}
+	return 0
+}
+
+func (x *CurrencyStats) GetTotalAmountVoid() int64 {
+	if x != nil {
+		return x.TotalAmountVoid
+	}
+	return 0
+}
+
 var File_stats_service_proto_stats_proto protoreflect.FileDescriptor
 
 var file_stats_service_proto_stats_proto_rawDesc = []byte{
@@ -186,7 +363,7 @@ var file_stats_service_proto_stats_proto_rawDesc = []byte{
 	0x6f, 0x12, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0x2a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x53,
 	0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75,
 	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0xc1, 0x03, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x22, 0xe3, 0x06, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
 	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74,
 	0x61, 0x6c, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73,
@@ -214,14 +391,67 @@ var file_stats_service_proto_stats_proto_rawDesc = []byte{
 	0x75, 0x6e, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61,
 	0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x18,
 	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x55, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x32, 0x4b, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x74,
-	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73,
-	0x74, 0x61, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x16, 0x5a, 0x14, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2d,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x74, 0x55, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x12, 0x31, 0x0a, 0x14, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x64, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x13, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x65, 0x64, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x63, 0x69, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x41, 0x0a, 0x1d,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x6c, 0x79, 0x5f,
+	0x70, 0x61, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x1a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61,
+	0x6c, 0x6c, 0x79, 0x50, 0x61, 0x69, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x2e, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x6f, 0x69, 0x64, 0x5f, 0x69, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x56, 0x6f, 0x69, 0x64, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x3d, 0x0a, 0x1b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x6c, 0x79, 0x50, 0x61, 0x69, 0x64, 0x12, 0x2a,
+	0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x76,
+	0x6f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x69, 0x64, 0x22, 0xaf, 0x03, 0x0a, 0x0d, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x70, 0x61, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x76, 0x65, 0x72,
+	0x64, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x64, 0x75, 0x65, 0x12, 0x2c, 0x0a,
+	0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64, 0x72,
+	0x61, 0x66, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x72, 0x61, 0x66, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x70, 0x61,
+	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x12, 0x3d, 0x0a, 0x1b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x70, 0x61, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x18, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x41, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x6c, 0x79, 0x50, 0x61, 0x69, 0x64,
+	0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x76, 0x6f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x56, 0x6f, 0x69, 0x64, 0x32, 0x4b, 0x0a, 0x0c,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x08,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x17, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x16, 0x5a, 0x14, 0x2f, 0x73, 0x74,
+	0x61, 0x74, 0x73, 0x2d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -236,19 +466,21 @@ func file_stats_service_proto_stats_proto_rawDescGZIP() []byte {
 	return file_stats_service_proto_stats_proto_rawDescData
 }
 
-var file_stats_service_proto_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_stats_service_proto_stats_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_stats_service_proto_stats_proto_goTypes = []interface{}{
 	(*GetStatsRequest)(nil),  // 0: stats.GetStatsRequest
 	(*GetStatsResponse)(nil), // 1: stats.GetStatsResponse
+	(*CurrencyStats)(nil),    // 2: stats.CurrencyStats
 }
 var file_stats_service_proto_stats_proto_depIdxs = []int32{
-	0, // 0: stats.StatsService.GetStats:input_type -> stats.GetStatsRequest
-	1, // 1: stats.StatsService.GetStats:output_type -> stats.GetStatsResponse
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: stats.GetStatsResponse.currencies:type_name -> stats.CurrencyStats
+	0, // 1: stats.StatsService.GetStats:input_type -> stats.GetStatsRequest
+	1, // 2: stats.StatsService.GetStats:output_type -> stats.GetStatsResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_stats_service_proto_stats_proto_init() }
@@ -281,6 +513,18 @@ func file_stats_service_proto_stats_proto_init() {
 				return nil
 			}
 		}
+		file_stats_service_proto_stats_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CurrencyStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -288,7 +532,7 @@ func file_stats_service_proto_stats_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_stats_service_proto_stats_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 
 	"github.com/emzola/numer/user-service/internal/models"
 	"github.com/emzola/numer/user-service/internal/service"
@@ -41,9 +42,14 @@ func (h *UserHandler) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.
 
 func (h *UserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest) (*pb.UserResponse, error) {
 	user := &models.User{
-		ID:    req.UserId,
-		Email: req.Email,
-		Role:  req.Role,
+		ID:           req.UserId,
+		Email:        req.Email,
+		Role:         req.Role,
+		BusinessName: req.BusinessName,
+		Address:      req.Address,
+		Country:      req.Country,
+		TaxID:        req.TaxId,
+		PeppolID:     req.PeppolId,
 	}
 
 	if req.Password != "" {
@@ -52,6 +58,9 @@ func (h *UserHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequest)
 
 	err := h.userService.UpdateUser(ctx, user)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidRequest) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -88,14 +97,20 @@ func (h *UserHandler) AuthenticateUser(ctx context.Context, req *pb.Authenticate
 // Customer Endpoints
 func (h *UserHandler) CreateCustomer(ctx context.Context, req *pb.CreateCustomerRequest) (*pb.CustomerResponse, error) {
 	customer := &models.Customer{
-		UserID:  req.UserId,
-		Name:    req.Name,
-		Email:   req.Email,
-		Address: req.Address,
+		UserID:   req.UserId,
+		Name:     req.Name,
+		Email:    req.Email,
+		Address:  req.Address,
+		Country:  req.Country,
+		TaxID:    req.TaxId,
+		PeppolID: req.PeppolId,
 	}
 
 	customer, err := h.userService.CreateCustomer(ctx, customer)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidRequest) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -113,14 +128,20 @@ func (h *UserHandler) GetCustomer(ctx context.Context, req *pb.GetCustomerReques
 
 func (h *UserHandler) UpdateCustomer(ctx context.Context, req *pb.UpdateCustomerRequest) (*pb.CustomerResponse, error) {
 	customer := &models.Customer{
-		ID:      req.CustomerId,
-		Name:    req.Name,
-		Email:   req.Email,
-		Address: req.Address,
+		ID:       req.CustomerId,
+		Name:     req.Name,
+		Email:    req.Email,
+		Address:  req.Address,
+		Country:  req.Country,
+		TaxID:    req.TaxId,
+		PeppolID: req.PeppolId,
 	}
 
 	err := h.userService.UpdateCustomer(ctx, customer)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidRequest) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -135,3 +156,35 @@ func (h *UserHandler) DeleteCustomer(ctx context.Context, req *pb.DeleteCustomer
 
 	return &pb.DeleteCustomerResponse{Message: "customer successfully deleted"}, nil
 }
+
+func (h *UserHandler) ImportCustomers(ctx context.Context, req *pb.ImportCustomersRequest) (*pb.ImportCustomersResponse, error) {
+	customers, importErrors, err := h.userService.ImportCustomers(ctx, req.UserId, req.Data, req.DryRun)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRequest) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	protoCustomers := make([]*pb.Customer, len(customers))
+	for i, customer := range customers {
+		protoCustomers[i] = models.ConvertCustomerToProto(customer)
+	}
+
+	return &pb.ImportCustomersResponse{
+		Customers: protoCustomers,
+		Errors:    models.ConvertImportErrorsToProto(importErrors),
+	}, nil
+}
+
+func (h *UserHandler) SearchCustomers(ctx context.Context, req *pb.SearchCustomersRequest) (*pb.SearchCustomersResponse, error) {
+	results, err := h.userService.SearchCustomers(ctx, req.UserId, req.Query, int(req.Limit))
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidRequest) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.SearchCustomersResponse{Results: models.ConvertCustomerSearchResultsToProto(results)}, nil
+}
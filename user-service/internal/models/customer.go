@@ -8,6 +8,9 @@ type Customer struct {
 	Name      string    `db:"name"`
 	Email     string    `db:"email"`
 	Address   string    `db:"address"`
+	Country   string    `db:"country"`   // ISO 3166-1 alpha-2 code
+	TaxID     string    `db:"tax_id"`    // VAT or other tax registration number, prefixed with the country code
+	PeppolID  string    `db:"peppol_id"` // Peppol participant identifier, as scheme:identifier
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
 }
@@ -0,0 +1,7 @@
+package models
+
+// ImportError reports why a row of an import file was rejected.
+type ImportError struct {
+	Row     int // Line of the file, counting the header as line 1
+	Message string
+}
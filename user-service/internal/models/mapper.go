@@ -7,19 +7,49 @@ import (
 // ConvertUserToProto converts a Go model struct to protobuf User message.
 func ConvertUserToProto(user *User) *pb.User {
 	return &pb.User{
-		Id:    user.ID,
-		Email: user.Email,
-		Role:  user.Role,
+		Id:           user.ID,
+		Email:        user.Email,
+		Role:         user.Role,
+		BusinessName: user.BusinessName,
+		Address:      user.Address,
+		Country:      user.Country,
+		TaxId:        user.TaxID,
+		PeppolId:     user.PeppolID,
 	}
 }
 
 // ConvertUserToProto converts a Go model struct to protobuf User message.
 func ConvertCustomerToProto(customer *Customer) *pb.Customer {
 	return &pb.Customer{
-		Id:      customer.ID,
-		UserId:  customer.UserID,
-		Name:    customer.Name,
-		Email:   customer.Email,
-		Address: customer.Address,
+		Id:       customer.ID,
+		UserId:   customer.UserID,
+		Name:     customer.Name,
+		Email:    customer.Email,
+		Address:  customer.Address,
+		Country:  customer.Country,
+		TaxId:    customer.TaxID,
+		PeppolId: customer.PeppolID,
 	}
 }
+
+// ConvertImportErrorsToProto converts import errors to protobuf ImportError messages.
+func ConvertImportErrorsToProto(importErrors []ImportError) []*pb.ImportError {
+	protoErrors := make([]*pb.ImportError, len(importErrors))
+	for i, importError := range importErrors {
+		protoErrors[i] = &pb.ImportError{Row: int32(importError.Row), Message: importError.Message}
+	}
+	return protoErrors
+}
+
+// ConvertCustomerSearchResultsToProto converts customer search results to protobuf CustomerSearchResult messages.
+func ConvertCustomerSearchResultsToProto(results []*CustomerSearchResult) []*pb.CustomerSearchResult {
+	protoResults := make([]*pb.CustomerSearchResult, len(results))
+	for i, result := range results {
+		protoResults[i] = &pb.CustomerSearchResult{
+			Customer:      ConvertCustomerToProto(result.Customer),
+			Rank:          result.Rank,
+			NameHighlight: result.Name,
+		}
+	}
+	return protoResults
+}
@@ -0,0 +1,15 @@
+package models
+
+// The repository wraps the words that matched a search in these control characters, which the service turns
+// into markup once the rest of the text is escaped.
+const (
+	HighlightStart = "\x02"
+	HighlightStop  = "\x03"
+)
+
+// CustomerSearchResult is a customer found by a search.
+type CustomerSearchResult struct {
+	Customer *Customer
+	Rank     float32
+	Name     string // The customer's name with the matching words marked, or empty if only the email matched
+}
@@ -7,6 +7,11 @@ type User struct {
 	Email          string
 	HashedPassword string
 	Role           string
+	BusinessName   string // The name the user invoices under
+	Address        string
+	Country        string // ISO 3166-1 alpha-2 code
+	TaxID          string // VAT or other tax registration number, prefixed with the country code
+	PeppolID       string // Peppol participant identifier, as scheme:identifier
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 }
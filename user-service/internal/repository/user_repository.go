@@ -35,8 +35,9 @@ func (r *UserRepository) CreateUser(ctx context.Context, email, password, role s
 func (r *UserRepository) GetUserByID(ctx context.Context, userID int64) (*models.User, error) {
 	var user models.User
 	err := r.db.QueryRowContext(ctx,
-		"SELECT id, email, hashed_password, role, created_at, updated_at FROM users WHERE id = $1",
-		userID).Scan(&user.ID, &user.Email, &user.HashedPassword, &user.Role, &user.CreatedAt, &user.UpdatedAt)
+		"SELECT id, email, hashed_password, role, business_name, address, country, tax_id, peppol_id, created_at, updated_at FROM users WHERE id = $1",
+		userID).Scan(&user.ID, &user.Email, &user.HashedPassword, &user.Role, &user.BusinessName, &user.Address, &user.Country,
+		&user.TaxID, &user.PeppolID, &user.CreatedAt, &user.UpdatedAt)
 	if err == sql.ErrNoRows {
 		return &models.User{}, errors.New("user not found")
 	}
@@ -46,8 +47,9 @@ func (r *UserRepository) GetUserByID(ctx context.Context, userID int64) (*models
 func (r *UserRepository) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
 	var user models.User
 	err := r.db.QueryRowContext(ctx,
-		"SELECT id, email, hashed_password, role, created_at, updated_at FROM users WHERE email = $1",
-		email).Scan(&user.ID, &user.Email, &user.HashedPassword, &user.Role, &user.CreatedAt, &user.UpdatedAt)
+		"SELECT id, email, hashed_password, role, business_name, address, country, tax_id, peppol_id, created_at, updated_at FROM users WHERE email = $1",
+		email).Scan(&user.ID, &user.Email, &user.HashedPassword, &user.Role, &user.BusinessName, &user.Address, &user.Country,
+		&user.TaxID, &user.PeppolID, &user.CreatedAt, &user.UpdatedAt)
 	if err == sql.ErrNoRows {
 		return &models.User{}, errors.New("user not found")
 	}
@@ -56,8 +58,9 @@ func (r *UserRepository) GetUserByEmail(ctx context.Context, email string) (*mod
 
 func (r *UserRepository) UpdateUser(ctx context.Context, user *models.User) error {
 	_, err := r.db.ExecContext(ctx,
-		"UPDATE users SET email = $1, hashed_password = $2, role = $3, updated_at = NOW() WHERE id = $4",
-		user.Email, user.HashedPassword, user.Role, user.ID)
+		`UPDATE users SET email = $1, hashed_password = $2, role = $3, business_name = $4, address = $5, country = $6,
+		tax_id = $7, peppol_id = $8, updated_at = NOW() WHERE id = $9`,
+		user.Email, user.HashedPassword, user.Role, user.BusinessName, user.Address, user.Country, user.TaxID, user.PeppolID, user.ID)
 	return err
 }
 
@@ -69,18 +72,40 @@ func (r *UserRepository) DeleteUser(ctx context.Context, userID int64) error {
 // Customer management
 func (r *UserRepository) CreateCustomer(ctx context.Context, customer *models.Customer) (*models.Customer, error) {
 	err := r.db.QueryRowContext(ctx,
-		"INSERT INTO customers (user_id, name, email, address) VALUES ($1, $2, $3, $4) RETURNING id, created_at, updated_at",
-		customer.UserID, customer.Name, customer.Email, customer.Address).
+		"INSERT INTO customers (user_id, name, email, address, country, tax_id, peppol_id) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at, updated_at",
+		customer.UserID, customer.Name, customer.Email, customer.Address, customer.Country, customer.TaxID, customer.PeppolID).
 		Scan(&customer.ID, &customer.CreatedAt, &customer.UpdatedAt)
 
 	return customer, err
 }
 
+// CreateCustomers inserts a batch of customers in one transaction, so either all of them are saved or none are.
+func (r *UserRepository) CreateCustomers(ctx context.Context, customers []*models.Customer) error {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, customer := range customers {
+		err = tx.QueryRowContext(ctx,
+			"INSERT INTO customers (user_id, name, email, address, country, tax_id, peppol_id) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, created_at, updated_at",
+			customer.UserID, customer.Name, customer.Email, customer.Address, customer.Country, customer.TaxID, customer.PeppolID).
+			Scan(&customer.ID, &customer.CreatedAt, &customer.UpdatedAt)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
 func (r *UserRepository) GetCustomerByID(ctx context.Context, customerID int64) (*models.Customer, error) {
 	var customer models.Customer
 	err := r.db.QueryRowContext(ctx,
-		"SELECT id, user_id, name, email, address, created_at, updated_at FROM customers WHERE id = $1",
-		customerID).Scan(&customer.ID, &customer.UserID, &customer.Name, &customer.Email, &customer.Address, &customer.CreatedAt, &customer.UpdatedAt)
+		"SELECT id, user_id, name, email, address, country, tax_id, peppol_id, created_at, updated_at FROM customers WHERE id = $1",
+		customerID).Scan(&customer.ID, &customer.UserID, &customer.Name, &customer.Email, &customer.Address, &customer.Country,
+		&customer.TaxID, &customer.PeppolID, &customer.CreatedAt, &customer.UpdatedAt)
 	if err == sql.ErrNoRows {
 		return &models.Customer{}, errors.New("customer not found")
 	}
@@ -89,8 +114,8 @@ func (r *UserRepository) GetCustomerByID(ctx context.Context, customerID int64)
 
 func (r *UserRepository) UpdateCustomer(ctx context.Context, customer *models.Customer) error {
 	_, err := r.db.ExecContext(ctx,
-		"UPDATE customers SET name = $1, email = $2, address = $3, updated_at = NOW() WHERE id = $4",
-		customer.Name, customer.Email, customer.Address, customer.ID)
+		"UPDATE customers SET name = $1, email = $2, address = $3, country = $4, tax_id = $5, peppol_id = $6, updated_at = NOW() WHERE id = $7",
+		customer.Name, customer.Email, customer.Address, customer.Country, customer.TaxID, customer.PeppolID, customer.ID)
 	return err
 }
 
@@ -98,3 +123,32 @@ func (r *UserRepository) DeleteCustomer(ctx context.Context, customerID int64) e
 	_, err := r.db.ExecContext(ctx, "DELETE FROM customers WHERE id = $1", customerID)
 	return err
 }
+
+// SearchCustomers returns up to limit of a user's customers matching a tsquery, best matches first.
+func (r *UserRepository) SearchCustomers(ctx context.Context, userID int64, query string, limit int) ([]*models.CustomerSearchResult, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, user_id, name, email, address, country, tax_id, peppol_id, created_at, updated_at,
+			ts_rank(search_vector, q.query) AS rank, ts_headline('simple', name, q.query, $4)
+		FROM customers, to_tsquery('simple', $2) AS q(query)
+		WHERE user_id = $1 AND search_vector @@ q.query
+		ORDER BY rank DESC, id DESC
+		LIMIT $3`,
+		userID, query, limit, "StartSel="+models.HighlightStart+", StopSel="+models.HighlightStop+", HighlightAll=true")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var results []*models.CustomerSearchResult
+	for rows.Next() {
+		var customer models.Customer
+		result := models.CustomerSearchResult{Customer: &customer}
+		err := rows.Scan(&customer.ID, &customer.UserID, &customer.Name, &customer.Email, &customer.Address, &customer.Country,
+			&customer.TaxID, &customer.PeppolID, &customer.CreatedAt, &customer.UpdatedAt, &result.Rank, &result.Name)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, &result)
+	}
+	return results, rows.Err()
+}
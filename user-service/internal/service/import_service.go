@@ -0,0 +1,127 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/mail"
+	"strings"
+
+	"github.com/emzola/numer/user-service/internal/models"
+)
+
+// MaxImportRows is the most rows, not counting the header, an import file can have.
+const MaxImportRows = 5000
+
+// ErrInvalidRequest is returned when a request, like an import file that can't be read at all, is rejected.
+var ErrInvalidRequest = errors.New("the request is invalid")
+
+// ImportCustomers creates customers from a CSV file with a name,email,address header, in any order and with the
+// address optional. Either every customer is created or, if any row is invalid, none are and the rejected rows
+// are returned. A dry run checks the file without creating anything.
+func (s *UserService) ImportCustomers(ctx context.Context, userID int64, data []byte, dryRun bool) ([]*models.Customer, []models.ImportError, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: reading header: %v", ErrInvalidRequest, err)
+	}
+	columns := map[string]int{}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if _, ok := columns[name]; ok || (name != "name" && name != "email" && name != "address") {
+			return nil, nil, fmt.Errorf("%w: header must be name,email and optionally address", ErrInvalidRequest)
+		}
+		columns[name] = i
+	}
+	if _, ok := columns["name"]; !ok {
+		return nil, nil, fmt.Errorf("%w: header must be name,email and optionally address", ErrInvalidRequest)
+	}
+	if _, ok := columns["email"]; !ok {
+		return nil, nil, fmt.Errorf("%w: header must be name,email and optionally address", ErrInvalidRequest)
+	}
+	get := func(record []string, name string) string {
+		i, ok := columns[name]
+		if !ok {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var customers []*models.Customer
+	var rowErrors []models.ImportError
+	emailRows := map[string]int{}
+	for row := 2; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if row > MaxImportRows+1 {
+			return nil, nil, fmt.Errorf("%w: the file has more than %d rows", ErrInvalidRequest, MaxImportRows)
+		}
+		if err != nil {
+			rowErrors = append(rowErrors, models.ImportError{Row: row, Message: err.Error()})
+			continue
+		}
+
+		customer := &models.Customer{
+			UserID:  userID,
+			Name:    get(record, "name"),
+			Email:   get(record, "email"),
+			Address: get(record, "address"),
+		}
+		reason := validateCustomer(customer)
+		if reason == "" {
+			email := strings.ToLower(customer.Email)
+			if first, ok := emailRows[email]; ok {
+				reason = fmt.Sprintf("email %s is already used on row %d", customer.Email, first)
+			} else {
+				emailRows[email] = row
+			}
+		}
+		if reason != "" {
+			rowErrors = append(rowErrors, models.ImportError{Row: row, Message: reason})
+			continue
+		}
+		customers = append(customers, customer)
+	}
+
+	if len(rowErrors) > 0 {
+		return customers, rowErrors, nil
+	}
+	if len(customers) == 0 {
+		return nil, nil, fmt.Errorf("%w: the file contains no customers", ErrInvalidRequest)
+	}
+	if dryRun {
+		return customers, nil, nil
+	}
+
+	err = s.repo.CreateCustomers(ctx, customers)
+	if err != nil {
+		return nil, nil, err
+	}
+	return customers, nil, nil
+}
+
+// validateCustomer returns why a customer can't be saved, or "" if it can.
+func validateCustomer(customer *models.Customer) string {
+	switch {
+	case customer.Name == "":
+		return "name is required"
+	case len(customer.Name) > 255:
+		return "name must not be longer than 255 bytes"
+	case customer.Email == "":
+		return "email is required"
+	case len(customer.Email) > 255:
+		return "email must not be longer than 255 bytes"
+	}
+	address, err := mail.ParseAddress(customer.Email)
+	if err != nil || address.Address != customer.Email {
+		return fmt.Sprintf("invalid email %q", customer.Email)
+	}
+	return ""
+}
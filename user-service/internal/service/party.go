@@ -0,0 +1,42 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// peppolIDPattern matches a Peppol participant identifier: a four digit ISO 6523 scheme, such as 0088 for GLN or
+// 0192 for Norwegian organisation numbers, then the identifier itself.
+var peppolIDPattern = regexp.MustCompile(`^[0-9]{4}:[^\s:]+$`)
+
+// partyDetails are the optional details that name a user or customer on a structured e-invoice.
+type partyDetails struct {
+	country  *string
+	taxID    *string
+	peppolID *string
+}
+
+// normalize tidies the details in place and returns why they can't be saved, or "" if they can.
+func (d partyDetails) normalize() string {
+	*d.country = strings.ToUpper(strings.TrimSpace(*d.country))
+	*d.taxID = strings.ToUpper(strings.Join(strings.Fields(*d.taxID), ""))
+	*d.peppolID = strings.TrimSpace(*d.peppolID)
+
+	switch {
+	case *d.country != "" && !isCountryCode(*d.country):
+		return fmt.Sprintf("country %q must be an ISO 3166-1 alpha-2 code, such as DE", *d.country)
+	case len(*d.taxID) > 50:
+		return "tax_id must not be longer than 50 bytes"
+	case len(*d.peppolID) > 100:
+		return "peppol_id must not be longer than 100 bytes"
+	case *d.peppolID != "" && !peppolIDPattern.MatchString(*d.peppolID):
+		return fmt.Sprintf("peppol_id %q must be a scheme and identifier, such as 0088:5790000435975", *d.peppolID)
+	}
+	return ""
+}
+
+// isCountryCode reports whether code is shaped like an ISO 3166-1 alpha-2 code.
+func isCountryCode(code string) bool {
+	return len(code) == 2 && code[0] >= 'A' && code[0] <= 'Z' && code[1] >= 'A' && code[1] <= 'Z'
+}
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+
+	"github.com/emzola/numer/user-service/internal/models"
+)
+
+const (
+	defaultCustomerSearchLimit = 10
+	maxCustomerSearchLimit     = 100
+
+	// A search query is cut down to this many words, each at most maxSearchTermLength letters long
+	maxSearchTerms      = 10
+	maxSearchTermLength = 50
+)
+
+// SearchCustomers returns up to limit of a user's customers whose name or email address contains every word of
+// query, best matches first. Words may be cut short, so "acm" finds Acme Ltd. The name of each result is
+// HTML-escaped, with the matching words wrapped in <mark> tags.
+func (s *UserService) SearchCustomers(ctx context.Context, userID int64, query string, limit int) ([]*models.CustomerSearchResult, error) {
+	tsQuery, err := searchQuery(query)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultCustomerSearchLimit
+	}
+	limit = min(limit, maxCustomerSearchLimit)
+
+	results, err := s.repo.SearchCustomers(ctx, userID, tsQuery, limit)
+	if err != nil {
+		return nil, err
+	}
+	for _, result := range results {
+		result.Name = markHighlight(result.Name)
+	}
+	return results, nil
+}
+
+// searchQuery turns the words of a search into a tsquery that matches documents containing all of them, each
+// as a whole word or the start of one. Anything but letters and digits separates words, so the query can't
+// carry tsquery operators.
+func searchQuery(query string) (string, error) {
+	words := strings.FieldsFunc(strings.ToLower(query), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(words) == 0 {
+		return "", fmt.Errorf("%w: the search must contain a letter or digit", ErrInvalidRequest)
+	}
+
+	terms := make([]string, 0, min(len(words), maxSearchTerms))
+	for _, word := range words[:min(len(words), maxSearchTerms)] {
+		if runes := []rune(word); len(runes) > maxSearchTermLength {
+			word = string(runes[:maxSearchTermLength])
+		}
+		terms = append(terms, word+":*")
+	}
+	return strings.Join(terms, " & "), nil
+}
+
+// markHighlight escapes a highlight from the repository and marks its matches with <mark> tags. A highlight
+// without any matches is left out.
+func markHighlight(highlight string) string {
+	if !strings.Contains(highlight, models.HighlightStart) {
+		return ""
+	}
+	highlight = html.EscapeString(highlight)
+	return strings.NewReplacer(models.HighlightStart, "<mark>", models.HighlightStop, "</mark>").Replace(highlight)
+}
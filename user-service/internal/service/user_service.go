@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/emzola/numer/user-service/internal/models"
 	"golang.org/x/crypto/bcrypt"
@@ -17,9 +19,11 @@ type userRepository interface {
 
 	// Customer management methods
 	CreateCustomer(ctx context.Context, customer *models.Customer) (*models.Customer, error)
+	CreateCustomers(ctx context.Context, customers []*models.Customer) error
 	GetCustomerByID(ctx context.Context, customerID int64) (*models.Customer, error)
 	UpdateCustomer(ctx context.Context, customer *models.Customer) error
 	DeleteCustomer(ctx context.Context, customerID int64) error
+	SearchCustomers(ctx context.Context, userID int64, query string, limit int) ([]*models.CustomerSearchResult, error)
 }
 
 type UserService struct {
@@ -52,6 +56,11 @@ func (s *UserService) GetUserByEmail(ctx context.Context, email string) (*models
 }
 
 func (s *UserService) UpdateUser(ctx context.Context, user *models.User) error {
+	user.BusinessName = strings.TrimSpace(user.BusinessName)
+	if reason := (partyDetails{&user.Country, &user.TaxID, &user.PeppolID}).normalize(); reason != "" {
+		return fmt.Errorf("%w: %s", ErrInvalidRequest, reason)
+	}
+
 	// Hash password if provided
 	if user.HashedPassword != "" {
 		hashedPassword, err := bcrypt.GenerateFromPassword([]byte(user.HashedPassword), bcrypt.DefaultCost)
@@ -69,6 +78,9 @@ func (s *UserService) DeleteUser(ctx context.Context, userID int64) error {
 
 // Customer Management
 func (s *UserService) CreateCustomer(ctx context.Context, customer *models.Customer) (*models.Customer, error) {
+	if reason := (partyDetails{&customer.Country, &customer.TaxID, &customer.PeppolID}).normalize(); reason != "" {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRequest, reason)
+	}
 	return s.repo.CreateCustomer(ctx, customer)
 }
 
@@ -77,6 +89,9 @@ func (s *UserService) GetCustomerByID(ctx context.Context, customerID int64) (*m
 }
 
 func (s *UserService) UpdateCustomer(ctx context.Context, customer *models.Customer) error {
+	if reason := (partyDetails{&customer.Country, &customer.TaxID, &customer.PeppolID}).normalize(); reason != "" {
+		return fmt.Errorf("%w: %s", ErrInvalidRequest, reason)
+	}
 	return s.repo.UpdateCustomer(ctx, customer)
 }
 
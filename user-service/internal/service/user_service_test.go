@@ -26,6 +26,11 @@ func (m *MockUserRepository) GetUserByID(ctx context.Context, userID int64) (*mo
 	return args.Get(0).(*models.User), args.Error(1)
 }
 
+func (m *MockUserRepository) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	args := m.Called(ctx, email)
+	return args.Get(0).(*models.User), args.Error(1)
+}
+
 func (m *MockUserRepository) UpdateUser(ctx context.Context, user *models.User) error {
 	args := m.Called(ctx, user)
 	return args.Error(0)
@@ -41,6 +46,11 @@ func (m *MockUserRepository) CreateCustomer(ctx context.Context, customer *model
 	return args.Get(0).(*models.Customer), args.Error(1)
 }
 
+func (m *MockUserRepository) CreateCustomers(ctx context.Context, customers []*models.Customer) error {
+	args := m.Called(ctx, customers)
+	return args.Error(0)
+}
+
 func (m *MockUserRepository) GetCustomerByID(ctx context.Context, customerID int64) (*models.Customer, error) {
 	args := m.Called(ctx, customerID)
 	return args.Get(0).(*models.Customer), args.Error(1)
@@ -56,6 +66,11 @@ func (m *MockUserRepository) DeleteCustomer(ctx context.Context, customerID int6
 	return args.Error(0)
 }
 
+func (m *MockUserRepository) SearchCustomers(ctx context.Context, userID int64, query string, limit int) ([]*models.CustomerSearchResult, error) {
+	args := m.Called(ctx, userID, query, limit)
+	return args.Get(0).([]*models.CustomerSearchResult), args.Error(1)
+}
+
 // Unit tests for the UserService
 
 func TestCreateUser(t *testing.T) {
@@ -170,6 +185,49 @@ func TestCreateCustomer(t *testing.T) {
 	mockRepo.AssertExpectations(t)
 }
 
+func TestCreateCustomerPartyDetails(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	userService := service.NewUserService(mockRepo)
+
+	customer := &models.Customer{
+		Name:     "Customer Name",
+		Email:    "customer@example.com",
+		Country:  " de",
+		TaxID:    "de 123 456 789",
+		PeppolID: "0204:991-12345-67",
+	}
+	mockRepo.On("CreateCustomer", mock.Anything, customer).Return(customer, nil)
+
+	createdCustomer, err := userService.CreateCustomer(context.Background(), customer)
+
+	require.NoError(t, err)
+	require.Equal(t, "DE", createdCustomer.Country)
+	require.Equal(t, "DE123456789", createdCustomer.TaxID)
+}
+
+func TestCreateCustomerInvalidPartyDetails(t *testing.T) {
+	tests := []struct {
+		name     string
+		customer *models.Customer
+	}{
+		{"country name", &models.Customer{Name: "Customer Name", Country: "Germany"}},
+		{"peppol ID without scheme", &models.Customer{Name: "Customer Name", PeppolID: "991-12345-67"}},
+		{"peppol ID with bad scheme", &models.Customer{Name: "Customer Name", PeppolID: "GLN:5790000435975"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo := new(MockUserRepository)
+			userService := service.NewUserService(mockRepo)
+
+			_, err := userService.CreateCustomer(context.Background(), tt.customer)
+
+			require.ErrorIs(t, err, service.ErrInvalidRequest)
+			mockRepo.AssertNotCalled(t, "CreateCustomer", mock.Anything, mock.Anything)
+		})
+	}
+}
+
 func TestGetCustomerByID(t *testing.T) {
 	mockRepo := new(MockUserRepository)
 	userService := service.NewUserService(mockRepo)
@@ -230,3 +288,90 @@ func TestDeleteCustomer(t *testing.T) {
 	require.NoError(t, err)
 	mockRepo.AssertExpectations(t)
 }
+
+func TestImportCustomers(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	userService := service.NewUserService(mockRepo)
+
+	// Mock the repository response
+	mockRepo.On("CreateCustomers", mock.Anything, mock.Anything).Return(nil)
+
+	// Call the ImportCustomers method
+	data := []byte("Email,Name\nada@example.com,Ada Lovelace\ngrace@example.com,\"Hopper, Grace\"\n")
+	customers, importErrors, err := userService.ImportCustomers(context.Background(), 1, data, false)
+
+	// Assertions
+	require.NoError(t, err)
+	require.Empty(t, importErrors)
+	require.Len(t, customers, 2)
+	require.Equal(t, &models.Customer{UserID: 1, Name: "Hopper, Grace", Email: "grace@example.com"}, customers[1])
+	mockRepo.AssertExpectations(t)
+}
+
+func TestImportCustomersInvalidRows(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	userService := service.NewUserService(mockRepo)
+
+	// Call the ImportCustomers method as a dry run
+	data := []byte("name,email,address\n" +
+		"Ada Lovelace,ada@example.com,\n" +
+		",nameless@example.com,\n" +
+		"Grace Hopper,not an email,\n" +
+		"Ada Again,ADA@example.com,\n")
+	customers, importErrors, err := userService.ImportCustomers(context.Background(), 1, data, true)
+
+	// Assertions
+	require.NoError(t, err)
+	require.Len(t, customers, 1)
+	require.Equal(t, []models.ImportError{
+		{Row: 3, Message: "name is required"},
+		{Row: 4, Message: `invalid email "not an email"`},
+		{Row: 5, Message: "email ADA@example.com is already used on row 2"},
+	}, importErrors)
+	mockRepo.AssertNotCalled(t, "CreateCustomers", mock.Anything, mock.Anything)
+}
+
+func TestImportCustomersInvalidHeader(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	userService := service.NewUserService(mockRepo)
+
+	// Call the ImportCustomers method
+	_, _, err := userService.ImportCustomers(context.Background(), 1, []byte("name,phone\nAda,555\n"), false)
+
+	// Assertions
+	require.ErrorIs(t, err, service.ErrInvalidRequest)
+	mockRepo.AssertNotCalled(t, "CreateCustomers", mock.Anything, mock.Anything)
+}
+
+func TestSearchCustomers(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	userService := service.NewUserService(mockRepo)
+
+	// Mock the repository response
+	mockRepo.On("SearchCustomers", mock.Anything, int64(1), "acme:* & ltd:*", 10).Return([]*models.CustomerSearchResult{
+		{Customer: &models.Customer{ID: 2, UserID: 1, Name: "Acme <Ltd>"}, Rank: 0.6, Name: "\x02Acme\x03 <\x02Ltd\x03>"},
+		{Customer: &models.Customer{ID: 3, UserID: 1, Name: "Widgets", Email: "sales@acme.ltd"}, Rank: 0.2, Name: "Widgets"},
+	}, nil)
+
+	// Call the SearchCustomers method
+	results, err := userService.SearchCustomers(context.Background(), 1, "ACME & Ltd:", 0)
+
+	// Assertions
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, "<mark>Acme</mark> &lt;<mark>Ltd</mark>&gt;", results[0].Name)
+	require.Empty(t, results[1].Name)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestSearchCustomersEmptyQuery(t *testing.T) {
+	mockRepo := new(MockUserRepository)
+	userService := service.NewUserService(mockRepo)
+
+	// Call the SearchCustomers method
+	_, err := userService.SearchCustomers(context.Background(), 1, " *:! ", 0)
+
+	// Assertions
+	require.ErrorIs(t, err, service.ErrInvalidRequest)
+	mockRepo.AssertNotCalled(t, "SearchCustomers", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,26 @@
+-- +goose Up
+-- Details needed to name the parties on structured e-invoices
+ALTER TABLE users
+    ADD COLUMN business_name VARCHAR(255) NOT NULL DEFAULT '',
+    ADD COLUMN address TEXT NOT NULL DEFAULT '',
+    ADD COLUMN country VARCHAR(2) NOT NULL DEFAULT '',
+    ADD COLUMN tax_id VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN peppol_id VARCHAR(100) NOT NULL DEFAULT '';
+
+ALTER TABLE customers
+    ADD COLUMN country VARCHAR(2) NOT NULL DEFAULT '',
+    ADD COLUMN tax_id VARCHAR(50) NOT NULL DEFAULT '',
+    ADD COLUMN peppol_id VARCHAR(100) NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE customers
+    DROP COLUMN peppol_id,
+    DROP COLUMN tax_id,
+    DROP COLUMN country;
+
+ALTER TABLE users
+    DROP COLUMN peppol_id,
+    DROP COLUMN tax_id,
+    DROP COLUMN country,
+    DROP COLUMN address,
+    DROP COLUMN business_name;
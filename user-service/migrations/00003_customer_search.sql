@@ -0,0 +1,13 @@
+-- +goose Up
+-- Customers are found by name, or by the parts of their email address. The 'simple' configuration keeps names
+-- from being stemmed as English words.
+ALTER TABLE customers ADD COLUMN IF NOT EXISTS search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple', COALESCE(name, '')), 'A') ||
+    setweight(to_tsvector('simple', translate(COALESCE(email, ''), '@.', '  ')), 'B')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS customers_search_vector_idx ON customers USING GIN (search_vector);
+
+-- +goose Down
+DROP INDEX IF EXISTS customers_search_vector_idx;
+ALTER TABLE customers DROP COLUMN IF EXISTS search_vector;
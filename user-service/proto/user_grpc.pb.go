@@ -28,6 +28,8 @@ const (
 	UserService_GetCustomer_FullMethodName      = "/user.UserService/GetCustomer"
 	UserService_UpdateCustomer_FullMethodName   = "/user.UserService/UpdateCustomer"
 	UserService_DeleteCustomer_FullMethodName   = "/user.UserService/DeleteCustomer"
+	UserService_ImportCustomers_FullMethodName  = "/user.UserService/ImportCustomers"
+	UserService_SearchCustomers_FullMethodName  = "/user.UserService/SearchCustomers"
 )
 
 // UserServiceClient is the client API for UserService service.
@@ -45,6 +47,8 @@ type UserServiceClient interface {
 	GetCustomer(ctx context.Context, in *GetCustomerRequest, opts ...grpc.CallOption) (*CustomerResponse, error)
 	UpdateCustomer(ctx context.Context, in *UpdateCustomerRequest, opts ...grpc.CallOption) (*CustomerResponse, error)
 	DeleteCustomer(ctx context.Context, in *DeleteCustomerRequest, opts ...grpc.CallOption) (*DeleteCustomerResponse, error)
+	ImportCustomers(ctx context.Context, in *ImportCustomersRequest, opts ...grpc.CallOption) (*ImportCustomersResponse, error)
+	SearchCustomers(ctx context.Context, in *SearchCustomersRequest, opts ...grpc.CallOption) (*SearchCustomersResponse, error)
 }
 
 type userServiceClient struct {
@@ -136,6 +140,24 @@ func (c *userServiceClient) DeleteCustomer(ctx context.Context, in *DeleteCustom
 	return out, nil
 }
 
+func (c *userServiceClient) ImportCustomers(ctx context.Context, in *ImportCustomersRequest, opts ...grpc.CallOption) (*ImportCustomersResponse, error) {
+	out := new(ImportCustomersResponse)
+	err := c.cc.Invoke(ctx, UserService_ImportCustomers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SearchCustomers(ctx context.Context, in *SearchCustomersRequest, opts ...grpc.CallOption) (*SearchCustomersResponse, error) {
+	out := new(SearchCustomersResponse)
+	err := c.cc.Invoke(ctx, UserService_SearchCustomers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -151,6 +173,8 @@ type UserServiceServer interface {
 	GetCustomer(context.Context, *GetCustomerRequest) (*CustomerResponse, error)
 	UpdateCustomer(context.Context, *UpdateCustomerRequest) (*CustomerResponse, error)
 	DeleteCustomer(context.Context, *DeleteCustomerRequest) (*DeleteCustomerResponse, error)
+	ImportCustomers(context.Context, *ImportCustomersRequest) (*ImportCustomersResponse, error)
+	SearchCustomers(context.Context, *SearchCustomersRequest) (*SearchCustomersResponse, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -185,6 +209,12 @@ func (UnimplementedUserServiceServer) UpdateCustomer(context.Context, *UpdateCus
 func (UnimplementedUserServiceServer) DeleteCustomer(context.Context, *DeleteCustomerRequest) (*DeleteCustomerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCustomer not implemented")
 }
+func (UnimplementedUserServiceServer) ImportCustomers(context.Context, *ImportCustomersRequest) (*ImportCustomersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportCustomers not implemented")
+}
+func (UnimplementedUserServiceServer) SearchCustomers(context.Context, *SearchCustomersRequest) (*SearchCustomersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchCustomers not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -360,6 +390,42 @@ func _UserService_DeleteCustomer_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_ImportCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).ImportCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_ImportCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).ImportCustomers(ctx, req.(*ImportCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SearchCustomers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchCustomersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SearchCustomers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SearchCustomers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SearchCustomers(ctx, req.(*SearchCustomersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -403,6 +469,14 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteCustomer",
 			Handler:    _UserService_DeleteCustomer_Handler,
 		},
+		{
+			MethodName: "ImportCustomers",
+			Handler:    _UserService_ImportCustomers_Handler,
+		},
+		{
+			MethodName: "SearchCustomers",
+			Handler:    _UserService_SearchCustomers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user-service/proto/user.proto",